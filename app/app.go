@@ -1,7 +1,9 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"maps"
@@ -33,6 +35,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/spf13/cast"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -124,7 +127,9 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmindexer "github.com/CosmWasm/wasmd/x/wasm/indexer"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtracing "github.com/CosmWasm/wasmd/x/wasm/tracing"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
@@ -227,6 +232,11 @@ type WasmApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// wasmTracerProvider exports the OpenTelemetry spans of contract calls. Nil when tracing is disabled.
+	wasmTracerProvider *sdktrace.TracerProvider
+	// wasmIndexer indexes the wasm activity of the blocks into a local database. Nil when the indexer is disabled.
+	wasmIndexer *wasmindexer.Indexer
 }
 
 // NewWasmApp returns a reference to an initialized WasmApp.
@@ -597,6 +607,28 @@ func NewWasmApp(
 	if err != nil {
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
+	if nodeConfig.TracingExporter != "" {
+		app.wasmTracerProvider, err = wasmtracing.NewTracerProvider(nodeConfig)
+		if err != nil {
+			panic(fmt.Sprintf("error while setting up wasm tracing: %s", err))
+		}
+		wasmOpts = append(wasmOpts, wasmkeeper.WithTracerProvider(app.wasmTracerProvider))
+	}
+	if nodeConfig.IndexerEnabled {
+		indexerDB, err := dbm.NewDB("wasm_indexer", server.GetAppDBBackend(appOpts), filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(fmt.Sprintf("error while opening wasm indexer db: %s", err))
+		}
+		app.wasmIndexer = wasmindexer.NewIndexer(indexerDB, nodeConfig.IndexerRetainBlocks)
+		streamingManager := app.StreamingManager()
+		streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, app.wasmIndexer)
+		app.SetStreamingManager(streamingManager)
+	}
+
+	// Accept the deterministic x/wasm gRPC queries by default. Query plugins passed via wasmOpts take precedence.
+	wasmOpts = append([]wasmkeeper.Option{wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Grpc: wasmkeeper.AcceptListGrpcQuerier(wasmkeeper.WasmAcceptedQueries(), app.GRPCQueryRouter(), appCodec),
+	})}, wasmOpts...)
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
@@ -959,6 +991,18 @@ func (app *WasmApp) setPostHandler() {
 // Name returns the name of the App
 func (app *WasmApp) Name() string { return app.BaseApp.Name() }
 
+// Close closes the BaseApp and the wasm indexer db and flushes the pending wasm tracing spans
+func (app *WasmApp) Close() error {
+	err := app.BaseApp.Close()
+	if app.wasmTracerProvider != nil {
+		err = errors.Join(err, app.wasmTracerProvider.Shutdown(context.Background()))
+	}
+	if app.wasmIndexer != nil {
+		err = errors.Join(err, app.wasmIndexer.Close())
+	}
+	return err
+}
+
 // PreBlocker application updates every pre block
 func (app *WasmApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
 	return app.ModuleManager.PreBlock(ctx)
@@ -1093,6 +1137,13 @@ func (app *WasmApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the node local wasm indexer service for grpc-gateway.
+	if app.wasmIndexer != nil {
+		if err := wasmtypes.RegisterIndexerHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, wasmtypes.NewIndexerClient(clientCtx)); err != nil {
+			panic(err)
+		}
+	}
+
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -1120,6 +1171,9 @@ func (app *WasmApp) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *WasmApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	if app.wasmIndexer != nil {
+		wasmtypes.RegisterIndexerServer(app.GRPCQueryRouter(), wasmindexer.NewQuerier(app.wasmIndexer))
+	}
 }
 
 // GetMaccPerms returns a copy of the module account permissions
@@ -2,6 +2,7 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
@@ -195,7 +196,15 @@ func newApp(
 
 	var wasmOpts []wasmkeeper.Option
 	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
-		wasmOpts = append(wasmOpts, wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer))
+		nodeConfig, err := wasm.ReadNodeConfig(appOpts)
+		if err != nil {
+			panic(fmt.Sprintf("error while reading wasm config: %s", err))
+		}
+		wasmOpts = append(wasmOpts,
+			wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer),
+			wasmkeeper.WithWasmEngineMetrics(prometheus.DefaultRegisterer, nodeConfig.MetricsCodeIDs...),
+			wasmkeeper.WithSmartQueryCacheMetrics(prometheus.DefaultRegisterer),
+		)
 	}
 
 	return app.NewWasmApp(
@@ -240,10 +240,11 @@ func TestHandleInstantiate(t *testing.T) {
 
 	require.Equal(t, "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr", contractBech32Addr)
 	// this should be standard x/wasm init event, nothing from contract
-	require.Equal(t, 2, len(res.Events), prettyEvents(res.Events))
+	require.Equal(t, 3, len(res.Events), prettyEvents(res.Events))
 	require.Equal(t, "instantiate", res.Events[0].Type)
-	require.Equal(t, "wasm", res.Events[1].Type)
-	assertAttribute(t, "_contract_address", contractBech32Addr, res.Events[1].Attributes[0])
+	require.Equal(t, "cosmwasm.wasm.v1.EventInstantiate", res.Events[1].Type)
+	require.Equal(t, "wasm", res.Events[2].Type)
+	assertAttribute(t, "_contract_address", contractBech32Addr, res.Events[2].Attributes[0])
 
 	assertCodeList(t, q, data.ctx, 1, data.encConf.Codec)
 	assertCodeBytes(t, q, data.ctx, 1, testContract, data.encConf.Codec)
@@ -298,13 +299,14 @@ func TestHandleExecute(t *testing.T) {
 
 	require.Equal(t, "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr", contractBech32Addr)
 	// this should be standard x/wasm message event,  init event, plus a bank send event (2), with no custom contract events
-	require.Equal(t, 5, len(res.Events), prettyEvents(res.Events))
+	require.Equal(t, 6, len(res.Events), prettyEvents(res.Events))
 	require.Equal(t, "coin_spent", res.Events[0].Type)
 	require.Equal(t, "coin_received", res.Events[1].Type)
 	require.Equal(t, "transfer", res.Events[2].Type)
 	require.Equal(t, "instantiate", res.Events[3].Type)
-	require.Equal(t, "wasm", res.Events[4].Type)
-	assertAttribute(t, "_contract_address", contractBech32Addr, res.Events[4].Attributes[0])
+	require.Equal(t, "cosmwasm.wasm.v1.EventInstantiate", res.Events[4].Type)
+	require.Equal(t, "wasm", res.Events[5].Type)
+	assertAttribute(t, "_contract_address", contractBech32Addr, res.Events[5].Attributes[0])
 
 	// ensure bob doesn't exist
 	bobAcct := data.acctKeeper.GetAccount(data.ctx, bob)
@@ -335,7 +337,7 @@ func TestHandleExecute(t *testing.T) {
 	assertExecuteResponse(t, res.Data, []byte{0xf0, 0x0b, 0xaa})
 
 	// this should be standard message event, plus x/wasm init event, plus 2 bank send event, plus a special event from the contract
-	require.Equal(t, 9, len(res.Events), prettyEvents(res.Events))
+	require.Equal(t, 10, len(res.Events), prettyEvents(res.Events))
 
 	assert.Equal(t, "coin_spent", res.Events[0].Type)
 	assert.Equal(t, "coin_received", res.Events[1].Type)
@@ -347,23 +349,24 @@ func TestHandleExecute(t *testing.T) {
 	assertAttribute(t, "amount", "5000denom", res.Events[2].Attributes[2])
 
 	assert.Equal(t, "execute", res.Events[3].Type)
+	assert.Equal(t, "cosmwasm.wasm.v1.EventExecute", res.Events[4].Type)
 
 	// custom contract event attribute
-	assert.Equal(t, "wasm", res.Events[4].Type)
-	assertAttribute(t, "_contract_address", contractBech32Addr, res.Events[4].Attributes[0])
-	assertAttribute(t, "action", "release", res.Events[4].Attributes[1])
-	// custom contract event
-	assert.Equal(t, "wasm-hackatom", res.Events[5].Type)
+	assert.Equal(t, "wasm", res.Events[5].Type)
 	assertAttribute(t, "_contract_address", contractBech32Addr, res.Events[5].Attributes[0])
 	assertAttribute(t, "action", "release", res.Events[5].Attributes[1])
+	// custom contract event
+	assert.Equal(t, "wasm-hackatom", res.Events[6].Type)
+	assertAttribute(t, "_contract_address", contractBech32Addr, res.Events[6].Attributes[0])
+	assertAttribute(t, "action", "release", res.Events[6].Attributes[1])
 	// second transfer (this without conflicting message)
-	assert.Equal(t, "coin_spent", res.Events[6].Type)
-	assert.Equal(t, "coin_received", res.Events[7].Type)
+	assert.Equal(t, "coin_spent", res.Events[7].Type)
+	assert.Equal(t, "coin_received", res.Events[8].Type)
 
-	assert.Equal(t, "transfer", res.Events[8].Type)
-	assertAttribute(t, "recipient", bob.String(), res.Events[8].Attributes[0])
-	assertAttribute(t, "sender", contractBech32Addr, res.Events[8].Attributes[1])
-	assertAttribute(t, "amount", "105000denom", res.Events[8].Attributes[2])
+	assert.Equal(t, "transfer", res.Events[9].Type)
+	assertAttribute(t, "recipient", bob.String(), res.Events[9].Attributes[0])
+	assertAttribute(t, "sender", contractBech32Addr, res.Events[9].Attributes[1])
+	assertAttribute(t, "amount", "105000denom", res.Events[9].Attributes[2])
 	// finally, standard x/wasm tag
 
 	// ensure bob now exists and got both payments released
@@ -484,6 +487,16 @@ func TestReadNodeConfig(t *testing.T) {
 				MemoryCacheSize:    defaults.MemoryCacheSize,
 			},
 		},
+		"set query cache size via opts": {
+			src: AppOptionsMock{
+				"wasm.query_cache_size": 10,
+			},
+			exp: types.NodeConfig{
+				SmartQueryGasLimit:  defaults.SmartQueryGasLimit,
+				SmartQueryCacheSize: 10,
+				MemoryCacheSize:     defaults.MemoryCacheSize,
+			},
+		},
 		"set cache via opts": {
 			src: AppOptionsMock{
 				"wasm.memory_cache_size": 2,
@@ -503,6 +516,46 @@ func TestReadNodeConfig(t *testing.T) {
 				ContractDebugMode:  true,
 			},
 		},
+		"set metrics code ids via opts": {
+			src: AppOptionsMock{
+				"wasm.metrics_code_ids": []interface{}{1, 2},
+			},
+			exp: types.NodeConfig{
+				SmartQueryGasLimit: defaults.SmartQueryGasLimit,
+				MemoryCacheSize:    defaults.MemoryCacheSize,
+				MetricsCodeIDs:     []uint64{1, 2},
+			},
+		},
+		"disable legacy events via opts": {
+			src: AppOptionsMock{
+				"wasm.disable_legacy_events": true,
+			},
+			exp: types.NodeConfig{
+				SmartQueryGasLimit:  defaults.SmartQueryGasLimit,
+				MemoryCacheSize:     defaults.MemoryCacheSize,
+				DisableLegacyEvents: true,
+			},
+		},
+		"indexer enabled via opts": {
+			src: AppOptionsMock{
+				"wasm.indexer_enabled": true,
+			},
+			exp: types.NodeConfig{
+				SmartQueryGasLimit: defaults.SmartQueryGasLimit,
+				MemoryCacheSize:    defaults.MemoryCacheSize,
+				IndexerEnabled:     true,
+			},
+		},
+		"indexer retain blocks via opts": {
+			src: AppOptionsMock{
+				"wasm.indexer_retain_blocks": 100,
+			},
+			exp: types.NodeConfig{
+				SmartQueryGasLimit:  defaults.SmartQueryGasLimit,
+				MemoryCacheSize:     defaults.MemoryCacheSize,
+				IndexerRetainBlocks: 100,
+			},
+		},
 		"all defaults when no options set": {
 			src: AppOptionsMock{},
 			exp: defaults,
@@ -516,12 +569,14 @@ func TestReadNodeConfig(t *testing.T) {
 				SimulationGasLimit: &one,
 				SmartQueryGasLimit: 2,
 				MemoryCacheSize:    3,
+				MetricsCodeIDs:     []uint64{4, 5},
 			})),
 			exp: types.NodeConfig{
 				SimulationGasLimit: &one,
 				SmartQueryGasLimit: 2,
 				MemoryCacheSize:    3,
 				ContractDebugMode:  false,
+				MetricsCodeIDs:     []uint64{4, 5},
 			},
 		},
 	}
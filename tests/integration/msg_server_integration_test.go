@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"crypto/sha256"
 	_ "embed"
 	"encoding/json"
 	"testing"
@@ -55,6 +56,107 @@ func TestStoreCode(t *testing.T) {
 	assert.Equal(t, types.DefaultParams().InstantiateDefaultPermission.With(sender), info.InstantiateConfig)
 }
 
+func TestStoreCodeReuseExisting(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false)
+	_, _, sender := testdata.KeyTestPubAddr()
+	storeCode := func(reuse bool) types.MsgStoreCodeResponse {
+		msg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+			m.WASMByteCode = wasmContract
+			m.Sender = sender.String()
+			m.ReuseExisting = reuse
+		})
+		rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+		require.NoError(t, err)
+		var result types.MsgStoreCodeResponse
+		require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+		return result
+	}
+	first := storeCode(false)
+
+	// when
+	reused := storeCode(true)
+
+	// then
+	assert.Equal(t, first, reused)
+	// and without the flag a new code is stored
+	assert.Equal(t, first.CodeID+1, storeCode(false).CodeID)
+}
+
+func TestInstantiateContractByChecksum(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+	_, _, sender := testdata.KeyTestPubAddr()
+	msg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+		m.WASMByteCode = wasmContract
+		m.Sender = sender.String()
+	})
+	rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+	require.NoError(t, err)
+	var storeCodeResponse types.MsgStoreCodeResponse
+	require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeCodeResponse))
+
+	// when
+	msgInstantiate := &types.MsgInstantiateContractByChecksum{
+		Sender:   sender.String(),
+		Checksum: storeCodeResponse.Checksum,
+		Label:    "test",
+		Msg:      []byte(`{}`),
+		Funds:    sdk.Coins{},
+	}
+	rsp, err = wasmApp.MsgServiceRouter().Handler(msgInstantiate)(ctx, msgInstantiate)
+
+	// then
+	require.NoError(t, err)
+	var result types.MsgInstantiateContractByChecksumResponse
+	require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+	assert.Equal(t, storeCodeResponse.CodeID, result.CodeID)
+	contractInfo := wasmApp.WasmKeeper.GetContractInfo(ctx, sdk.MustAccAddressFromBech32(result.Address))
+	require.NotNil(t, contractInfo)
+	assert.Equal(t, storeCodeResponse.CodeID, contractInfo.CodeID)
+}
+
+func TestChunkedCodeUpload(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false)
+	_, _, sender := testdata.KeyTestPubAddr()
+	chunkSize := len(wasmContract)/2 + 1
+
+	// when chunks are stored
+	var uploadID uint64
+	for i, chunk := range [][]byte{wasmContract[:chunkSize], wasmContract[chunkSize:]} {
+		msg := &types.MsgStoreCodeChunk{
+			Sender:   sender.String(),
+			UploadID: uploadID,
+			Index:    uint64(i),
+			Chunk:    chunk,
+		}
+		rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+		require.NoError(t, err)
+		var result types.MsgStoreCodeChunkResponse
+		require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+		uploadID = result.UploadID
+	}
+	// and the upload is finalized
+	checksum := sha256.Sum256(wasmContract)
+	msg := &types.MsgFinalizeCodeUpload{
+		Sender:   sender.String(),
+		UploadID: uploadID,
+		Checksum: checksum[:],
+	}
+	rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+
+	// then
+	require.NoError(t, err)
+	var result types.MsgFinalizeCodeUploadResponse
+	require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+	assert.Equal(t, checksum[:], result.Checksum)
+	info := wasmApp.WasmKeeper.GetCodeInfo(ctx, result.CodeID)
+	require.NotNil(t, info)
+	assert.Equal(t, sender.String(), info.Creator)
+	assert.Nil(t, wasmApp.WasmKeeper.GetCodeUploadSession(ctx, uploadID))
+}
+
 func TestUpdateParams(t *testing.T) {
 	wasmApp := app.Setup(t)
 	ctx := wasmApp.BaseApp.NewContext(false)
@@ -159,6 +261,7 @@ func TestAddCodeUploadParamsAddresses(t *testing.T) {
 		src             types.MsgAddCodeUploadParamsAddresses
 		uploadConfig    types.AccessConfig
 		expUploadConfig types.AccessConfig
+		maxAddressCount uint64
 		expErr          bool
 	}{
 		"authority can add addresses when permission is any of addresses": {
@@ -204,6 +307,16 @@ func TestAddCodeUploadParamsAddresses(t *testing.T) {
 			expUploadConfig: types.AccessTypeAnyOfAddresses.With(myAddress),
 			expErr:          true,
 		},
+		"authority cannot add addresses beyond max address count": {
+			src: types.MsgAddCodeUploadParamsAddresses{
+				Authority: govAuthority,
+				Addresses: []string{otherAddr.String()},
+			},
+			uploadConfig:    types.AccessTypeAnyOfAddresses.With(myAddress),
+			expUploadConfig: types.AccessTypeAnyOfAddresses.With(myAddress),
+			maxAddressCount: 1,
+			expErr:          true,
+		},
 		"other address cannot add addresses when permission is any of addresses": {
 			src: types.MsgAddCodeUploadParamsAddresses{
 				Authority: otherAddr.String(),
@@ -219,6 +332,7 @@ func TestAddCodeUploadParamsAddresses(t *testing.T) {
 			err := wasmApp.WasmKeeper.SetParams(ctx, types.Params{
 				CodeUploadAccess:             spec.uploadConfig,
 				InstantiateDefaultPermission: types.AccessTypeEverybody,
+				MaxAddressCount:              spec.maxAddressCount,
 			})
 			require.NoError(t, err)
 
@@ -1227,3 +1341,736 @@ func TestUpdateContractLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteCode(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false)
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"authority can delete code": {
+			addr:   authority,
+			expErr: false,
+		},
+		"creator can delete code": {
+			addr:   myAddress.String(),
+			expErr: false,
+		},
+		"other address cannot delete code": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = wasmContract
+				m.Sender = myAddress.String()
+			})
+
+			// store code
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var result types.MsgStoreCodeResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+
+			// when
+			msgDeleteCode := &types.MsgDeleteCode{
+				Sender: spec.addr,
+				CodeID: result.CodeID,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgDeleteCode)(ctx, msgDeleteCode)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.NotNil(t, wasmApp.WasmKeeper.GetCodeInfo(ctx, result.CodeID))
+			} else {
+				require.NoError(t, err)
+				assert.Nil(t, wasmApp.WasmKeeper.GetCodeInfo(ctx, result.CodeID))
+			}
+		})
+	}
+}
+
+func TestFreezeContract(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                = wasmApp.WasmKeeper.GetAuthority()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"authority can freeze and unfreeze contract": {
+			addr:   authority,
+			expErr: false,
+		},
+		"other address cannot freeze contract": {
+			addr:   myAddress.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr, err := sdk.AccAddressFromBech32(storeAndInstantiateResponse.Address)
+			require.NoError(t, err)
+
+			// when
+			msgFreeze := &types.MsgFreezeContract{
+				Authority: spec.addr,
+				Contract:  storeAndInstantiateResponse.Address,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgFreeze)(ctx, msgFreeze)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.False(t, wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).Frozen)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).Frozen)
+
+			// and when
+			msgUnfreeze := &types.MsgUnfreezeContract{
+				Authority: spec.addr,
+				Contract:  storeAndInstantiateResponse.Address,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgUnfreeze)(ctx, msgUnfreeze)
+
+			// then
+			require.NoError(t, err)
+			assert.False(t, wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).Frozen)
+		})
+	}
+}
+
+func TestRegisterAndCancelCallback(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress    sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		otherAddress sdk.AccAddress = append([]byte{1}, make([]byte, types.ContractAddrLen-1)...)
+		authority                   = wasmApp.WasmKeeper.GetAuthority()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"admin can register and cancel callback": {
+			addr:   myAddress.String(),
+			expErr: false,
+		},
+		"authority can register and cancel callback": {
+			addr:   authority,
+			expErr: false,
+		},
+		"other address cannot register callback": {
+			addr:   otherAddress.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr, err := sdk.AccAddressFromBech32(storeAndInstantiateResponse.Address)
+			require.NoError(t, err)
+
+			// when
+			msgRegister := &types.MsgRegisterCallback{
+				Sender:      spec.addr,
+				Contract:    storeAndInstantiateResponse.Address,
+				Msg:         []byte(`{}`),
+				StartHeight: ctx.BlockHeight() + 10,
+				GasLimit:    100_000,
+			}
+			rsp, err = wasmApp.MsgServiceRouter().Handler(msgRegister)(ctx, msgRegister)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				wasmApp.WasmKeeper.IterateCallbacksByContract(ctx, contractAddr, func(c types.ContractCallback) bool {
+					t.Fatalf("unexpected callback: %d", c.ID)
+					return false
+				})
+				return
+			}
+			require.NoError(t, err)
+			var registerResponse types.MsgRegisterCallbackResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &registerResponse))
+			callback := wasmApp.WasmKeeper.GetCallback(ctx, registerResponse.CallbackID)
+			require.NotNil(t, callback)
+			assert.Equal(t, storeAndInstantiateResponse.Address, callback.Contract)
+			assert.Equal(t, spec.addr, callback.Sender)
+
+			// and when
+			msgCancel := &types.MsgCancelCallback{
+				Sender:     spec.addr,
+				CallbackID: registerResponse.CallbackID,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgCancel)(ctx, msgCancel)
+
+			// then
+			require.NoError(t, err)
+			assert.Nil(t, wasmApp.WasmKeeper.GetCallback(ctx, registerResponse.CallbackID))
+		})
+	}
+}
+
+func TestProposeAndAcceptAdmin(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		proposer string
+		acceptor func(newAdmin sdk.AccAddress) string
+		expErr   bool
+	}{
+		"admin proposes, new admin accepts": {
+			proposer: myAddress.String(),
+			acceptor: func(newAdmin sdk.AccAddress) string { return newAdmin.String() },
+		},
+		"authority proposes, new admin accepts": {
+			proposer: authority,
+			acceptor: func(newAdmin sdk.AccAddress) string { return newAdmin.String() },
+		},
+		"admin proposes, authority accepts": {
+			proposer: myAddress.String(),
+			acceptor: func(sdk.AccAddress) string { return authority },
+		},
+		"admin proposes, other address cannot accept": {
+			proposer: myAddress.String(),
+			acceptor: func(sdk.AccAddress) string { return otherAddr.String() },
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			_, _, newAdmin := testdata.KeyTestPubAddr()
+
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				UnpinCode:             false,
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr := sdk.MustAccAddressFromBech32(storeAndInstantiateResponse.Address)
+
+			msgProposeAdmin := &types.MsgProposeAdmin{
+				Sender:   spec.proposer,
+				Contract: storeAndInstantiateResponse.Address,
+				NewAdmin: newAdmin.String(),
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgProposeAdmin)(ctx, msgProposeAdmin)
+			require.NoError(t, err)
+			require.NotNil(t, wasmApp.WasmKeeper.GetPendingAdminTransfer(ctx, contractAddr))
+
+			// when
+			msgAcceptAdmin := &types.MsgAcceptAdmin{
+				Sender:   spec.acceptor(newAdmin),
+				Contract: storeAndInstantiateResponse.Address,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgAcceptAdmin)(ctx, msgAcceptAdmin)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Equal(t, myAddress.String(), wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).Admin)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, newAdmin.String(), wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).Admin)
+			assert.Nil(t, wasmApp.WasmKeeper.GetPendingAdminTransfer(ctx, contractAddr))
+		})
+	}
+}
+
+func TestCancelAdminProposal(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+		_, _, newAdmin                 = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"authority can cancel": {
+			addr: authority,
+		},
+		"admin can cancel": {
+			addr: myAddress.String(),
+		},
+		"proposed admin can cancel": {
+			addr: newAdmin.String(),
+		},
+		"other address cannot cancel": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				UnpinCode:             false,
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr := sdk.MustAccAddressFromBech32(storeAndInstantiateResponse.Address)
+
+			msgProposeAdmin := &types.MsgProposeAdmin{
+				Sender:   myAddress.String(),
+				Contract: storeAndInstantiateResponse.Address,
+				NewAdmin: newAdmin.String(),
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgProposeAdmin)(ctx, msgProposeAdmin)
+			require.NoError(t, err)
+
+			// when
+			msgCancel := &types.MsgCancelAdminProposal{
+				Sender:   spec.addr,
+				Contract: storeAndInstantiateResponse.Address,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgCancel)(ctx, msgCancel)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.NotNil(t, wasmApp.WasmKeeper.GetPendingAdminTransfer(ctx, contractAddr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Nil(t, wasmApp.WasmKeeper.GetPendingAdminTransfer(ctx, contractAddr))
+			assert.Equal(t, myAddress.String(), wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).Admin)
+		})
+	}
+}
+
+func TestMigrateContractWithDelay(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now(), Height: 1})
+
+	var (
+		myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                = wasmApp.WasmKeeper.GetAuthority()
+	)
+
+	specs := map[string]struct {
+		addr     string
+		expQueue bool
+	}{
+		"admin migration is queued": {
+			addr:     myAddress.String(),
+			expQueue: true,
+		},
+		"authority migration is executed immediately": {
+			addr: authority,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			_, _, sender := testdata.KeyTestPubAddr()
+			msg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = hackatomContract
+				m.Sender = sender.String()
+			})
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeCodeResponse types.MsgStoreCodeResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeCodeResponse))
+
+			initMsgBz, err := json.Marshal(keeper.HackatomExampleInitMsg{
+				Verifier:    sender,
+				Beneficiary: myAddress,
+			})
+			require.NoError(t, err)
+			msgInstantiate := &types.MsgInstantiateContract{
+				Sender: sender.String(),
+				Admin:  myAddress.String(),
+				CodeID: storeCodeResponse.CodeID,
+				Label:  "test",
+				Msg:    initMsgBz,
+				Funds:  sdk.Coins{},
+			}
+			rsp, err = wasmApp.MsgServiceRouter().Handler(msgInstantiate)(ctx, msgInstantiate)
+			require.NoError(t, err)
+			var instantiateResponse types.MsgInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &instantiateResponse))
+			contractAddr := sdk.MustAccAddressFromBech32(instantiateResponse.Address)
+
+			msgUpdateDelay := &types.MsgUpdateMigrationDelay{
+				Sender:         myAddress.String(),
+				Contract:       instantiateResponse.Address,
+				MigrationDelay: 10,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgUpdateDelay)(ctx, msgUpdateDelay)
+			require.NoError(t, err)
+
+			// when
+			migMsgBz, err := json.Marshal(struct {
+				Verifier sdk.AccAddress `json:"verifier"`
+			}{Verifier: myAddress})
+			require.NoError(t, err)
+			msgMigrateContract := &types.MsgMigrateContract{
+				Sender:   spec.addr,
+				Msg:      migMsgBz,
+				Contract: instantiateResponse.Address,
+				CodeID:   storeCodeResponse.CodeID,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgMigrateContract)(ctx, msgMigrateContract)
+
+			// then
+			require.NoError(t, err)
+			history := wasmApp.WasmKeeper.GetContractHistory(ctx, contractAddr)
+			if !spec.expQueue {
+				assert.Nil(t, wasmApp.WasmKeeper.GetPendingMigration(ctx, contractAddr))
+				assert.Len(t, history, 2)
+				return
+			}
+			assert.Len(t, history, 1)
+			pending := wasmApp.WasmKeeper.GetPendingMigration(ctx, contractAddr)
+			require.NotNil(t, pending)
+			assert.Equal(t, ctx.BlockHeight()+10, pending.ExecuteHeight)
+
+			// and executed in the end blocker
+			wasmApp.WasmKeeper.ExecuteDueMigrations(ctx.WithBlockHeight(pending.ExecuteHeight))
+			assert.Nil(t, wasmApp.WasmKeeper.GetPendingMigration(ctx, contractAddr))
+			assert.Len(t, wasmApp.WasmKeeper.GetContractHistory(ctx, contractAddr), 2)
+		})
+	}
+}
+
+func TestMigrateContractsByCode(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now(), Height: 1})
+
+	var (
+		myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                = wasmApp.WasmKeeper.GetAuthority()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"authority can migrate contracts by code": {
+			addr: authority,
+		},
+		"other address cannot migrate contracts by code": {
+			addr:   myAddress.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			_, _, sender := testdata.KeyTestPubAddr()
+			var codeIDs []uint64
+			for range 2 {
+				msg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+					m.WASMByteCode = hackatomContract
+					m.Sender = sender.String()
+				})
+				rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+				require.NoError(t, err)
+				var storeCodeResponse types.MsgStoreCodeResponse
+				require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeCodeResponse))
+				codeIDs = append(codeIDs, storeCodeResponse.CodeID)
+			}
+
+			initMsgBz, err := json.Marshal(keeper.HackatomExampleInitMsg{
+				Verifier:    sender,
+				Beneficiary: myAddress,
+			})
+			require.NoError(t, err)
+			var contracts []sdk.AccAddress
+			for range 2 {
+				msgInstantiate := &types.MsgInstantiateContract{
+					Sender: sender.String(),
+					Admin:  myAddress.String(),
+					CodeID: codeIDs[0],
+					Label:  "test",
+					Msg:    initMsgBz,
+					Funds:  sdk.Coins{},
+				}
+				rsp, err := wasmApp.MsgServiceRouter().Handler(msgInstantiate)(ctx, msgInstantiate)
+				require.NoError(t, err)
+				var instantiateResponse types.MsgInstantiateContractResponse
+				require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &instantiateResponse))
+				contracts = append(contracts, sdk.MustAccAddressFromBech32(instantiateResponse.Address))
+			}
+
+			// when
+			migMsgBz, err := json.Marshal(struct {
+				Verifier sdk.AccAddress `json:"verifier"`
+			}{Verifier: myAddress})
+			require.NoError(t, err)
+			msgMigrate := &types.MsgMigrateContractsByCode{
+				Authority:  spec.addr,
+				FromCodeID: codeIDs[0],
+				ToCodeID:   codeIDs[1],
+				Msg:        migMsgBz,
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msgMigrate)(ctx, msgMigrate)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				for _, c := range contracts {
+					assert.Equal(t, codeIDs[0], wasmApp.WasmKeeper.GetContractInfo(ctx, c).CodeID)
+				}
+				return
+			}
+			require.NoError(t, err)
+			var migrateResponse types.MsgMigrateContractsByCodeResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &migrateResponse))
+			require.Len(t, migrateResponse.Results, 2)
+			for _, r := range migrateResponse.Results {
+				assert.True(t, r.Success, r.Error)
+			}
+			for _, c := range contracts {
+				assert.Equal(t, codeIDs[1], wasmApp.WasmKeeper.GetContractInfo(ctx, c).CodeID)
+			}
+		})
+	}
+}
+
+func TestSponsorAndClearContractStorage(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		sponsor string
+		addr    string
+		expErr  bool
+	}{
+		"authority can clear": {
+			sponsor: myAddress.String(),
+			addr:    authority,
+		},
+		"admin can clear": {
+			sponsor: authority,
+			addr:    myAddress.String(),
+		},
+		"sponsor can clear": {
+			sponsor: authority,
+			addr:    authority,
+		},
+		"other address cannot clear": {
+			sponsor: myAddress.String(),
+			addr:    otherAddr.String(),
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				UnpinCode:             false,
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr := sdk.MustAccAddressFromBech32(storeAndInstantiateResponse.Address)
+
+			msgSponsor := &types.MsgSponsorContractStorage{
+				Sender:   otherAddr.String(),
+				Contract: storeAndInstantiateResponse.Address,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgSponsor)(ctx, msgSponsor)
+			require.Error(t, err, "only the admin or authority can sponsor")
+			msgSponsor.Sender = spec.sponsor
+			_, err = wasmApp.MsgServiceRouter().Handler(msgSponsor)(ctx, msgSponsor)
+			require.NoError(t, err)
+			require.Equal(t, spec.sponsor, wasmApp.WasmKeeper.GetContractStorageUsage(ctx, contractAddr).Sponsor)
+
+			// when
+			msgClear := &types.MsgClearContractStorageSponsor{
+				Sender:   spec.addr,
+				Contract: storeAndInstantiateResponse.Address,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgClear)(ctx, msgClear)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Equal(t, spec.sponsor, wasmApp.WasmKeeper.GetContractStorageUsage(ctx, contractAddr).Sponsor)
+				return
+			}
+			require.NoError(t, err)
+			assert.Empty(t, wasmApp.WasmKeeper.GetContractStorageUsage(ctx, contractAddr).Sponsor)
+		})
+	}
+}
+
+func TestUpdateStateQuota(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		addr         string
+		maxStateSize uint64
+		expErr       bool
+	}{
+		"authority can raise": {
+			addr:         authority,
+			maxStateSize: 2000,
+		},
+		"authority can remove": {
+			addr: authority,
+		},
+		"admin can lower": {
+			addr:         myAddress.String(),
+			maxStateSize: 500,
+		},
+		"admin cannot raise": {
+			addr:         myAddress.String(),
+			maxStateSize: 2000,
+			expErr:       true,
+		},
+		"other address cannot lower": {
+			addr:         otherAddr.String(),
+			maxStateSize: 500,
+			expErr:       true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				UnpinCode:             false,
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr := sdk.MustAccAddressFromBech32(storeAndInstantiateResponse.Address)
+
+			msgSetQuota := &types.MsgUpdateStateQuota{
+				Sender:       myAddress.String(),
+				Contract:     storeAndInstantiateResponse.Address,
+				MaxStateSize: 1000,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgSetQuota)(ctx, msgSetQuota)
+			require.NoError(t, err)
+
+			// when
+			msgUpdate := &types.MsgUpdateStateQuota{
+				Sender:       spec.addr,
+				Contract:     storeAndInstantiateResponse.Address,
+				MaxStateSize: spec.maxStateSize,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgUpdate)(ctx, msgUpdate)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Equal(t, uint64(1000), wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).MaxStateSize)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.maxStateSize, wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).MaxStateSize)
+		})
+	}
+}
@@ -178,6 +178,42 @@ func TestReflectGrpcQuery(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWasmAcceptedGrpcQueries(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, cmtproto.Header{Time: time.Now()})
+	contractKeeper := wasmKeeper.NewDefaultPermissionKeeper(wasmApp.WasmKeeper)
+	creator := wasmKeeper.RandomAccountAddress(t)
+
+	codeID, _, err := contractKeeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	contractAddr, _, err := contractKeeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "reflect contract 1", nil)
+	require.NoError(t, err)
+
+	queryBz, err := proto.Marshal(&types.QueryContractStorageUsageRequest{Address: contractAddr.String()})
+	require.NoError(t, err)
+	reflectQueryBz, err := json.Marshal(testdata.ReflectQueryMsg{
+		Chain: &testdata.ChainQuery{Request: &wasmvmtypes.QueryRequest{
+			Grpc: &wasmvmtypes.GrpcQuery{
+				Path: "/cosmwasm.wasm.v1.Query/ContractStorageUsage",
+				Data: queryBz,
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	// when
+	reflectRespBz, err := wasmApp.WasmKeeper.QuerySmart(ctx, contractAddr, reflectQueryBz)
+
+	// then
+	require.NoError(t, err)
+	var reflectResp testdata.ChainResponse
+	mustUnmarshal(t, reflectRespBz, &reflectResp)
+	var gotRsp types.QueryContractStorageUsageResponse
+	require.NoError(t, proto.Unmarshal(reflectResp.Data, &gotRsp))
+	assert.Equal(t, wasmApp.WasmKeeper.GetContractStorageUsage(ctx, contractAddr), gotRsp.StorageUsage)
+	assert.NotZero(t, gotRsp.StorageUsage.StoredBytes)
+}
+
 func TestReflectTotalSupplyQuery(t *testing.T) {
 	cdc := wasmKeeper.MakeEncodingConfig(t).Codec
 	ctx, keepers := wasmKeeper.CreateTestInput(t, false, ReflectCapabilities, wasmKeeper.WithMessageEncoders(reflectEncoders(cdc)), wasmKeeper.WithQueryPlugins(reflectPlugins()))
@@ -53,6 +53,10 @@ func SubmitProposalCmd() *cobra.Command {
 		ProposalAddCodeUploadParamsAddresses(),
 		ProposalRemoveCodeUploadParamsAddresses(),
 		ProposalStoreAndMigrateContractCmd(),
+		ProposalDeleteCodeCmd(),
+		ProposalFreezeContractCmd(),
+		ProposalUnfreezeContractCmd(),
+		ProposalMigrateContractsByCodeCmd(),
 	)
 	return cmd
 }
@@ -603,6 +607,137 @@ func ProposalClearContractAdminCmd() *cobra.Command {
 	return cmd
 }
 
+func ProposalDeleteCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-code [code_id_int64] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to delete a code that is not used by any contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDeleteCode{
+				Sender: authority,
+				CodeID: codeID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalFreezeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-contract [contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to reject any execution of a contract until it is unfrozen",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg := types.MsgFreezeContract{
+				Authority: authority,
+				Contract:  args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalUnfreezeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-contract [contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to allow the execution of a frozen contract again",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg := types.MsgUnfreezeContract{
+				Authority: authority,
+				Contract:  args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
 func ProposalPinCodesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pin-codes [code-ids] --title [text] --summary [text] --authority [address]",
@@ -991,3 +1126,68 @@ func ProposalStoreAndMigrateContractCmd() *cobra.Command {
 	addCommonProposalFlags(cmd)
 	return cmd
 }
+
+func ProposalMigrateContractsByCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-contracts-by-code [from_code_id_int64] [to_code_id_int64] [json_encoded_migration_args] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to migrate a batch of contracts of a code to a new code version",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			fromCodeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("from code id: %s", err)
+			}
+			toCodeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("to code id: %s", err)
+			}
+			limit, err := cmd.Flags().GetUint64(flagBatchLimit)
+			if err != nil {
+				return fmt.Errorf("limit: %s", err)
+			}
+			startAfter, err := cmd.Flags().GetString(flagStartAfter)
+			if err != nil {
+				return fmt.Errorf("start after: %s", err)
+			}
+
+			msg := types.MsgMigrateContractsByCode{
+				Authority:  authority,
+				FromCodeID: fromCodeID,
+				ToCodeID:   toCodeID,
+				Msg:        []byte(args[2]),
+				Limit:      limit,
+				StartAfter: startAfter,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Uint64(flagBatchLimit, 0, "Max number of contracts migrated. Zero for the max batch size")
+	cmd.Flags().String(flagStartAfter, "", "Address of the last contract of a previous batch")
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
@@ -1,15 +1,18 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -185,3 +188,376 @@ func UpdateContractLabelCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// DeleteCodeCmd removes a code that is not used by any contract anymore
+func DeleteCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-code [code_id_int64]",
+		Short: "Delete a code that is not used by any contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDeleteCode{
+				Sender: clientCtx.GetFromAddress().String(),
+				CodeID: codeID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RegisterCallbackCmd schedules a sudo call into a contract at the end of a block
+func RegisterCallbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-callback [contract_addr_bech32] [json_encoded_sudo_msg]",
+		Short: "Schedule a sudo call into a contract at the end of a block",
+		Long: `Schedule a sudo call into a contract at the end of the start height block. With an interval, the call
+is repeated every interval blocks until the escrow does not cover the fee anymore or the callback is canceled.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg, err := parseRegisterCallbackArgs(args, clientCtx.GetFromAddress().String(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Int64(flagStartHeight, 0, "Block height of the first execution")
+	cmd.Flags().Uint64(flagInterval, 0, "Number of blocks between executions. Zero for a one time callback")
+	cmd.Flags().Uint64(flagCallbackGasLimit, 0, "Max gas for a single execution")
+	cmd.Flags().String(flagCallbackFee, "", "Coins charged from the escrow for each execution")
+	cmd.Flags().String(flagEscrow, "", "Coins to escrow for the execution fees")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseRegisterCallbackArgs(args []string, sender string, flags *flag.FlagSet) (types.MsgRegisterCallback, error) {
+	startHeight, err := flags.GetInt64(flagStartHeight)
+	if err != nil {
+		return types.MsgRegisterCallback{}, fmt.Errorf("start height: %s", err)
+	}
+	interval, err := flags.GetUint64(flagInterval)
+	if err != nil {
+		return types.MsgRegisterCallback{}, fmt.Errorf("interval: %s", err)
+	}
+	gasLimit, err := flags.GetUint64(flagCallbackGasLimit)
+	if err != nil {
+		return types.MsgRegisterCallback{}, fmt.Errorf("callback gas: %s", err)
+	}
+	feeStr, err := flags.GetString(flagCallbackFee)
+	if err != nil {
+		return types.MsgRegisterCallback{}, fmt.Errorf("callback fee: %s", err)
+	}
+	fee, err := sdk.ParseCoinsNormalized(feeStr)
+	if err != nil {
+		return types.MsgRegisterCallback{}, fmt.Errorf("callback fee: %s", err)
+	}
+	escrowStr, err := flags.GetString(flagEscrow)
+	if err != nil {
+		return types.MsgRegisterCallback{}, fmt.Errorf("escrow: %s", err)
+	}
+	escrow, err := sdk.ParseCoinsNormalized(escrowStr)
+	if err != nil {
+		return types.MsgRegisterCallback{}, fmt.Errorf("escrow: %s", err)
+	}
+	return types.MsgRegisterCallback{
+		Sender:      sender,
+		Contract:    args[0],
+		Msg:         []byte(args[1]),
+		StartHeight: startHeight,
+		Interval:    interval,
+		GasLimit:    gasLimit,
+		Fee:         fee,
+		Escrow:      escrow,
+	}, nil
+}
+
+// CancelCallbackCmd removes a scheduled callback and refunds the remaining escrow
+func CancelCallbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-callback [callback_id_int64]",
+		Short: "Cancel a scheduled callback and refund the remaining escrow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			callbackID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelCallback{
+				Sender:     clientCtx.GetFromAddress().String(),
+				CallbackID: callbackID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ProposeContractAdminCmd proposes a new admin for a contract that has to accept the transfer
+func ProposeContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-admin [contract_addr_bech32] [new_admin_addr_bech32]",
+		Short: "Propose a new admin for a contract. The new admin must accept the transfer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			expiryHeight, err := cmd.Flags().GetUint64(flagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgProposeAdmin{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Contract:     args[0],
+				NewAdmin:     args[1],
+				ExpiryHeight: expiryHeight,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Uint64(flagExpiryHeight, 0, "Last block height at which the transfer can be accepted. Zero for no expiry")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// AcceptContractAdminCmd accepts a pending admin transfer for a contract
+func AcceptContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-admin [contract_addr_bech32]",
+		Short: "Accept a pending admin transfer for a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAcceptAdmin{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelAdminProposalCmd cancels a pending admin transfer for a contract
+func CancelAdminProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-admin-proposal [contract_addr_bech32]",
+		Short: "Cancel a pending admin transfer for a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelAdminProposal{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateMigrationDelayCmd sets the number of blocks a migration by the admin is queued
+func UpdateMigrationDelayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-migration-delay [contract_addr_bech32] [blocks]",
+		Short: "Set the number of blocks a migration by the admin is queued before it is executed",
+		Long: "Set the number of blocks a migration by the admin is queued before it is executed. " +
+			"The delay can only be lowered by the governance authority.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delay, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(err, "blocks")
+			}
+
+			msg := types.MsgUpdateMigrationDelay{
+				Sender:         clientCtx.GetFromAddress().String(),
+				Contract:       args[0],
+				MigrationDelay: delay,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelMigrationCmd cancels a pending migration for a contract
+func CancelMigrationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-migration [contract_addr_bech32]",
+		Short: "Cancel a pending migration for a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelMigration{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SponsorContractStorageCmd makes the sender pay the storage deposit of a contract
+func SponsorContractStorageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor-storage [contract_addr_bech32]",
+		Short: "Pay the storage deposit of a contract instead of the contract balance. Requires the contract admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSponsorContractStorage{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ClearContractStorageSponsorCmd refunds the storage deposit to the sponsor of a contract
+func ClearContractStorageSponsorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-storage-sponsor [contract_addr_bech32]",
+		Short: "Refund the storage deposit to the sponsor of a contract and remove the sponsor",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgClearContractStorageSponsor{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateStateQuotaCmd sets the maximum number of bytes a contract can store in its state
+func UpdateStateQuotaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-state-quota [contract_addr_bech32] [max_bytes]",
+		Short: "Set the maximum number of bytes a contract can store in its state",
+		Long: "Set the maximum number of bytes a contract can store in its state. Zero for no limit. " +
+			"The quota can only be raised or removed by the governance authority.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			maxStateSize, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(err, "max bytes")
+			}
+
+			msg := types.MsgUpdateStateQuota{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Contract:     args[0],
+				MaxStateSize: maxStateSize,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -44,6 +44,16 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdBuildAddress(),
 		GetCmdListContractsByCreator(),
+		GetCmdListContractsByAdmin(),
+		GetCmdListContractsByLabel(),
+		GetCmdListContractCallbacks(),
+		GetCmdQueryCallback(),
+		GetCmdQueryPendingAdminTransfer(),
+		GetCmdListPendingAdminTransfers(),
+		GetCmdQueryPendingMigration(),
+		GetCmdListCodesByChecksum(),
+		GetCmdQueryGasRegister(),
+		GetCmdQueryContractStorageUsage(),
 	)
 	return queryCmd
 }
@@ -108,7 +118,7 @@ func GetCmdListCode() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "list-code",
 		Short:   "List all wasm bytecode on the chain",
-		Long:    "List all wasm bytecode on the chain",
+		Long:    "List all wasm bytecode on the chain. The codes can be filtered by creator, checksum, instantiate access type and pin status.",
 		Aliases: []string{"list-codes", "codes", "lco"},
 		Args:    cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -121,12 +131,15 @@ func GetCmdListCode() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			req, err := parseCodesFilterFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req.Pagination = pageReq
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.Codes(
 				context.Background(),
-				&types.QueryCodesRequest{
-					Pagination: pageReq,
-				},
+				req,
 			)
 			if err != nil {
 				return err
@@ -135,11 +148,63 @@ func GetCmdListCode() *cobra.Command {
 		},
 		SilenceUsage: true,
 	}
+	cmd.Flags().String(flagCreator, "", "Only codes stored by this creator address")
+	cmd.Flags().String(flagChecksum, "", "Only codes with this hex encoded checksum")
+	cmd.Flags().String(flagAccessType, "", "Only codes with this instantiate access type: Nobody, Everybody or AnyOfAddresses")
+	cmd.Flags().Bool(flagPinned, false, "Only codes that are pinned")
+	cmd.Flags().Bool(flagUnpinned, false, "Only codes that are not pinned")
+	cmd.MarkFlagsMutuallyExclusive(flagPinned, flagUnpinned)
 	flags.AddQueryFlagsToCmd(cmd)
 	addPaginationFlags(cmd, "list codes")
 	return cmd
 }
 
+// parseCodesFilterFlags returns a codes request with the filters of the list-code flags
+func parseCodesFilterFlags(flags *flag.FlagSet) (*types.QueryCodesRequest, error) {
+	var req types.QueryCodesRequest
+	creator, err := flags.GetString(flagCreator)
+	if err != nil {
+		return nil, fmt.Errorf("creator: %s", err)
+	}
+	if creator != "" {
+		if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+			return nil, fmt.Errorf("creator: %s", err)
+		}
+		req.Creator = creator
+	}
+	checksum, err := flags.GetString(flagChecksum)
+	if err != nil {
+		return nil, fmt.Errorf("checksum: %s", err)
+	}
+	if req.Checksum, err = hex.DecodeString(checksum); err != nil {
+		return nil, fmt.Errorf("checksum: %s", err)
+	}
+	accessType, err := flags.GetString(flagAccessType)
+	if err != nil {
+		return nil, fmt.Errorf("access type: %s", err)
+	}
+	if accessType != "" {
+		if err := req.InstantiateAccessType.UnmarshalText([]byte(accessType)); err != nil || req.InstantiateAccessType == types.AccessTypeUnspecified {
+			return nil, fmt.Errorf("access type: unknown %q", accessType)
+		}
+	}
+	pinned, err := flags.GetBool(flagPinned)
+	if err != nil {
+		return nil, fmt.Errorf("pinned: %s", err)
+	}
+	unpinned, err := flags.GetBool(flagUnpinned)
+	if err != nil {
+		return nil, fmt.Errorf("unpinned: %s", err)
+	}
+	switch {
+	case pinned:
+		req.PinStatus = types.PinStatusPinned
+	case unpinned:
+		req.PinStatus = types.PinStatusUnpinned
+	}
+	return &req, nil
+}
+
 // GetCmdListContractByCode lists all wasm code uploaded for given code id
 func GetCmdListContractByCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -315,6 +380,7 @@ func GetCmdGetContractState() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdGetContractStateAll(),
 		GetCmdGetContractStateRaw(),
+		GetCmdGetContractStateRange(),
 		GetCmdGetContractStateSmart(),
 	)
 	return cmd
@@ -403,6 +469,65 @@ func GetCmdGetContractStateRaw() *cobra.Command {
 	return cmd
 }
 
+func GetCmdGetContractStateRange() *cobra.Command {
+	decoder := newArgDecoder(hex.DecodeString)
+	cmd := &cobra.Command{
+		Use:   "range [bech32_address]",
+		Short: "Prints out internal state for a range of keys of a contract given its address",
+		Long:  "Prints out internal state for a range of keys of a contract given its address. The range is narrowed to the keys with the prefix when set.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			keys := make(map[string][]byte, 3)
+			for _, name := range []string{flagPrefix, flagStart, flagEnd} {
+				v, err := cmd.Flags().GetString(name)
+				if err != nil {
+					return err
+				}
+				if keys[name], err = decoder.DecodeString(v); err != nil {
+					return fmt.Errorf("decode %s: %s", name, err)
+				}
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RawContractStateRange(
+				context.Background(),
+				&types.QueryRawContractStateRangeRequest{
+					Address:    args[0],
+					Prefix:     keys[flagPrefix],
+					Start:      keys[flagStart],
+					End:        keys[flagEnd],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	decoder.RegisterFlags(cmd.PersistentFlags(), "keys")
+	cmd.Flags().String(flagPrefix, "", "Only keys with this prefix")
+	cmd.Flags().String(flagStart, "", "First key of the range, inclusive")
+	cmd.Flags().String(flagEnd, "", "End of the range, exclusive")
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "contract state")
+	return cmd
+}
+
 func GetCmdGetContractStateSmart() *cobra.Command {
 	decoder := newArgDecoder(asciiDecodeString)
 	cmd := &cobra.Command{
@@ -574,6 +699,272 @@ func GetCmdListContractsByCreator() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListContractsByAdmin lists all contracts by admin
+func GetCmdListContractsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-admin [admin]",
+		Short: "List all contracts by admin",
+		Long:  "List all contracts that can be migrated or updated by the admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByAdmin(
+				context.Background(),
+				&types.QueryContractsByAdminRequest{
+					AdminAddress: args[0],
+					Pagination:   pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list contracts by admin")
+	return cmd
+}
+
+// GetCmdListContractsByLabel lists all contracts by label
+func GetCmdListContractsByLabel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-label [label]",
+		Short: "List all contracts by label",
+		Long:  "List all contracts with the exact label or with a label that starts with it when the prefix flag is set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			labelPrefix, err := cmd.Flags().GetBool(flagPrefix)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByLabel(
+				context.Background(),
+				&types.QueryContractsByLabelRequest{
+					Label:      args[0],
+					Prefix:     labelPrefix,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Bool(flagPrefix, false, "Match all labels that start with the label")
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list contracts by label")
+	return cmd
+}
+
+// GetCmdListContractCallbacks lists the scheduled callbacks of a contract
+func GetCmdListContractCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-callbacks [bech32_address]",
+		Short: "List all scheduled callbacks of a contract",
+		Long:  "List all scheduled callbacks of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractCallbacks(
+				context.Background(),
+				&types.QueryContractCallbacksRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list contract callbacks")
+	return cmd
+}
+
+// GetCmdQueryCallback returns a scheduled callback by id
+func GetCmdQueryCallback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "callback [callback_id]",
+		Short: "Prints out a scheduled callback",
+		Long:  "Prints out a scheduled callback",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			callbackID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Callback(
+				context.Background(),
+				&types.QueryCallbackRequest{
+					CallbackId: callbackID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPendingAdminTransfer returns the pending admin transfer of a contract
+func GetCmdQueryPendingAdminTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-admin [bech32_address]",
+		Short: "Prints out the pending admin transfer of a contract",
+		Long:  "Prints out the pending admin transfer of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingAdminTransfer(
+				context.Background(),
+				&types.QueryPendingAdminTransferRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListPendingAdminTransfers lists all pending admin transfers
+func GetCmdListPendingAdminTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pending-admins",
+		Short: "List all pending contract admin transfers",
+		Long:  "List all pending contract admin transfers",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingAdminTransfers(
+				context.Background(),
+				&types.QueryPendingAdminTransfersRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list pending admin transfers")
+	return cmd
+}
+
+// GetCmdQueryPendingMigration returns the queued migration of a contract
+func GetCmdQueryPendingMigration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-migration [bech32_address]",
+		Short: "Prints out the queued migration of a contract",
+		Long:  "Prints out the queued migration of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingMigration(
+				context.Background(),
+				&types.QueryPendingMigrationRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 type argumentDecoder struct {
 	// dec is the default decoder
 	dec                func(string) ([]byte, error)
@@ -670,3 +1061,103 @@ func addPaginationFlags(cmd *cobra.Command, query string) {
 	cmd.Flags().Uint64(flags.FlagLimit, 100, fmt.Sprintf("pagination limit of %s to query for", query))
 	cmd.Flags().Bool(flags.FlagReverse, false, "results are sorted in descending order")
 }
+
+// GetCmdListCodesByChecksum lists all code ids that reference the given checksum
+func GetCmdListCodesByChecksum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-codes-by-checksum [checksum_hex]",
+		Short: "List all code ids with the given checksum",
+		Long:  "List all code ids with the given checksum",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			checksum, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("checksum: %s", err)
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodesByChecksum(
+				context.Background(),
+				&types.QueryCodesByChecksumRequest{
+					Checksum:   checksum,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list codes by checksum")
+	return cmd
+}
+
+// GetCmdQueryGasRegister queries the costs that are used to charge contract executions
+func GetCmdQueryGasRegister() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-register",
+		Short: "Query the effective gas costs for contract executions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.WasmGasRegister(cmd.Context(), &types.QueryWasmGasRegisterRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.GasRegister)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContractStorageUsage prints the storage usage of a contract
+func GetCmdQueryContractStorageUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-usage [bech32_address]",
+		Short: "Prints out the size of the contract state and the deposit held for it",
+		Long:  "Prints out the size of the contract state and the deposit held for it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractStorageUsage(
+				context.Background(),
+				&types.QueryContractStorageUsageRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
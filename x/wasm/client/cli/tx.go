@@ -48,6 +48,25 @@ const (
 	flagNoTokenTransfer           = "no-token-transfer"
 	flagAuthority                 = "authority"
 	flagExpedite                  = "expedite"
+	flagStartHeight               = "start-height"
+	flagInterval                  = "interval"
+	flagCallbackGasLimit          = "callback-gas"
+	flagCallbackFee               = "callback-fee"
+	flagEscrow                    = "escrow"
+	flagExpiryHeight              = "expiry-height"
+	flagBatchLimit                = "limit"
+	flagStartAfter                = "start-after"
+	flagReuseExisting             = "reuse-existing"
+	flagUploadID                  = "upload-id"
+	flagChunkIndex                = "index"
+	flagPrefix                    = "prefix"
+	flagStart                     = "start"
+	flagEnd                       = "end"
+	flagCreator                   = "creator"
+	flagChecksum                  = "checksum"
+	flagAccessType                = "access-type"
+	flagPinned                    = "pinned"
+	flagUnpinned                  = "unpinned"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -62,8 +81,11 @@ func GetTxCmd() *cobra.Command {
 	}
 	txCmd.AddCommand(
 		StoreCodeCmd(),
+		StoreCodeChunkCmd(),
+		FinalizeCodeUploadCmd(),
 		InstantiateContractCmd(),
 		InstantiateContract2Cmd(),
+		InstantiateContractByChecksumCmd(),
 		ExecuteContractCmd(),
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
@@ -72,6 +94,17 @@ func GetTxCmd() *cobra.Command {
 		UpdateInstantiateConfigCmd(),
 		SubmitProposalCmd(),
 		UpdateContractLabelCmd(),
+		DeleteCodeCmd(),
+		RegisterCallbackCmd(),
+		CancelCallbackCmd(),
+		ProposeContractAdminCmd(),
+		AcceptContractAdminCmd(),
+		CancelAdminProposalCmd(),
+		UpdateMigrationDelayCmd(),
+		CancelMigrationCmd(),
+		SponsorContractStorageCmd(),
+		ClearContractStorageSponsorCmd(),
+		UpdateStateQuotaCmd(),
 	)
 	return txCmd
 }
@@ -92,6 +125,100 @@ func StoreCodeCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			msg.ReuseExisting, err = cmd.Flags().GetBool(flagReuseExisting)
+			if err != nil {
+				return fmt.Errorf("reuse existing: %s", err)
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().Bool(flagReuseExisting, false, "Return the id of an existing code with the same checksum and instantiate permission instead of storing a new code")
+	addInstantiatePermissionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// StoreCodeChunkCmd will stage a chunk of a wasm binary that is too large to be uploaded within a single tx
+func StoreCodeChunkCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-chunk [chunk file]",
+		Short: "Upload a chunk of a wasm binary",
+		Long: `Upload a chunk of a raw or gzipped wasm binary that exceeds the max tx size.
+The first chunk starts a new upload session. Further chunks must reference the upload id of the session
+and their position in the upload. The upload is stored as new code with the finalize-upload command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			chunk, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			uploadID, err := cmd.Flags().GetUint64(flagUploadID)
+			if err != nil {
+				return fmt.Errorf("upload id: %s", err)
+			}
+			index, err := cmd.Flags().GetUint64(flagChunkIndex)
+			if err != nil {
+				return fmt.Errorf("index: %s", err)
+			}
+			msg := types.MsgStoreCodeChunk{
+				Sender:   clientCtx.GetFromAddress().String(),
+				UploadID: uploadID,
+				Index:    index,
+				Chunk:    chunk,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().Uint64(flagUploadID, 0, "Upload session to add the chunk to. Zero to start a new session")
+	cmd.Flags().Uint64(flagChunkIndex, 0, "Position of the chunk in the upload")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// FinalizeCodeUploadCmd will store the chunks of an upload session as new wasm code
+func FinalizeCodeUploadCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-upload [upload_id] [checksum_hex]",
+		Short: "Store the chunks of an upload session as new wasm code",
+		Long:  "Store the chunks of an upload session as new wasm code. The checksum is the sha256 hash of the concatenated chunks.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			uploadID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("upload id: %s", err)
+			}
+			checksum, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("checksum: %s", err)
+			}
+			perm, err := parseAccessConfigFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			msg := types.MsgFinalizeCodeUpload{
+				Sender:                clientCtx.GetFromAddress().String(),
+				UploadID:              uploadID,
+				Checksum:              checksum,
+				InstantiatePermission: perm,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 		SilenceUsage: true,
@@ -288,6 +415,56 @@ $ %s tx wasm instantiate2 1 '{"foo":"bar"}' $(echo -n "testing" | xxd -ps) --adm
 	return cmd
 }
 
+// InstantiateContractByChecksumCmd will instantiate a contract from previously uploaded code with the given checksum
+func InstantiateContractByChecksumCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instantiate-by-checksum [checksum_hex] [json_encoded_init_args] --label [text] --admin [address,optional] --amount [coins,optional] ",
+		Short: "Instantiate a wasm contract by the checksum of its code",
+		Long: fmt.Sprintf(`Creates a new instance of an uploaded wasm code with the given 'constructor' message.
+The code is selected by its checksum. When multiple codes share the checksum, the first one the sender is allowed to
+instantiate is used.
+Example:
+$ %s tx wasm instantiate-by-checksum 13a1fc994cc6d1c81b746ee0c0ff6f90043875e0bf1d9be6b7d779fc978dc2a5 '{"foo":"bar"}' \
+  --admin="$(%s keys show mykey -a)" --from mykey --amount="100ustake" --label "local0.1.0"
+`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			checksum, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("checksum: %s", err)
+			}
+			label, admin, amount, err := parseInstantiateFlags(clientCtx.Keyring, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			msg := types.MsgInstantiateContractByChecksum{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Admin:    admin,
+				Checksum: checksum,
+				Label:    label,
+				Msg:      []byte(args[1]),
+				Funds:    amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
+	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
+	cmd.Flags().String(flagAdmin, "", "Address or key name of an admin")
+	cmd.Flags().Bool(flagNoAdmin, false, "You must set this explicitly if you don't want an admin")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func parseInstantiateArgs(rawCodeID, initMsg string, kr keyring.Keyring, sender string, flags *flag.FlagSet) (*types.MsgInstantiateContract, error) {
 	// get the id of the code to instantiate
 	codeID, err := strconv.ParseUint(rawCodeID, 10, 64)
@@ -295,37 +472,56 @@ func parseInstantiateArgs(rawCodeID, initMsg string, kr keyring.Keyring, sender
 		return nil, err
 	}
 
+	label, adminStr, amount, err := parseInstantiateFlags(kr, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	// build and sign the transaction, then broadcast to Tendermint
+	msg := types.MsgInstantiateContract{
+		Sender: sender,
+		CodeID: codeID,
+		Label:  label,
+		Funds:  amount,
+		Msg:    []byte(initMsg),
+		Admin:  adminStr,
+	}
+	return &msg, msg.ValidateBasic()
+}
+
+// parseInstantiateFlags returns the label, admin and funds for an instantiation
+func parseInstantiateFlags(kr keyring.Keyring, flags *flag.FlagSet) (string, string, sdk.Coins, error) {
 	amountStr, err := flags.GetString(flagAmount)
 	if err != nil {
-		return nil, fmt.Errorf("amount: %s", err)
+		return "", "", nil, fmt.Errorf("amount: %s", err)
 	}
 	amount, err := sdk.ParseCoinsNormalized(amountStr)
 	if err != nil {
-		return nil, fmt.Errorf("amount: %s", err)
+		return "", "", nil, fmt.Errorf("amount: %s", err)
 	}
 	label, err := flags.GetString(flagLabel)
 	if err != nil {
-		return nil, fmt.Errorf("label: %s", err)
+		return "", "", nil, fmt.Errorf("label: %s", err)
 	}
 	if label == "" {
-		return nil, errors.New("label is required on all contracts")
+		return "", "", nil, errors.New("label is required on all contracts")
 	}
 	adminStr, err := flags.GetString(flagAdmin)
 	if err != nil {
-		return nil, fmt.Errorf("admin: %s", err)
+		return "", "", nil, fmt.Errorf("admin: %s", err)
 	}
 
 	noAdmin, err := flags.GetBool(flagNoAdmin)
 	if err != nil {
-		return nil, fmt.Errorf("no-admin: %s", err)
+		return "", "", nil, fmt.Errorf("no-admin: %s", err)
 	}
 
 	// ensure sensible admin is set (or explicitly immutable)
 	if adminStr == "" && !noAdmin {
-		return nil, errors.New("you must set an admin or explicitly pass --no-admin to make it immutable (wasmd issue #719)")
+		return "", "", nil, errors.New("you must set an admin or explicitly pass --no-admin to make it immutable (wasmd issue #719)")
 	}
 	if adminStr != "" && noAdmin {
-		return nil, errors.New("you set an admin and passed --no-admin, those cannot both be true")
+		return "", "", nil, errors.New("you set an admin and passed --no-admin, those cannot both be true")
 	}
 
 	if adminStr != "" {
@@ -333,28 +529,18 @@ func parseInstantiateArgs(rawCodeID, initMsg string, kr keyring.Keyring, sender
 		if err != nil {
 			info, err := kr.Key(adminStr)
 			if err != nil {
-				return nil, fmt.Errorf("admin %s", err)
+				return "", "", nil, fmt.Errorf("admin %s", err)
 			}
 			admin, err := info.GetAddress()
 			if err != nil {
-				return nil, err
+				return "", "", nil, err
 			}
 			adminStr = admin.String()
 		} else {
 			adminStr = addr.String()
 		}
 	}
-
-	// build and sign the transaction, then broadcast to Tendermint
-	msg := types.MsgInstantiateContract{
-		Sender: sender,
-		CodeID: codeID,
-		Label:  label,
-		Funds:  amount,
-		Msg:    []byte(initMsg),
-		Admin:  adminStr,
-	}
-	return &msg, msg.ValidateBasic()
+	return label, adminStr, amount, nil
 }
 
 // ExecuteContractCmd will execute a contract method using its address and JSON-encoded arguments.
@@ -0,0 +1,247 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+var (
+	// eventsKeyPrefix indexes the wasm events by contract and position
+	eventsKeyPrefix = []byte{0x01}
+	// contractHistoryKeyPrefix indexes the instantiate and migrate operations by contract and position
+	contractHistoryKeyPrefix = []byte{0x02}
+	// executionsKeyPrefix indexes the execute calls by contract and position
+	executionsKeyPrefix = []byte{0x03}
+	// executionsBySenderKeyPrefix indexes the execute calls by contract, sender and position
+	executionsBySenderKeyPrefix = []byte{0x04}
+	// keysByPositionKeyPrefix indexes the keys of all entries above by position for the pruning
+	keysByPositionKeyPrefix = []byte{0x05}
+)
+
+// typedEventPrefix is the type prefix of the typed wasm events
+const typedEventPrefix = "cosmwasm.wasm.v1."
+
+// positionLen is the length of the position of an event: height, tx index and event index
+const positionLen = 8 + 4 + 4
+
+var _ storetypes.ABCIListener = &Indexer{}
+
+// Indexer indexes the wasm activity of the finalized blocks into a local database: the wasm and custom wasm
+// events, the instantiate and migrate operations and the execute calls by sender. It is registered as ABCI
+// listener of the app. The indexed data is served by the node local Indexer gRPC service, see NewQuerier.
+type Indexer struct {
+	db dbm.DB
+	// retainBlocks is the number of recent blocks that are kept in the index. All blocks are kept when 0.
+	retainBlocks uint64
+}
+
+// NewIndexer constructor. The db is owned by the indexer and closed with it. The entries of blocks older than
+// the last retainBlocks blocks are pruned with each new block. Nothing is pruned when retainBlocks is 0.
+func NewIndexer(db dbm.DB, retainBlocks uint64) *Indexer {
+	return &Indexer{db: db, retainBlocks: retainBlocks}
+}
+
+// Close closes the database
+func (i *Indexer) Close() error {
+	return i.db.Close()
+}
+
+// ListenFinalizeBlock indexes the events of the successful txs of the block and the events of the block itself.
+// The block events, like the ones of the begin and end blockers, are positioned after the txs.
+func (i *Indexer) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	batch := i.db.NewBatch()
+	defer batch.Close()
+	for txIndex, txResult := range res.TxResults {
+		if txResult.IsErr() {
+			continue
+		}
+		var txHash cmtbytes.HexBytes
+		if txIndex < len(req.Txs) {
+			txHash = cmttypes.Tx(req.Txs[txIndex]).Hash()
+		}
+		if err := indexEvents(batch, req.Height, uint32(txIndex), txHash, txResult.Events); err != nil {
+			return err
+		}
+	}
+	if err := indexEvents(batch, req.Height, uint32(len(res.TxResults)), nil, res.Events); err != nil {
+		return err
+	}
+	if i.retainBlocks != 0 && req.Height > int64(i.retainBlocks) {
+		if err := i.prune(batch, req.Height-int64(i.retainBlocks)); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// prune deletes the entries of all blocks up to and including the given height
+func (i *Indexer) prune(batch dbm.Batch, height int64) error {
+	end := append(bytes.Clone(keysByPositionKeyPrefix), position(height+1, 0, 0)...)
+	iter, err := i.db.Iterator(keysByPositionKeyPrefix, end)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := bytes.Clone(iter.Key())
+		if err := batch.Delete(key[len(keysByPositionKeyPrefix)+positionLen:]); err != nil {
+			return err
+		}
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// ListenCommit is a noop as the index is written on finalize block already
+func (i *Indexer) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+func indexEvents(batch dbm.Batch, height int64, txIndex uint32, txHash cmtbytes.HexBytes, events []abci.Event) error {
+	for eventIndex, e := range events {
+		pos := position(height, txIndex, uint32(eventIndex))
+		switch {
+		case e.Type == types.WasmModuleEventType || strings.HasPrefix(e.Type, types.CustomContractEventPrefix):
+			contractAddr, err := sdk.AccAddressFromBech32(attributeValue(e, types.AttributeKeyContractAddr))
+			if err != nil {
+				continue
+			}
+			indexed := types.IndexedEvent{
+				Height:     height,
+				TxHash:     txHash,
+				Type:       e.Type,
+				Attributes: make([]types.IndexedEventAttribute, len(e.Attributes)),
+			}
+			for j, a := range e.Attributes {
+				indexed.Attributes[j] = types.IndexedEventAttribute{Key: a.Key, Value: a.Value}
+			}
+			if err := set(batch, pos, indexKey(eventsKeyPrefix, pos, contractAddr), &indexed); err != nil {
+				return err
+			}
+		case strings.HasPrefix(e.Type, typedEventPrefix):
+			typed, err := sdk.ParseTypedEvent(e)
+			if err != nil {
+				continue
+			}
+			if err := indexTypedEvent(batch, height, txHash, pos, typed); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// indexTypedEvent indexes the contract operations and execute calls. Events with invalid addresses are skipped.
+func indexTypedEvent(batch dbm.Batch, height int64, txHash cmtbytes.HexBytes, pos []byte, typed proto.Message) error {
+	switch evt := typed.(type) {
+	case *types.EventInstantiate:
+		return indexOperation(batch, pos, evt.Contract, types.IndexedContractOperation{
+			Height:    height,
+			TxHash:    txHash,
+			Operation: types.ContractCodeHistoryOperationTypeInit,
+			CodeID:    evt.CodeID,
+			Sender:    evt.Creator,
+		})
+	case *types.EventMigrate:
+		return indexOperation(batch, pos, evt.Contract, types.IndexedContractOperation{
+			Height:    height,
+			TxHash:    txHash,
+			Operation: types.ContractCodeHistoryOperationTypeMigrate,
+			CodeID:    evt.CodeID,
+			Sender:    evt.Sender,
+		})
+	case *types.EventScheduledMigration:
+		if !evt.Success {
+			return nil
+		}
+		return indexOperation(batch, pos, evt.Contract, types.IndexedContractOperation{
+			Height:    height,
+			TxHash:    txHash,
+			Operation: types.ContractCodeHistoryOperationTypeMigrate,
+			CodeID:    evt.CodeID,
+		})
+	case *types.EventExecute:
+		contractAddr, err := sdk.AccAddressFromBech32(evt.Contract)
+		if err != nil {
+			return nil
+		}
+		senderAddr, err := sdk.AccAddressFromBech32(evt.Sender)
+		if err != nil {
+			return nil
+		}
+		indexed := types.IndexedExecution{Height: height, TxHash: txHash, Contract: evt.Contract, Sender: evt.Sender}
+		if err := set(batch, pos, indexKey(executionsKeyPrefix, pos, contractAddr), &indexed); err != nil {
+			return err
+		}
+		return set(batch, pos, indexKey(executionsBySenderKeyPrefix, pos, contractAddr, senderAddr), &indexed)
+	}
+	return nil
+}
+
+func indexOperation(batch dbm.Batch, pos []byte, contract string, op types.IndexedContractOperation) error {
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return nil
+	}
+	return set(batch, pos, indexKey(contractHistoryKeyPrefix, pos, contractAddr), &op)
+}
+
+func attributeValue(e abci.Event, key string) string {
+	for _, a := range e.Attributes {
+		if a.Key == key {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// set stores the entry and adds its key to the position index for the pruning
+func set(batch dbm.Batch, pos, key []byte, value proto.Message) error {
+	bz, err := proto.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if err := batch.Set(key, bz); err != nil {
+		return err
+	}
+	return batch.Set(append(append(bytes.Clone(keysByPositionKeyPrefix), pos...), key...), []byte{})
+}
+
+// position returns the sortable position of an event
+func position(height int64, txIndex, eventIndex uint32) []byte {
+	r := make([]byte, 0, positionLen)
+	r = binary.BigEndian.AppendUint64(r, uint64(height))
+	r = binary.BigEndian.AppendUint32(r, txIndex)
+	return binary.BigEndian.AppendUint32(r, eventIndex)
+}
+
+// heightFromPosition returns the block height of a position
+func heightFromPosition(pos []byte) int64 {
+	return int64(binary.BigEndian.Uint64(pos[:8]))
+}
+
+// indexKey returns the index key for the length prefixed addresses followed by the position
+func indexKey(prefix, pos []byte, addrs ...sdk.AccAddress) []byte {
+	r := append([]byte{}, prefix...)
+	for _, a := range addrs {
+		r = append(r, address.MustLengthPrefix(a)...)
+	}
+	return append(r, pos...)
+}
@@ -0,0 +1,261 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestIndexer(t *testing.T) {
+	var (
+		myContract    = sdk.AccAddress(bytes.Repeat([]byte{1}, address.Len)).String()
+		otherContract = sdk.AccAddress(bytes.Repeat([]byte{2}, address.Len)).String()
+		alice         = sdk.AccAddress(bytes.Repeat([]byte{3}, address.Len)).String()
+		bob           = sdk.AccAddress(bytes.Repeat([]byte{4}, address.Len)).String()
+	)
+	typedEvent := func(evt proto.Message) abci.Event {
+		e, err := sdk.TypedEventToEvent(evt)
+		require.NoError(t, err)
+		return abci.Event(e)
+	}
+	wasmEvent := func(eventType, contract, action string) abci.Event {
+		return abci.Event(sdk.NewEvent(eventType, sdk.NewAttribute(types.AttributeKeyContractAddr, contract), sdk.NewAttribute("action", action)))
+	}
+	txs := [][]byte{[]byte("tx0"), []byte("tx1")}
+	tx0Hash, tx1Hash := cmtbytes.HexBytes(cmttypes.Tx(txs[0]).Hash()), cmtbytes.HexBytes(cmttypes.Tx(txs[1]).Hash())
+
+	indexer := NewIndexer(dbm.NewMemDB(), 0)
+	blocks := []abci.ResponseFinalizeBlock{
+		{
+			TxResults: []*abci.ExecTxResult{
+				{Events: []abci.Event{
+					typedEvent(&types.EventInstantiate{Contract: myContract, CodeID: 1, Creator: alice}),
+					wasmEvent(types.WasmModuleEventType, myContract, "init"),
+				}},
+				{Code: 1, Events: []abci.Event{typedEvent(&types.EventExecute{Contract: myContract, Sender: bob})}},
+			},
+		},
+		{
+			TxResults: []*abci.ExecTxResult{
+				{Events: []abci.Event{
+					typedEvent(&types.EventExecute{Contract: myContract, Sender: alice}),
+					wasmEvent(types.WasmModuleEventType, myContract, "transfer"),
+					wasmEvent(types.CustomContractEventPrefix+"transfer", myContract, "transfer"),
+					wasmEvent(types.WasmModuleEventType, otherContract, "other"),
+				}},
+				{Events: []abci.Event{
+					abci.Event(sdk.NewEvent("message", sdk.NewAttribute("sender", bob))),
+					typedEvent(&types.EventExecute{Contract: myContract, Sender: bob}),
+				}},
+			},
+		},
+		{
+			TxResults: []*abci.ExecTxResult{
+				{Events: []abci.Event{
+					typedEvent(&types.EventMigrate{Contract: myContract, CodeID: 2, Sender: alice}),
+					typedEvent(&types.EventExecute{Contract: myContract, Sender: alice}),
+				}},
+			},
+			Events: []abci.Event{
+				typedEvent(&types.EventScheduledMigration{Contract: myContract, CodeID: 3, Success: true}),
+				typedEvent(&types.EventScheduledMigration{Contract: otherContract, CodeID: 3, Error: "testing"}),
+			},
+		},
+	}
+	for i, res := range blocks {
+		req := abci.RequestFinalizeBlock{Height: int64(i + 1), Txs: txs[:len(res.TxResults)]}
+		require.NoError(t, indexer.ListenFinalizeBlock(context.Background(), req, res))
+	}
+	q := NewQuerier(indexer)
+
+	t.Run("contract events", func(t *testing.T) {
+		specs := map[string]struct {
+			req       *types.IndexerContractEventsRequest
+			expEvents []types.IndexedEvent
+		}{
+			"all": {
+				req: &types.IndexerContractEventsRequest{Address: myContract},
+				expEvents: []types.IndexedEvent{
+					{Height: 1, TxHash: tx0Hash, Type: "wasm", Attributes: []types.IndexedEventAttribute{{Key: "_contract_address", Value: myContract}, {Key: "action", Value: "init"}}},
+					{Height: 2, TxHash: tx0Hash, Type: "wasm", Attributes: []types.IndexedEventAttribute{{Key: "_contract_address", Value: myContract}, {Key: "action", Value: "transfer"}}},
+					{Height: 2, TxHash: tx0Hash, Type: "wasm-transfer", Attributes: []types.IndexedEventAttribute{{Key: "_contract_address", Value: myContract}, {Key: "action", Value: "transfer"}}},
+				},
+			},
+			"by type": {
+				req: &types.IndexerContractEventsRequest{Address: myContract, EventType: "wasm-transfer"},
+				expEvents: []types.IndexedEvent{
+					{Height: 2, TxHash: tx0Hash, Type: "wasm-transfer", Attributes: []types.IndexedEventAttribute{{Key: "_contract_address", Value: myContract}, {Key: "action", Value: "transfer"}}},
+				},
+			},
+			"by height": {
+				req: &types.IndexerContractEventsRequest{Address: myContract, MinHeight: 1, MaxHeight: 1},
+				expEvents: []types.IndexedEvent{
+					{Height: 1, TxHash: tx0Hash, Type: "wasm", Attributes: []types.IndexedEventAttribute{{Key: "_contract_address", Value: myContract}, {Key: "action", Value: "init"}}},
+				},
+			},
+			"other contract": {
+				req: &types.IndexerContractEventsRequest{Address: otherContract},
+				expEvents: []types.IndexedEvent{
+					{Height: 2, TxHash: tx0Hash, Type: "wasm", Attributes: []types.IndexedEventAttribute{{Key: "_contract_address", Value: otherContract}, {Key: "action", Value: "other"}}},
+				},
+			},
+		}
+		for name, spec := range specs {
+			t.Run(name, func(t *testing.T) {
+				// when
+				res, err := q.ContractEvents(context.Background(), spec.req)
+
+				// then
+				require.NoError(t, err)
+				assert.Equal(t, spec.expEvents, res.Events)
+			})
+		}
+	})
+	t.Run("contract history", func(t *testing.T) {
+		// when
+		res, err := q.ContractHistory(context.Background(), &types.IndexerContractHistoryRequest{Address: myContract})
+
+		// then
+		require.NoError(t, err)
+		exp := []types.IndexedContractOperation{
+			{Height: 1, TxHash: tx0Hash, Operation: types.ContractCodeHistoryOperationTypeInit, CodeID: 1, Sender: alice},
+			{Height: 3, TxHash: tx0Hash, Operation: types.ContractCodeHistoryOperationTypeMigrate, CodeID: 2, Sender: alice},
+			{Height: 3, Operation: types.ContractCodeHistoryOperationTypeMigrate, CodeID: 3},
+		}
+		assert.Equal(t, exp, res.Operations)
+	})
+	t.Run("contract executions", func(t *testing.T) {
+		specs := map[string]struct {
+			req           *types.IndexerContractExecutionsRequest
+			expExecutions []types.IndexedExecution
+			expNextKey    bool
+		}{
+			"all": {
+				req: &types.IndexerContractExecutionsRequest{Address: myContract},
+				expExecutions: []types.IndexedExecution{
+					{Height: 2, TxHash: tx0Hash, Contract: myContract, Sender: alice},
+					{Height: 2, TxHash: tx1Hash, Contract: myContract, Sender: bob},
+					{Height: 3, TxHash: tx0Hash, Contract: myContract, Sender: alice},
+				},
+			},
+			"by sender": {
+				req: &types.IndexerContractExecutionsRequest{Address: myContract, Sender: alice},
+				expExecutions: []types.IndexedExecution{
+					{Height: 2, TxHash: tx0Hash, Contract: myContract, Sender: alice},
+					{Height: 3, TxHash: tx0Hash, Contract: myContract, Sender: alice},
+				},
+			},
+			"by sender and height": {
+				req: &types.IndexerContractExecutionsRequest{Address: myContract, Sender: alice, MinHeight: 3},
+				expExecutions: []types.IndexedExecution{
+					{Height: 3, TxHash: tx0Hash, Contract: myContract, Sender: alice},
+				},
+			},
+			"paginated": {
+				req: &types.IndexerContractExecutionsRequest{Address: myContract, Pagination: &query.PageRequest{Limit: 1}},
+				expExecutions: []types.IndexedExecution{
+					{Height: 2, TxHash: tx0Hash, Contract: myContract, Sender: alice},
+				},
+				expNextKey: true,
+			},
+			"none": {
+				req:           &types.IndexerContractExecutionsRequest{Address: otherContract},
+				expExecutions: []types.IndexedExecution{},
+			},
+		}
+		for name, spec := range specs {
+			t.Run(name, func(t *testing.T) {
+				// when
+				res, err := q.ContractExecutions(context.Background(), spec.req)
+
+				// then
+				require.NoError(t, err)
+				assert.Equal(t, spec.expExecutions, res.Executions)
+				assert.Equal(t, spec.expNextKey, len(res.Pagination.NextKey) != 0)
+			})
+		}
+	})
+}
+
+func TestIndexerPruning(t *testing.T) {
+	myContract := sdk.AccAddress(bytes.Repeat([]byte{1}, address.Len)).String()
+	alice := sdk.AccAddress(bytes.Repeat([]byte{3}, address.Len)).String()
+	block := func() abci.ResponseFinalizeBlock {
+		executed, err := sdk.TypedEventToEvent(&types.EventExecute{Contract: myContract, Sender: alice})
+		require.NoError(t, err)
+		return abci.ResponseFinalizeBlock{
+			TxResults: []*abci.ExecTxResult{{Events: []abci.Event{
+				abci.Event(executed),
+				abci.Event(sdk.NewEvent(types.WasmModuleEventType, sdk.NewAttribute(types.AttributeKeyContractAddr, myContract))),
+			}}},
+		}
+	}
+	specs := map[string]struct {
+		retainBlocks uint64
+		expHeights   []int64
+	}{
+		"keep all": {
+			expHeights: []int64{1, 2, 3, 4},
+		},
+		"retain last blocks": {
+			retainBlocks: 2,
+			expHeights:   []int64{3, 4},
+		},
+		"retain more blocks than indexed": {
+			retainBlocks: 10,
+			expHeights:   []int64{1, 2, 3, 4},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			indexer := NewIndexer(db, spec.retainBlocks)
+
+			// when
+			for height := int64(1); height <= 4; height++ {
+				req := abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{[]byte("tx")}}
+				require.NoError(t, indexer.ListenFinalizeBlock(context.Background(), req, block()))
+			}
+
+			// then
+			q := NewQuerier(indexer)
+			eventsRes, err := q.ContractEvents(context.Background(), &types.IndexerContractEventsRequest{Address: myContract})
+			require.NoError(t, err)
+			executionsRes, err := q.ContractExecutions(context.Background(), &types.IndexerContractExecutionsRequest{Address: myContract, Sender: alice})
+			require.NoError(t, err)
+			var gotEventHeights, gotExecutionHeights []int64
+			for _, e := range eventsRes.Events {
+				gotEventHeights = append(gotEventHeights, e.Height)
+			}
+			for _, e := range executionsRes.Executions {
+				gotExecutionHeights = append(gotExecutionHeights, e.Height)
+			}
+			assert.Equal(t, spec.expHeights, gotEventHeights)
+			assert.Equal(t, spec.expHeights, gotExecutionHeights)
+			// and no entries of the pruned blocks are left
+			iter, err := db.Iterator(nil, nil)
+			require.NoError(t, err)
+			defer iter.Close()
+			var gotEntries int
+			for ; iter.Valid(); iter.Next() {
+				gotEntries++
+			}
+			// each block has an event, an execution by contract and by sender plus their position index entries
+			assert.Equal(t, len(spec.expHeights)*3*2, gotEntries)
+		})
+	}
+}
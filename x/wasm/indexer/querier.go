@@ -0,0 +1,150 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// maxResultEntries is the max number of results per page
+const maxResultEntries = 100
+
+var _ types.IndexerServer = &Querier{}
+
+// Querier serves the data of an Indexer. It does not depend on the chain state, so that the results are
+// node local and not deterministic.
+type Querier struct {
+	indexer *Indexer
+}
+
+// NewQuerier constructor
+func NewQuerier(indexer *Indexer) *Querier {
+	return &Querier{indexer: indexer}
+}
+
+// ContractEvents lists the indexed wasm and custom wasm events of a contract
+func (q Querier) ContractEvents(_ context.Context, req *types.IndexerContractEventsRequest) (*types.IndexerContractEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]types.IndexedEvent, 0)
+	pageRes, err := q.paginate(indexKey(eventsKeyPrefix, nil, contractAddr), req.Pagination, req.MinHeight, req.MaxHeight, func(value []byte) (bool, error) {
+		var e types.IndexedEvent
+		if err := proto.Unmarshal(value, &e); err != nil {
+			return false, err
+		}
+		if req.EventType != "" && e.Type != req.EventType {
+			return false, nil
+		}
+		events = append(events, e)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.IndexerContractEventsResponse{Events: events, Pagination: pageRes}, nil
+}
+
+// ContractHistory lists the indexed instantiate and migrate operations of a contract
+func (q Querier) ContractHistory(_ context.Context, req *types.IndexerContractHistoryRequest) (*types.IndexerContractHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ops := make([]types.IndexedContractOperation, 0)
+	pageRes, err := q.paginate(indexKey(contractHistoryKeyPrefix, nil, contractAddr), req.Pagination, 0, 0, func(value []byte) (bool, error) {
+		var op types.IndexedContractOperation
+		if err := proto.Unmarshal(value, &op); err != nil {
+			return false, err
+		}
+		ops = append(ops, op)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.IndexerContractHistoryResponse{Operations: ops, Pagination: pageRes}, nil
+}
+
+// ContractExecutions lists the indexed execute calls of a contract. They are filtered by sender when set.
+func (q Querier) ContractExecutions(_ context.Context, req *types.IndexerContractExecutionsRequest) (*types.IndexerContractExecutionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	prefixKey := indexKey(executionsKeyPrefix, nil, contractAddr)
+	if req.Sender != "" {
+		senderAddr, err := sdk.AccAddressFromBech32(req.Sender)
+		if err != nil {
+			return nil, err
+		}
+		prefixKey = indexKey(executionsBySenderKeyPrefix, nil, contractAddr, senderAddr)
+	}
+	executions := make([]types.IndexedExecution, 0)
+	pageRes, err := q.paginate(prefixKey, req.Pagination, req.MinHeight, req.MaxHeight, func(value []byte) (bool, error) {
+		var e types.IndexedExecution
+		if err := proto.Unmarshal(value, &e); err != nil {
+			return false, err
+		}
+		executions = append(executions, e)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.IndexerContractExecutionsResponse{Executions: executions, Pagination: pageRes}, nil
+}
+
+// paginate iterates the entries with the key prefix in the height range. A max height of 0 is unbounded.
+// The onResult callback returns false for entries that do not match the request.
+func (q Querier) paginate(prefixKey []byte, pageReq *query.PageRequest, minHeight, maxHeight int64, onResult func(value []byte) (bool, error)) (*query.PageResponse, error) {
+	pageReq, err := ensurePaginationParams(pageReq)
+	if err != nil {
+		return nil, err
+	}
+	prefixStore := prefix.NewStore(dbadapter.Store{DB: q.indexer.db}, prefixKey)
+	return query.FilteredPaginate(prefixStore, pageReq, func(key, value []byte, accumulate bool) (bool, error) {
+		height := heightFromPosition(key)
+		if height < minHeight || (maxHeight != 0 && height > maxHeight) {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
+		return onResult(value)
+	})
+}
+
+// ensurePaginationParams ensures that pagination is done via key iterator with reasonable limit
+func ensurePaginationParams(req *query.PageRequest) (*query.PageRequest, error) {
+	if req == nil {
+		return &query.PageRequest{Limit: query.DefaultLimit}, nil
+	}
+	if req.Offset != 0 || req.CountTotal {
+		return nil, status.Error(codes.InvalidArgument, "offset and count queries not supported")
+	}
+	if req.Limit > maxResultEntries || req.Limit <= 0 {
+		req.Limit = maxResultEntries
+	}
+	return req, nil
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// proposeContractAdmin starts a two-step admin transfer. The new admin only takes over the contract when the
+// proposal is accepted. An existing proposal for the contract is replaced.
+// An expiry height of 0 means that the proposal does not expire.
+func (k Keeper) proposeContractAdmin(ctx context.Context, contractAddress, caller, newAdmin sdk.AccAddress, expiryHeight uint64, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if expiryHeight != 0 && expiryHeight <= uint64(sdkCtx.BlockHeight()) {
+		return errorsmod.Wrap(types.ErrInvalid, "expiry height must be in the future")
+	}
+	transfer := types.PendingAdminTransfer{
+		Contract:      contractAddress.String(),
+		ProposedAdmin: newAdmin.String(),
+		ExpiryHeight:  expiryHeight,
+	}
+	if err := k.storePendingAdminTransfer(sdkCtx, contractAddress, transfer); err != nil {
+		return err
+	}
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
+		types.EventTypeProposeContractAdmin,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, transfer.Contract),
+		sdk.NewAttribute(types.AttributeKeyProposedAdmin, transfer.ProposedAdmin),
+		sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatUint(expiryHeight, 10)),
+	), &types.EventProposeContractAdmin{
+		Contract:      transfer.Contract,
+		ProposedAdmin: transfer.ProposedAdmin,
+		ExpiryHeight:  expiryHeight,
+	})
+	return nil
+}
+
+// acceptContractAdmin completes a pending admin transfer and sets the proposed admin as the new contract admin.
+func (k Keeper) acceptContractAdmin(ctx context.Context, contractAddress, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	transfer := k.GetPendingAdminTransfer(sdkCtx, contractAddress)
+	if transfer == nil {
+		return errorsmod.Wrap(types.ErrNotFound, "pending admin transfer")
+	}
+	if transfer.ExpiryHeight != 0 && uint64(sdkCtx.BlockHeight()) > transfer.ExpiryHeight {
+		return errorsmod.Wrapf(types.ErrInvalid, "admin transfer expired at height %d", transfer.ExpiryHeight)
+	}
+	proposedAdmin, err := sdk.AccAddressFromBech32(transfer.ProposedAdmin)
+	if err != nil {
+		return errorsmod.Wrap(err, "proposed admin")
+	}
+	if !authZ.CanAcceptAdmin(proposedAdmin, caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not accept admin")
+	}
+	if err := k.deletePendingAdminTransfer(sdkCtx, contractAddress); err != nil {
+		return err
+	}
+	if err := k.updateContractAdmin(sdkCtx, contractAddress, contractInfo, proposedAdmin); err != nil {
+		return err
+	}
+	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
+		types.EventTypeUpdateContractAdmin,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyNewAdmin, transfer.ProposedAdmin),
+	), &types.EventUpdateAdmin{Contract: contractAddress.String(), NewAdmin: transfer.ProposedAdmin})
+	return nil
+}
+
+// cancelAdminProposal removes a pending admin transfer. It can be cancelled by an actor that is allowed to modify the
+// contract or by the proposed admin.
+func (k Keeper) cancelAdminProposal(ctx context.Context, contractAddress, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	transfer := k.GetPendingAdminTransfer(sdkCtx, contractAddress)
+	if transfer == nil {
+		return errorsmod.Wrap(types.ErrNotFound, "pending admin transfer")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) && transfer.ProposedAdmin != caller.String() {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not cancel admin proposal")
+	}
+	if err := k.deletePendingAdminTransfer(sdkCtx, contractAddress); err != nil {
+		return err
+	}
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
+		types.EventTypeCancelAdminProposal,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyProposedAdmin, transfer.ProposedAdmin),
+	), &types.EventCancelAdminProposal{Contract: contractAddress.String(), ProposedAdmin: transfer.ProposedAdmin})
+	return nil
+}
+
+// GetPendingAdminTransfer returns the pending admin transfer for the contract or nil when none exists
+func (k Keeper) GetPendingAdminTransfer(ctx context.Context, contractAddress sdk.AccAddress) *types.PendingAdminTransfer {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetPendingAdminTransferKey(contractAddress))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var transfer types.PendingAdminTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return &transfer
+}
+
+// IteratePendingAdminTransfers iterates over all pending admin transfers.
+func (k Keeper) IteratePendingAdminTransfers(ctx context.Context, cb func(types.PendingAdminTransfer) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingAdminTransferPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var transfer types.PendingAdminTransfer
+		k.cdc.MustUnmarshal(iter.Value(), &transfer)
+		if cb(transfer) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storePendingAdminTransfer(ctx context.Context, contractAddress sdk.AccAddress, transfer types.PendingAdminTransfer) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetPendingAdminTransferKey(contractAddress), k.cdc.MustMarshal(&transfer))
+}
+
+func (k Keeper) deletePendingAdminTransfer(ctx context.Context, contractAddress sdk.AccAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetPendingAdminTransferKey(contractAddress))
+}
+
+func (k Keeper) importPendingAdminTransfer(ctx context.Context, transfer types.PendingAdminTransfer) error {
+	contractAddress := sdk.MustAccAddressFromBech32(transfer.Contract)
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return types.ErrNoSuchContractFn(transfer.Contract).Wrapf("address %s", transfer.Contract)
+	}
+	if k.GetPendingAdminTransfer(ctx, contractAddress) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "pending admin transfer: %s", transfer.Contract)
+	}
+	return k.storePendingAdminTransfer(ctx, contractAddress, transfer)
+}
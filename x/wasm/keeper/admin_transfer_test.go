@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestProposeContractAdmin(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	newAdmin := RandomAccountAddress(t)
+	otherAddr := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		sender       sdk.AccAddress
+		contract     sdk.AccAddress
+		expiryHeight uint64
+		authZ        types.AuthorizationPolicy
+		expErr       *errorsmod.Error
+	}{
+		"contract admin": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			authZ:    DefaultAuthorizationPolicy{},
+		},
+		"with expiry height": {
+			sender:       example.CreatorAddr,
+			contract:     example.Contract,
+			expiryHeight: uint64(parentCtx.BlockHeight()) + 1,
+			authZ:        DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			sender:   otherAddr,
+			contract: example.Contract,
+			authZ:    GovAuthorizationPolicy{},
+		},
+		"other sender": {
+			sender:   otherAddr,
+			contract: example.Contract,
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			sender:   example.CreatorAddr,
+			contract: RandomAccountAddress(t),
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+		"expiry height not in the future": {
+			sender:       example.CreatorAddr,
+			contract:     example.Contract,
+			expiryHeight: uint64(parentCtx.BlockHeight()),
+			authZ:        DefaultAuthorizationPolicy{},
+			expErr:       types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotErr := k.proposeContractAdmin(ctx, spec.contract, spec.sender, newAdmin, spec.expiryHeight, spec.authZ)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Nil(t, k.GetPendingAdminTransfer(ctx, spec.contract))
+				return
+			}
+			require.NoError(t, gotErr)
+			exp := types.PendingAdminTransfer{
+				Contract:      spec.contract.String(),
+				ProposedAdmin: newAdmin.String(),
+				ExpiryHeight:  spec.expiryHeight,
+			}
+			got := k.GetPendingAdminTransfer(ctx, spec.contract)
+			require.NotNil(t, got)
+			assert.Equal(t, exp, *got)
+			// admin not changed before acceptance
+			assert.Equal(t, example.CreatorAddr.String(), k.GetContractInfo(ctx, spec.contract).Admin)
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, types.EventTypeProposeContractAdmin, em.Events()[0].Type)
+			assert.Equal(t, proto.MessageName(&types.EventProposeContractAdmin{}), em.Events()[1].Type)
+		})
+	}
+}
+
+func TestAcceptContractAdmin(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	newAdmin := RandomAccountAddress(t)
+	otherAddr := RandomAccountAddress(t)
+	expiryHeight := uint64(parentCtx.BlockHeight()) + 1
+	require.NoError(t, k.proposeContractAdmin(parentCtx, example.Contract, example.CreatorAddr, newAdmin, expiryHeight, DefaultAuthorizationPolicy{}))
+
+	specs := map[string]struct {
+		sender   sdk.AccAddress
+		contract sdk.AccAddress
+		height   int64
+		authZ    types.AuthorizationPolicy
+		expErr   *errorsmod.Error
+	}{
+		"proposed admin": {
+			sender:   newAdmin,
+			contract: example.Contract,
+			height:   int64(expiryHeight),
+			authZ:    DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			sender:   otherAddr,
+			contract: example.Contract,
+			height:   int64(expiryHeight),
+			authZ:    GovAuthorizationPolicy{},
+		},
+		"current admin": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			height:   int64(expiryHeight),
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"other sender": {
+			sender:   otherAddr,
+			contract: example.Contract,
+			height:   int64(expiryHeight),
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"expired": {
+			sender:   newAdmin,
+			contract: example.Contract,
+			height:   int64(expiryHeight) + 1,
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   types.ErrInvalid,
+		},
+		"unknown contract": {
+			sender:   newAdmin,
+			contract: RandomAccountAddress(t),
+			height:   int64(expiryHeight),
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithBlockHeight(spec.height).WithEventManager(em)
+
+			// when
+			gotErr := k.acceptContractAdmin(ctx, spec.contract, spec.sender, spec.authZ)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.NotNil(t, k.GetPendingAdminTransfer(ctx, example.Contract))
+				assert.Equal(t, example.CreatorAddr.String(), k.GetContractInfo(ctx, example.Contract).Admin)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetPendingAdminTransfer(ctx, spec.contract))
+			assert.Equal(t, newAdmin.String(), k.GetContractInfo(ctx, spec.contract).Admin)
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, types.EventTypeUpdateContractAdmin, em.Events()[0].Type)
+			assert.Equal(t, proto.MessageName(&types.EventUpdateAdmin{}), em.Events()[1].Type)
+		})
+	}
+}
+
+func TestAcceptContractAdminWithoutProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	gotErr := k.acceptContractAdmin(ctx, example.Contract, RandomAccountAddress(t), DefaultAuthorizationPolicy{})
+	require.ErrorIs(t, gotErr, types.ErrNotFound)
+}
+
+func TestCancelAdminProposal(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	newAdmin := RandomAccountAddress(t)
+	otherAddr := RandomAccountAddress(t)
+	require.NoError(t, k.proposeContractAdmin(parentCtx, example.Contract, example.CreatorAddr, newAdmin, 0, DefaultAuthorizationPolicy{}))
+
+	specs := map[string]struct {
+		sender sdk.AccAddress
+		authZ  types.AuthorizationPolicy
+		expErr *errorsmod.Error
+	}{
+		"contract admin": {
+			sender: example.CreatorAddr,
+			authZ:  DefaultAuthorizationPolicy{},
+		},
+		"proposed admin": {
+			sender: newAdmin,
+			authZ:  DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			sender: otherAddr,
+			authZ:  GovAuthorizationPolicy{},
+		},
+		"other sender": {
+			sender: otherAddr,
+			authZ:  DefaultAuthorizationPolicy{},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotErr := k.cancelAdminProposal(ctx, example.Contract, spec.sender, spec.authZ)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.NotNil(t, k.GetPendingAdminTransfer(ctx, example.Contract))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetPendingAdminTransfer(ctx, example.Contract))
+			assert.Equal(t, example.CreatorAddr.String(), k.GetContractInfo(ctx, example.Contract).Admin)
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, types.EventTypeCancelAdminProposal, em.Events()[0].Type)
+			assert.Equal(t, proto.MessageName(&types.EventCancelAdminProposal{}), em.Events()[1].Type)
+			// nothing left to cancel
+			require.ErrorIs(t, k.cancelAdminProposal(ctx, example.Contract, spec.sender, spec.authZ), types.ErrNotFound)
+		})
+	}
+}
+
+func TestUpdateContractAdminRemovesPendingTransfer(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, k.proposeContractAdmin(ctx, example.Contract, example.CreatorAddr, RandomAccountAddress(t), 0, DefaultAuthorizationPolicy{}))
+
+	// when
+	err := k.setContractAdmin(ctx, example.Contract, RandomAccountAddress(t), RandomAccountAddress(t), GovAuthorizationPolicy{})
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, k.GetPendingAdminTransfer(ctx, example.Contract))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"encoding/binary"
 
 	corestoretypes "cosmossdk.io/core/store"
@@ -106,19 +107,25 @@ func (d LimitSimulationGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	return next(ctx, tx, simulate)
 }
 
+// GasRegisterSource provides the gas register that applies to the current state
+type GasRegisterSource interface {
+	GetEffectiveGasRegister(ctx context.Context) types.GasRegister
+}
+
 // GasRegisterDecorator ante decorator to store gas register in the context
 type GasRegisterDecorator struct {
-	gasRegister types.GasRegister
+	source GasRegisterSource
 }
 
 // NewGasRegisterDecorator constructor.
-func NewGasRegisterDecorator(gr types.GasRegister) *GasRegisterDecorator {
-	return &GasRegisterDecorator{gasRegister: gr}
+func NewGasRegisterDecorator(s GasRegisterSource) *GasRegisterDecorator {
+	return &GasRegisterDecorator{source: s}
 }
 
-// AnteHandle adds the gas register to the context.
+// AnteHandle adds the gas register to the context. The gas register is built from the params
+// so that all contract executions within the tx are charged with the same costs.
 func (g GasRegisterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	return next(types.WithGasRegister(ctx, g.gasRegister), tx, simulate)
+	return next(types.WithGasRegister(ctx, g.source.GetEffectiveGasRegister(ctx)), tx, simulate)
 }
 
 // TxContractsDecorator implements an AnteHandler that keeps track of which contracts were already accessed during the current transaction. This allows discounting further calls to those contracts, as they are likely to be in the memory cache of the VM already.
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -191,6 +192,7 @@ func consumeGasAnteHandler(gasToConsume storetypes.Gas) sdk.AnteHandler {
 func TestGasRegisterDecorator(t *testing.T) {
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+	myGasRegister := types.NewWasmGasRegister(types.WasmGasRegisterConfig{GasMultiplier: 1})
 
 	specs := map[string]struct {
 		simulate       bool
@@ -199,8 +201,9 @@ func TestGasRegisterDecorator(t *testing.T) {
 		"simulation": {
 			simulate: true,
 			nextAssertAnte: func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
-				_, ok := types.GasRegisterFromContext(ctx)
+				gr, ok := types.GasRegisterFromContext(ctx)
 				assert.True(t, ok)
+				assert.Equal(t, myGasRegister, gr)
 				require.True(t, simulate)
 				return ctx, nil
 			},
@@ -208,8 +211,9 @@ func TestGasRegisterDecorator(t *testing.T) {
 		"not simulation": {
 			simulate: false,
 			nextAssertAnte: func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
-				_, ok := types.GasRegisterFromContext(ctx)
+				gr, ok := types.GasRegisterFromContext(ctx)
 				assert.True(t, ok)
+				assert.Equal(t, myGasRegister, gr)
 				require.False(t, simulate)
 				return ctx, nil
 			},
@@ -224,7 +228,11 @@ func TestGasRegisterDecorator(t *testing.T) {
 			var anyTx sdk.Tx
 
 			// when
-			ante := keeper.NewGasRegisterDecorator(&wasmtesting.MockGasRegister{})
+			ante := keeper.NewGasRegisterDecorator(wasmtesting.MockGasRegisterSource{
+				GetEffectiveGasRegisterFn: func(context.Context) types.GasRegister {
+					return myGasRegister
+				},
+			})
 			_, gotErr := ante.AnteHandle(ctx, anyTx, spec.simulate, spec.nextAssertAnte)
 
 			// then
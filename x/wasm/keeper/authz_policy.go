@@ -27,6 +27,30 @@ func (p DefaultAuthorizationPolicy) CanModifyCodeAccessConfig(creator, actor sdk
 	return creator != nil && creator.Equals(actor) && isSubset
 }
 
+func (p DefaultAuthorizationPolicy) CanDeleteCode(creator, actor sdk.AccAddress) bool {
+	return creator != nil && creator.Equals(actor)
+}
+
+// CanMigrateFrozenContract returns false as only gov can migrate frozen contracts
+func (p DefaultAuthorizationPolicy) CanMigrateFrozenContract(sdk.AccAddress) bool {
+	return false
+}
+
+// CanAcceptAdmin returns true when the actor is the proposed admin
+func (p DefaultAuthorizationPolicy) CanAcceptAdmin(proposedAdmin, actor sdk.AccAddress) bool {
+	return proposedAdmin != nil && proposedAdmin.Equals(actor)
+}
+
+// CanBypassMigrationDelay returns false as only gov can migrate without the contract's delay
+func (p DefaultAuthorizationPolicy) CanBypassMigrationDelay(sdk.AccAddress) bool {
+	return false
+}
+
+// CanRaiseStateQuota returns false as only gov can raise or remove the state size quota of a contract
+func (p DefaultAuthorizationPolicy) CanRaiseStateQuota(sdk.AccAddress) bool {
+	return false
+}
+
 // SubMessageAuthorizationPolicy always returns the default policy
 func (p DefaultAuthorizationPolicy) SubMessageAuthorizationPolicy(_ types.AuthorizationPolicyAction) types.AuthorizationPolicy {
 	return p
@@ -71,6 +95,26 @@ func (p GovAuthorizationPolicy) CanModifyCodeAccessConfig(sdk.AccAddress, sdk.Ac
 	return true
 }
 
+func (p GovAuthorizationPolicy) CanDeleteCode(sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanMigrateFrozenContract(sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanAcceptAdmin(sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanBypassMigrationDelay(sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanRaiseStateQuota(sdk.AccAddress) bool {
+	return true
+}
+
 // SubMessageAuthorizationPolicy returns new policy with fine-grained gov permission for given action only
 func (p GovAuthorizationPolicy) SubMessageAuthorizationPolicy(action types.AuthorizationPolicyAction) types.AuthorizationPolicy {
 	defaultPolicy := DefaultAuthorizationPolicy{}
@@ -118,6 +162,32 @@ func (p PartialGovAuthorizationPolicy) CanModifyCodeAccessConfig(creator, actor
 	return p.defaultPolicy.CanModifyCodeAccessConfig(creator, actor, isSubset)
 }
 
+func (p PartialGovAuthorizationPolicy) CanDeleteCode(creator, actor sdk.AccAddress) bool {
+	return p.defaultPolicy.CanDeleteCode(creator, actor)
+}
+
+func (p PartialGovAuthorizationPolicy) CanMigrateFrozenContract(actor sdk.AccAddress) bool {
+	if p.action == types.AuthZActionMigrateContract {
+		return true
+	}
+	return p.defaultPolicy.CanMigrateFrozenContract(actor)
+}
+
+func (p PartialGovAuthorizationPolicy) CanAcceptAdmin(proposedAdmin, actor sdk.AccAddress) bool {
+	return p.defaultPolicy.CanAcceptAdmin(proposedAdmin, actor)
+}
+
+func (p PartialGovAuthorizationPolicy) CanBypassMigrationDelay(actor sdk.AccAddress) bool {
+	if p.action == types.AuthZActionMigrateContract {
+		return true
+	}
+	return p.defaultPolicy.CanBypassMigrationDelay(actor)
+}
+
+func (p PartialGovAuthorizationPolicy) CanRaiseStateQuota(actor sdk.AccAddress) bool {
+	return p.defaultPolicy.CanRaiseStateQuota(actor)
+}
+
 // SubMessageAuthorizationPolicy always returns self
 func (p PartialGovAuthorizationPolicy) SubMessageAuthorizationPolicy(_ types.AuthorizationPolicyAction) types.AuthorizationPolicy {
 	return p
@@ -181,6 +181,79 @@ func TestDefaultAuthzPolicyCanModifyCodeAccessConfig(t *testing.T) {
 	}
 }
 
+func TestDefaultAuthzPolicyCanDeleteCode(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		creator sdk.AccAddress
+		exp     bool
+	}{
+		"same as actor": {
+			creator: myActorAddress,
+			exp:     true,
+		},
+		"different creator": {
+			creator: otherAddress,
+			exp:     false,
+		},
+		"no creator": {
+			exp: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultAuthorizationPolicy{}
+			got := policy.CanDeleteCode(spec.creator, myActorAddress)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestDefaultAuthzPolicyCanMigrateFrozenContract(t *testing.T) {
+	policy := DefaultAuthorizationPolicy{}
+	assert.False(t, policy.CanMigrateFrozenContract(RandomAccountAddress(t)))
+}
+
+func TestDefaultAuthzPolicyCanAcceptAdmin(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		proposedAdmin sdk.AccAddress
+		exp           bool
+	}{
+		"same as actor": {
+			proposedAdmin: myActorAddress,
+			exp:           true,
+		},
+		"different proposed admin": {
+			proposedAdmin: otherAddress,
+			exp:           false,
+		},
+		"no proposed admin": {
+			exp: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultAuthorizationPolicy{}
+			got := policy.CanAcceptAdmin(spec.proposedAdmin, myActorAddress)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestDefaultAuthzPolicyCanBypassMigrationDelay(t *testing.T) {
+	policy := DefaultAuthorizationPolicy{}
+	assert.False(t, policy.CanBypassMigrationDelay(RandomAccountAddress(t)))
+}
+
+func TestDefaultAuthzPolicyCanRaiseStateQuota(t *testing.T) {
+	policy := DefaultAuthorizationPolicy{}
+	assert.False(t, policy.CanRaiseStateQuota(RandomAccountAddress(t)))
+}
+
 func TestDefaultAuthzPolicySubMessageAuthorizationPolicy(t *testing.T) {
 	policy := DefaultAuthorizationPolicy{}
 	for _, v := range []types.AuthorizationPolicyAction{types.AuthZActionInstantiate, types.AuthZActionMigrateContract} {
@@ -321,6 +394,69 @@ func TestGovAuthzPolicyCanModifyCodeAccessConfig(t *testing.T) {
 	}
 }
 
+func TestGovAuthzPolicyCanDeleteCode(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		creator sdk.AccAddress
+	}{
+		"same as actor": {
+			creator: myActorAddress,
+		},
+		"different creator": {
+			creator: otherAddress,
+		},
+		"no creator": {},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := newGovAuthorizationPolicy(nil)
+			got := policy.CanDeleteCode(spec.creator, myActorAddress)
+			assert.True(t, got)
+		})
+	}
+}
+
+func TestGovAuthzPolicyCanMigrateFrozenContract(t *testing.T) {
+	policy := newGovAuthorizationPolicy(nil)
+	assert.True(t, policy.CanMigrateFrozenContract(RandomAccountAddress(t)))
+}
+
+func TestGovAuthzPolicyCanAcceptAdmin(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		proposedAdmin sdk.AccAddress
+	}{
+		"same as actor": {
+			proposedAdmin: myActorAddress,
+		},
+		"different proposed admin": {
+			proposedAdmin: otherAddress,
+		},
+		"no proposed admin": {},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := newGovAuthorizationPolicy(nil)
+			got := policy.CanAcceptAdmin(spec.proposedAdmin, myActorAddress)
+			assert.True(t, got)
+		})
+	}
+}
+
+func TestGovAuthzPolicyCanBypassMigrationDelay(t *testing.T) {
+	policy := newGovAuthorizationPolicy(nil)
+	assert.True(t, policy.CanBypassMigrationDelay(RandomAccountAddress(t)))
+}
+
+func TestGovAuthzPolicyCanRaiseStateQuota(t *testing.T) {
+	policy := newGovAuthorizationPolicy(nil)
+	assert.True(t, policy.CanRaiseStateQuota(RandomAccountAddress(t)))
+}
+
 func TestGovAuthorizationPolicySubMessageAuthorizationPolicy(t *testing.T) {
 	specs := map[string]struct {
 		propagate  map[types.AuthorizationPolicyAction]struct{}
@@ -405,6 +541,55 @@ func TestPartialGovAuthorizationPolicyCanModifyContract(t *testing.T) {
 	}
 }
 
+func TestPartialGovAuthorizationPolicyCanMigrateFrozenContract(t *testing.T) {
+	specs := map[string]struct {
+		allowedAction types.AuthorizationPolicyAction
+		exp           bool
+	}{
+		"migration granted": {
+			allowedAction: types.AuthZActionMigrateContract,
+			exp:           true,
+		},
+		"decorated policy when migration not granted ": {
+			allowedAction: types.AuthZActionInstantiate,
+			exp:           false,
+		},
+		"decorated policy when nothing set": {
+			exp: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := NewPartialGovAuthorizationPolicy(AlwaysRejectTestAuthZPolicy{}, spec.allowedAction)
+			got := policy.CanMigrateFrozenContract(nil)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestPartialGovAuthorizationPolicyCanBypassMigrationDelay(t *testing.T) {
+	specs := map[string]struct {
+		allowedAction types.AuthorizationPolicyAction
+		exp           bool
+	}{
+		"migration granted": {
+			allowedAction: types.AuthZActionMigrateContract,
+			exp:           true,
+		},
+		"instantiation granted": {
+			allowedAction: types.AuthZActionInstantiate,
+			exp:           false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := NewPartialGovAuthorizationPolicy(AlwaysRejectTestAuthZPolicy{}, spec.allowedAction)
+			got := policy.CanBypassMigrationDelay(nil)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
 func TestPartialGovAuthorizationPolicyDelegatedOnly(t *testing.T) {
 	for _, v := range []types.AuthorizationPolicy{AlwaysRejectTestAuthZPolicy{}, NewGovAuthorizationPolicy()} {
 		policy := NewPartialGovAuthorizationPolicy(v, types.AuthZActionInstantiate)
@@ -416,6 +601,18 @@ func TestPartialGovAuthorizationPolicyDelegatedOnly(t *testing.T) {
 		got = policy.CanModifyCodeAccessConfig(nil, nil, false)
 		exp = v.CanModifyCodeAccessConfig(nil, nil, false)
 		assert.Equal(t, exp, got)
+
+		got = policy.CanDeleteCode(nil, nil)
+		exp = v.CanDeleteCode(nil, nil)
+		assert.Equal(t, exp, got)
+
+		got = policy.CanAcceptAdmin(nil, nil)
+		exp = v.CanAcceptAdmin(nil, nil)
+		assert.Equal(t, exp, got)
+
+		got = policy.CanRaiseStateQuota(nil)
+		exp = v.CanRaiseStateQuota(nil)
+		assert.Equal(t, exp, got)
 	}
 }
 
@@ -447,6 +644,26 @@ func (a AlwaysRejectTestAuthZPolicy) CanModifyCodeAccessConfig(creator, actor sd
 	return false
 }
 
+func (a AlwaysRejectTestAuthZPolicy) CanDeleteCode(creator, actor sdk.AccAddress) bool {
+	return false
+}
+
+func (a AlwaysRejectTestAuthZPolicy) CanMigrateFrozenContract(actor sdk.AccAddress) bool {
+	return false
+}
+
+func (a AlwaysRejectTestAuthZPolicy) CanAcceptAdmin(proposedAdmin, actor sdk.AccAddress) bool {
+	return false
+}
+
+func (a AlwaysRejectTestAuthZPolicy) CanBypassMigrationDelay(actor sdk.AccAddress) bool {
+	return false
+}
+
+func (a AlwaysRejectTestAuthZPolicy) CanRaiseStateQuota(actor sdk.AccAddress) bool {
+	return false
+}
+
 func (a AlwaysRejectTestAuthZPolicy) SubMessageAuthorizationPolicy(entrypoint types.AuthorizationPolicyAction) types.AuthorizationPolicy {
 	return a
 }
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// callTrace is an open node in the call tree of a simulated tx
+type callTrace struct {
+	tracer       *types.CallTracer
+	node         *types.CallTraceNode
+	ctx          sdk.Context
+	root         bool
+	gasBefore    uint64
+	eventsBefore int
+}
+
+// startCallTrace adds a node for the contract entrypoint call to the call tree when the tx is simulated. The first
+// call of a message becomes the root of the tree and the returned context carries the tracer to the nested calls.
+// Nil is returned for the trace when not simulated.
+func startCallTrace(ctx sdk.Context, entrypoint string, contractAddress sdk.AccAddress) (sdk.Context, *callTrace) {
+	if ctx.ExecMode() != sdk.ExecModeSimulate {
+		return ctx, nil
+	}
+	tracer, ok := types.CallTracerFromContext(ctx)
+	if !ok {
+		tracer = types.NewCallTracer()
+		ctx = types.WithCallTracer(ctx, tracer)
+	}
+	t := enterCallTrace(ctx, tracer, &types.CallTraceNode{
+		Type:     types.CallTraceTypeEntrypoint,
+		Name:     entrypoint,
+		Contract: contractAddress.String(),
+	})
+	t.root = !ok
+	return ctx, t
+}
+
+// traceCall adds the node to the call tree when the tx is simulated and a trace was started before in the context.
+// Nil otherwise. The node is only built when it is traced.
+func traceCall(ctx sdk.Context, newNode func() *types.CallTraceNode) *callTrace {
+	if ctx.ExecMode() != sdk.ExecModeSimulate {
+		return nil
+	}
+	tracer, ok := types.CallTracerFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return enterCallTrace(ctx, tracer, newNode())
+}
+
+func enterCallTrace(ctx sdk.Context, tracer *types.CallTracer, node *types.CallTraceNode) *callTrace {
+	tracer.Enter(node)
+	return &callTrace{
+		tracer:       tracer,
+		node:         node,
+		ctx:          ctx,
+		gasBefore:    ctx.GasMeter().GasConsumed(),
+		eventsBefore: len(ctx.EventManager().Events()),
+	}
+}
+
+// setError records the error of the call and the redacted error text that a calling contract receives.
+// The redacted error is optional. It is safe to call on a nil trace.
+func (t *callTrace) setError(err, redacted error) {
+	if t == nil || err == nil {
+		return
+	}
+	t.node.Error = err.Error()
+	if redacted != nil {
+		t.node.RedactedError = redacted.Error()
+	}
+}
+
+// end completes the node with the gas consumed and the events emitted during the call.
+// It is safe to call on a nil trace.
+func (t *callTrace) end() {
+	if t == nil {
+		return
+	}
+	t.tracer.Exit()
+	if gas := t.ctx.GasMeter().GasConsumed(); gas > t.gasBefore {
+		t.node.GasUsed = gas - t.gasBefore
+	}
+	if events := t.ctx.EventManager().Events(); len(events) > t.eventsBefore {
+		t.node.Events = sdk.StringifyEvents(events[t.eventsBefore:].ToABCIEvents())
+	}
+}
+
+// finish completes the node with the error of the call. When the root is finished, the call tree is emitted as
+// event so that it is returned in the simulate response. On failure, there are no events in the response, so
+// that the call tree is added to the error message instead.
+// It is safe to call on a nil trace.
+func (t *callTrace) finish(err error) error {
+	if t == nil {
+		return err
+	}
+	if t.node.Error == "" {
+		t.setError(err, nil)
+	}
+	t.end()
+	if !t.root {
+		return err
+	}
+	bz, mErr := json.Marshal(t.node)
+	if mErr != nil {
+		moduleLogger(t.ctx).Error("failed to encode call trace", "err", mErr)
+		return err
+	}
+	if err != nil {
+		return errorsmod.Wrapf(err, "call trace: %s", bz)
+	}
+	t.ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCallTrace,
+		sdk.NewAttribute(types.AttributeKeyCallTrace, string(bz)),
+	))
+	return nil
+}
+
+// traceName returns the json name of the variant that is set in wasmvm message or query enums including the
+// nested variant, for example "bank/send" or "wasm/smart"
+func traceName(v any) string {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	var variants map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &variants); err != nil {
+		return ""
+	}
+	for name, inner := range variants {
+		var nested map[string]json.RawMessage
+		if err := json.Unmarshal(inner, &nested); err == nil && len(nested) == 1 {
+			for nestedName := range nested {
+				return name + "/" + nestedName
+			}
+		}
+		return name
+	}
+	return ""
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestCallTraceOnExecute(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+
+	specs := map[string]struct {
+		execMode sdk.ExecMode
+		sender   sdk.AccAddress
+		expTrace bool
+		expErr   bool
+	}{
+		"simulate": {
+			execMode: sdk.ExecModeSimulate,
+			sender:   example.VerifierAddr,
+			expTrace: true,
+		},
+		"simulate with error": {
+			execMode: sdk.ExecModeSimulate,
+			sender:   example.CreatorAddr,
+			expTrace: true,
+			expErr:   true,
+		},
+		"finalize": {
+			execMode: sdk.ExecModeFinalize,
+			sender:   example.VerifierAddr,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em).WithExecMode(spec.execMode)
+
+			// when
+			_, gotErr := k.execute(ctx, example.Contract, spec.sender, []byte(`{"release":{}}`), nil)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Equal(t, spec.expTrace, strings.Contains(gotErr.Error(), "call trace:"))
+				return
+			}
+			require.NoError(t, gotErr)
+			var traceAttrs []string
+			for _, e := range em.Events() {
+				if e.Type != types.EventTypeCallTrace {
+					continue
+				}
+				for _, a := range e.Attributes {
+					traceAttrs = append(traceAttrs, a.Value)
+				}
+			}
+			if !spec.expTrace {
+				assert.Empty(t, traceAttrs)
+				return
+			}
+			require.Len(t, traceAttrs, 1)
+			var root types.CallTraceNode
+			require.NoError(t, json.Unmarshal([]byte(traceAttrs[0]), &root))
+			assert.Equal(t, types.CallTraceTypeEntrypoint, root.Type)
+			assert.Equal(t, "execute", root.Name)
+			assert.Equal(t, example.Contract.String(), root.Contract)
+			assert.NotZero(t, root.GasUsed)
+			assert.NotZero(t, root.WasmGasUsed)
+			assert.NotEmpty(t, root.Events)
+			// hackatom queries the contract balance before it sends it to the beneficiary
+			require.Len(t, root.Children, 2)
+			balanceQuery := root.Children[0]
+			assert.Equal(t, types.CallTraceTypeQuery, balanceQuery.Type)
+			assert.Equal(t, "bank/all_balances", balanceQuery.Name)
+			assert.Empty(t, balanceQuery.Error)
+			bankSend := root.Children[1]
+			assert.Equal(t, types.CallTraceTypeSubmessage, bankSend.Type)
+			assert.Equal(t, "bank/send", bankSend.Name)
+			assert.Equal(t, "never", bankSend.ReplyOn)
+			assert.Empty(t, bankSend.Error)
+		})
+	}
+}
+
+func TestCallTracer(t *testing.T) {
+	tracer := types.NewCallTracer()
+	require.Nil(t, tracer.Root())
+
+	root := &types.CallTraceNode{Name: "execute"}
+	tracer.Enter(root)
+	tracer.AddWasmGas(1)
+	child := &types.CallTraceNode{Name: "bank/send"}
+	tracer.Enter(child)
+	tracer.AddWasmGas(2)
+	tracer.Exit()
+	sibling := &types.CallTraceNode{Name: "wasm/smart"}
+	tracer.Enter(sibling)
+	tracer.Exit()
+	tracer.AddWasmGas(3)
+	tracer.Exit()
+
+	assert.Same(t, root, tracer.Root())
+	assert.Equal(t, []*types.CallTraceNode{child, sibling}, root.Children)
+	assert.Equal(t, uint64(4), root.WasmGasUsed)
+	assert.Equal(t, uint64(2), child.WasmGasUsed)
+	assert.Zero(t, sibling.WasmGasUsed)
+}
+
+func TestTraceName(t *testing.T) {
+	specs := map[string]struct {
+		src any
+		exp string
+	}{
+		"bank send": {
+			src: wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: "foo"}}},
+			exp: "bank/send",
+		},
+		"wasm smart query": {
+			src: wasmvmtypes.QueryRequest{Wasm: &wasmvmtypes.WasmQuery{Smart: &wasmvmtypes.SmartQuery{ContractAddr: "foo"}}},
+			exp: "wasm/smart",
+		},
+		"custom": {
+			src: wasmvmtypes.CosmosMsg{Custom: json.RawMessage(`{"foo":{},"bar":{}}`)},
+			exp: "custom",
+		},
+		"empty": {
+			src: wasmvmtypes.CosmosMsg{},
+			exp: "",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, traceName(spec.src))
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// registerCallback schedules a sudo call into the contract. The callback can be registered by the contract itself or
+// by an actor that is allowed to modify the contract. The fee must cover the callback gas price param for the gas
+// limit. The escrow is held by the module account until it is used for the execution fees or refunded to the sender.
+func (k Keeper) registerCallback(
+	ctx context.Context,
+	sender, contractAddress sdk.AccAddress,
+	msg []byte,
+	startHeight int64,
+	interval, gasLimit uint64,
+	fee, escrow sdk.Coins,
+	authZ types.AuthorizationPolicy,
+) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !sender.Equals(contractAddress) && !authZ.CanModifyContract(contractInfo.AdminAddr(), sender) {
+		return 0, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not register callback")
+	}
+	if startHeight <= sdkCtx.BlockHeight() {
+		return 0, errorsmod.Wrapf(types.ErrInvalid, "start height must be greater than current height %d", sdkCtx.BlockHeight())
+	}
+	if minFee := k.GetParams(sdkCtx).MinCallbackFee(gasLimit); !fee.IsAllGTE(minFee) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "fee must be at least %s", minFee)
+	}
+	callback := types.ContractCallback{
+		ID:         k.mustAutoIncrementID(sdkCtx, types.KeySequenceCallbackID),
+		Contract:   contractAddress.String(),
+		Sender:     sender.String(),
+		Msg:        msg,
+		NextHeight: startHeight,
+		Interval:   interval,
+		GasLimit:   gasLimit,
+		Fee:        fee,
+		Escrow:     escrow,
+	}
+	if err := callback.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	if !escrow.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, sender, types.ModuleName, escrow); err != nil {
+			return 0, errorsmod.Wrap(err, "escrow")
+		}
+	}
+	if err := k.storeCallback(sdkCtx, callback); err != nil {
+		return 0, err
+	}
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
+		types.EventTypeRegisterCallback,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyCallbackID, strconv.FormatUint(callback.ID, 10)),
+	), &types.EventRegisterCallback{Contract: contractAddress.String(), CallbackID: callback.ID})
+	return callback.ID, nil
+}
+
+// cancelCallback removes a scheduled callback and refunds the remaining escrow to the registrant. Callbacks can be
+// canceled by the registrant, the contract itself or an actor that is allowed to modify the contract.
+func (k Keeper) cancelCallback(ctx context.Context, callbackID uint64, caller sdk.AccAddress, authZ types.AuthorizationPolicy) (sdk.Coins, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	callback := k.GetCallback(sdkCtx, callbackID)
+	if callback == nil {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "callback %d", callbackID)
+	}
+	contractAddress := sdk.MustAccAddressFromBech32(callback.Contract)
+	if caller.String() != callback.Sender && !caller.Equals(contractAddress) {
+		contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+		if contractInfo == nil || !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not cancel callback")
+		}
+	}
+	if err := k.removeCallback(sdkCtx, *callback); err != nil {
+		return nil, err
+	}
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
+		types.EventTypeCancelCallback,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, callback.Contract),
+		sdk.NewAttribute(types.AttributeKeyCallbackID, strconv.FormatUint(callbackID, 10)),
+	), &types.EventCancelCallback{Contract: callback.Contract, CallbackID: callbackID})
+	return callback.Escrow, nil
+}
+
+// ExecuteDueCallbacks runs the scheduled contract callbacks with a next height up to the current block height.
+// At most types.MaxCallbacksPerBlock are executed, the remaining callbacks are due in the next block then.
+// A failing contract call does not abort the block. Its state changes are reverted but the fee is still charged.
+func (k Keeper) ExecuteDueCallbacks(ctx context.Context) {
+	// the gas register params are read once for all callbacks of the block
+	sdkCtx, _ := k.withGasRegister(sdk.UnwrapSDKContext(ctx))
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CallbackByHeightIndexPrefix)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(sdkCtx.BlockHeight()+1)))
+	var callbackIDs []uint64
+	for ; iter.Valid() && len(callbackIDs) < types.MaxCallbacksPerBlock; iter.Next() {
+		callbackIDs = append(callbackIDs, sdk.BigEndianToUint64(iter.Key()[8:]))
+	}
+	iter.Close()
+
+	for _, id := range callbackIDs {
+		callback := k.GetCallback(sdkCtx, id)
+		if callback == nil {
+			continue
+		}
+		cacheCtx, commit := sdkCtx.CacheContext()
+		if err := k.tryExecuteCallback(cacheCtx, *callback); err != nil {
+			// a failure must not stop the chain, drop the callback so that it does not block the queue
+			k.Logger(sdkCtx).Error("failed to execute callback", "callback_id", id, "error", err)
+			k.dropCallback(sdkCtx, *callback)
+			continue
+		}
+		commit()
+	}
+}
+
+// dropCallback removes a callback that failed to execute and refunds the escrow to the registrant. When the
+// refund fails as well, the callback is deleted without a refund.
+func (k Keeper) dropCallback(ctx sdk.Context, callback types.ContractCallback) {
+	cacheCtx, commit := ctx.CacheContext()
+	err := k.removeCallback(cacheCtx, callback)
+	if err == nil {
+		commit()
+		return
+	}
+	k.Logger(ctx).Error("failed to refund callback escrow", "callback_id", callback.ID, "error", err)
+	if err := k.deleteCallback(ctx, callback); err != nil {
+		panic(err)
+	}
+}
+
+// tryExecuteCallback executes the callback and turns a panic into an error, so that a misbehaving contract or
+// module can not halt the chain in the EndBlocker.
+func (k Keeper) tryExecuteCallback(ctx sdk.Context, callback types.ContractCallback) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			k.Logger(ctx).Error("callback execution panicked", "callback_id", callback.ID, "panic", r)
+			err = errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r)
+		}
+	}()
+	return k.executeCallback(ctx, callback)
+}
+
+func (k Keeper) executeCallback(ctx sdk.Context, callback types.ContractCallback) error {
+	if err := k.deleteCallback(ctx, callback); err != nil {
+		return err
+	}
+	if !callback.Escrow.IsAllGTE(callback.Fee) {
+		k.emitCallbackEvent(ctx, callback, errorsmod.Wrap(sdkerrors.ErrInsufficientFee, "escrow"))
+		return k.refundCallbackEscrow(ctx, callback)
+	}
+	if !callback.Fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, callback.Fee); err != nil {
+			return errorsmod.Wrap(err, "fee")
+		}
+		callback.Escrow = callback.Escrow.Sub(callback.Fee...)
+	}
+
+	execErr := k.runCallback(ctx, callback)
+	k.emitCallbackEvent(ctx, callback, execErr)
+
+	if callback.Interval == 0 || !callback.Escrow.IsAllGTE(callback.Fee) {
+		return k.refundCallbackEscrow(ctx, callback)
+	}
+	callback.NextHeight = ctx.BlockHeight() + int64(callback.Interval)
+	return k.storeCallback(ctx, callback)
+}
+
+// runCallback executes the sudo call with the callback's gas limit. State changes are only persisted on success.
+// A panic in the contract call is turned into an error so that the callback is handled like any other failure.
+func (k Keeper) runCallback(ctx sdk.Context, callback types.ContractCallback) (err error) {
+	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(callback.GasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrap(sdkerrors.ErrOutOfGas, outOfGas.Descriptor)
+				return
+			}
+			k.Logger(ctx).Error("callback panicked", "callback_id", callback.ID, "panic", r)
+			err = sdkerrors.ErrPanic
+		}
+	}()
+
+	if _, err := k.Sudo(cacheCtx, sdk.MustAccAddressFromBech32(callback.Contract), callback.Msg); err != nil {
+		return err
+	}
+	commit()
+	return nil
+}
+
+func (k Keeper) emitCallbackEvent(ctx sdk.Context, callback types.ContractCallback, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyContractAddr, callback.Contract),
+		sdk.NewAttribute(types.AttributeKeyCallbackID, strconv.FormatUint(callback.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyCallbackSuccess, strconv.FormatBool(err == nil)),
+	}
+	typedEvt := &types.EventCallback{Contract: callback.Contract, CallbackID: callback.ID, Success: err == nil}
+	if err != nil {
+		typedEvt.Error = k.redactError(ctx, err).Error()
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyCallbackError, typedEvt.Error))
+	}
+	k.emitActionEvent(ctx, sdk.NewEvent(types.EventTypeCallback, attributes...), typedEvt)
+}
+
+func (k Keeper) refundCallbackEscrow(ctx sdk.Context, callback types.ContractCallback) error {
+	if callback.Escrow.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(callback.Sender), callback.Escrow)
+}
+
+// removeCallback deletes the callback and refunds the remaining escrow
+func (k Keeper) removeCallback(ctx sdk.Context, callback types.ContractCallback) error {
+	if err := k.deleteCallback(ctx, callback); err != nil {
+		return err
+	}
+	return k.refundCallbackEscrow(ctx, callback)
+}
+
+// GetCallback returns the scheduled callback for the given id or nil when not found
+func (k Keeper) GetCallback(ctx context.Context, callbackID uint64) *types.ContractCallback {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetCallbackKey(callbackID))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var callback types.ContractCallback
+	k.cdc.MustUnmarshal(bz, &callback)
+	return &callback
+}
+
+// IterateCallbacksByContract iterates over all scheduled callbacks of the given contract in order of registration.
+func (k Keeper) IterateCallbacksByContract(ctx context.Context, contractAddress sdk.AccAddress, cb func(types.ContractCallback) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetCallbacksByContractPrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		callback := k.GetCallback(ctx, sdk.BigEndianToUint64(iter.Key()))
+		if callback == nil {
+			continue
+		}
+		if cb(*callback) {
+			return
+		}
+	}
+}
+
+// IterateCallbacks iterates over all scheduled callbacks in order of registration.
+func (k Keeper) IterateCallbacks(ctx context.Context, cb func(types.ContractCallback) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CallbackPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var callback types.ContractCallback
+		k.cdc.MustUnmarshal(iter.Value(), &callback)
+		if cb(callback) {
+			return
+		}
+	}
+}
+
+// storeCallback persists the callback together with the height and contract secondary indexes
+func (k Keeper) storeCallback(ctx context.Context, callback types.ContractCallback) error {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetCallbackKey(callback.ID), k.cdc.MustMarshal(&callback)); err != nil {
+		return err
+	}
+	if err := store.Set(types.GetCallbackByHeightIndexKey(callback.NextHeight, callback.ID), []byte{}); err != nil {
+		return err
+	}
+	return store.Set(types.GetCallbackByContractIndexKey(contractAddress, callback.ID), []byte{})
+}
+
+func (k Keeper) deleteCallback(ctx context.Context, callback types.ContractCallback) error {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetCallbackKey(callback.ID)); err != nil {
+		return err
+	}
+	if err := store.Delete(types.GetCallbackByHeightIndexKey(callback.NextHeight, callback.ID)); err != nil {
+		return err
+	}
+	return store.Delete(types.GetCallbackByContractIndexKey(contractAddress, callback.ID))
+}
+
+func (k Keeper) importCallback(ctx context.Context, callback types.ContractCallback) error {
+	if k.GetCallback(ctx, callback.ID) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "callback: %d", callback.ID)
+	}
+	if !k.HasContractInfo(ctx, sdk.MustAccAddressFromBech32(callback.Contract)) {
+		return types.ErrNoSuchContractFn(callback.Contract).Wrapf("address %s", callback.Contract)
+	}
+	return k.storeCallback(ctx, callback)
+}
@@ -0,0 +1,542 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestRegisterCallback(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	escrow := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+	otherAddr := keepers.Faucet.NewFundedRandomAccount(parentCtx, escrow...)
+	keepers.Faucet.Fund(parentCtx, example.CreatorAddr, escrow...)
+
+	specs := map[string]struct {
+		sender      sdk.AccAddress
+		contract    sdk.AccAddress
+		startHeight int64
+		gasLimit    uint64
+		escrow      sdk.Coins
+		gasPrice    *sdk.DecCoin
+		authZ       types.AuthorizationPolicy
+		expErr      *errorsmod.Error
+	}{
+		"contract admin": {
+			sender:      example.CreatorAddr,
+			contract:    example.Contract,
+			startHeight: parentCtx.BlockHeight() + 1,
+			gasLimit:    100_000,
+			escrow:      escrow,
+			authZ:       DefaultAuthorizationPolicy{},
+		},
+		"contract itself": {
+			sender:      example.Contract,
+			contract:    example.Contract,
+			startHeight: parentCtx.BlockHeight() + 1,
+			gasLimit:    100_000,
+			escrow:      escrow,
+			authZ:       DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			sender:      otherAddr,
+			contract:    example.Contract,
+			startHeight: parentCtx.BlockHeight() + 1,
+			gasLimit:    100_000,
+			escrow:      escrow,
+			authZ:       GovAuthorizationPolicy{},
+		},
+		"without escrow": {
+			sender:      example.CreatorAddr,
+			contract:    example.Contract,
+			startHeight: parentCtx.BlockHeight() + 1,
+			gasLimit:    100_000,
+			authZ:       DefaultAuthorizationPolicy{},
+		},
+		"fee covers callback gas price": {
+			sender:      example.CreatorAddr,
+			contract:    example.Contract,
+			startHeight: parentCtx.BlockHeight() + 1,
+			gasLimit:    100_000,
+			escrow:      escrow,
+			gasPrice:    &sdk.DecCoin{Denom: "denom", Amount: sdkmath.LegacyNewDecWithPrec(1, 5)},
+			authZ:       DefaultAuthorizationPolicy{},
+		},
+		"fee below callback gas price": {
+			sender:      example.CreatorAddr,
+			contract:    example.Contract,
+			startHeight: parentCtx.BlockHeight() + 1,
+			gasLimit:    100_001,
+			escrow:      escrow,
+			gasPrice:    &sdk.DecCoin{Denom: "denom", Amount: sdkmath.LegacyNewDecWithPrec(1, 5)},
+			authZ:       DefaultAuthorizationPolicy{},
+			expErr:      sdkerrors.ErrInsufficientFee,
+		},
+		"fee in other denom than callback gas price": {
+			sender:      example.CreatorAddr,
+			contract:    example.Contract,
+			startHeight: parentCtx.BlockHeight() + 1,
+			gasLimit:    100_000,
+			escrow:      escrow,
+			gasPrice:    &sdk.DecCoin{Denom: "stake", Amount: sdkmath.LegacyNewDecWithPrec(1, 5)},
+			authZ:       DefaultAuthorizationPolicy{},
+			expErr:      sdkerrors.ErrInsufficientFee,
+		},
+		"other sender": {
+			sender:      otherAddr,
+			contract:    example.Contract,
+			startHeight: parentCtx.BlockHeight() + 1,
+			gasLimit:    100_000,
+			escrow:      escrow,
+			authZ:       DefaultAuthorizationPolicy{},
+			expErr:      sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			sender:      example.CreatorAddr,
+			contract:    RandomAccountAddress(t),
+			startHeight: parentCtx.BlockHeight() + 1,
+			gasLimit:    100_000,
+			escrow:      escrow,
+			authZ:       DefaultAuthorizationPolicy{},
+			expErr:      sdkerrors.ErrInvalidRequest,
+		},
+		"start height not in the future": {
+			sender:      example.CreatorAddr,
+			contract:    example.Contract,
+			startHeight: parentCtx.BlockHeight(),
+			gasLimit:    100_000,
+			escrow:      escrow,
+			authZ:       DefaultAuthorizationPolicy{},
+			expErr:      types.ErrInvalid,
+		},
+		"gas limit exceeds max": {
+			sender:      example.CreatorAddr,
+			contract:    example.Contract,
+			startHeight: parentCtx.BlockHeight() + 1,
+			gasLimit:    types.MaxCallbackGasLimit + 1,
+			escrow:      escrow,
+			authZ:       DefaultAuthorizationPolicy{},
+			expErr:      types.ErrLimit,
+		},
+		"insufficient funds for escrow": {
+			sender:      example.CreatorAddr,
+			contract:    example.Contract,
+			startHeight: parentCtx.BlockHeight() + 1,
+			gasLimit:    100_000,
+			escrow:      sdk.NewCoins(sdk.NewInt64Coin("denom", 1_000_000_000)),
+			authZ:       DefaultAuthorizationPolicy{},
+			expErr:      sdkerrors.ErrInsufficientFunds,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if spec.gasPrice != nil {
+				params := types.DefaultParams()
+				params.CallbackGasPrice = spec.gasPrice
+				require.NoError(t, k.SetParams(ctx, params))
+			}
+			senderBalance := keepers.BankKeeper.GetAllBalances(ctx, spec.sender)
+
+			// when
+			gotID, gotErr := k.registerCallback(ctx, spec.sender, spec.contract, []byte(`{}`), spec.startHeight, 2, spec.gasLimit, fee, spec.escrow, spec.authZ)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			exp := types.ContractCallback{
+				ID:         gotID,
+				Contract:   spec.contract.String(),
+				Sender:     spec.sender.String(),
+				Msg:        []byte(`{}`),
+				NextHeight: spec.startHeight,
+				Interval:   2,
+				GasLimit:   spec.gasLimit,
+				Fee:        fee,
+				Escrow:     spec.escrow,
+			}
+			got := k.GetCallback(ctx, gotID)
+			require.NotNil(t, got)
+			assert.Equal(t, exp.String(), got.String())
+			var byContract []types.ContractCallback
+			k.IterateCallbacksByContract(ctx, spec.contract, func(c types.ContractCallback) bool {
+				byContract = append(byContract, c)
+				return false
+			})
+			require.Len(t, byContract, 1)
+			assert.Equal(t, gotID, byContract[0].ID)
+			assert.Equal(t, spec.escrow.String(), keepers.BankKeeper.GetAllBalances(ctx, moduleAddr).String())
+			assert.Equal(t, senderBalance.Sub(spec.escrow...).String(), keepers.BankKeeper.GetAllBalances(ctx, spec.sender).String())
+		})
+	}
+}
+
+func TestCancelCallback(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	escrow := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+	keepers.Faucet.Fund(parentCtx, example.CreatorAddr, escrow...)
+	otherAddr := RandomAccountAddress(t)
+	callbackID, err := k.registerCallback(parentCtx, example.CreatorAddr, example.Contract, []byte(`{}`), parentCtx.BlockHeight()+1, 0, 100_000, nil, escrow, DefaultAuthorizationPolicy{})
+	require.NoError(t, err)
+	creatorBalance := keepers.BankKeeper.GetAllBalances(parentCtx, example.CreatorAddr)
+
+	specs := map[string]struct {
+		callbackID uint64
+		sender     sdk.AccAddress
+		authZ      types.AuthorizationPolicy
+		expErr     *errorsmod.Error
+	}{
+		"registrant": {
+			callbackID: callbackID,
+			sender:     example.CreatorAddr,
+			authZ:      DefaultAuthorizationPolicy{},
+		},
+		"contract itself": {
+			callbackID: callbackID,
+			sender:     example.Contract,
+			authZ:      DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			callbackID: callbackID,
+			sender:     otherAddr,
+			authZ:      GovAuthorizationPolicy{},
+		},
+		"other sender": {
+			callbackID: callbackID,
+			sender:     otherAddr,
+			authZ:      DefaultAuthorizationPolicy{},
+			expErr:     sdkerrors.ErrUnauthorized,
+		},
+		"unknown callback": {
+			callbackID: callbackID + 1,
+			sender:     example.CreatorAddr,
+			authZ:      DefaultAuthorizationPolicy{},
+			expErr:     types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+
+			// when
+			gotRefund, gotErr := k.cancelCallback(ctx, spec.callbackID, spec.sender, spec.authZ)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.NotNil(t, k.GetCallback(ctx, callbackID))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, escrow, gotRefund)
+			assert.Nil(t, k.GetCallback(ctx, callbackID))
+			k.IterateCallbacksByContract(ctx, example.Contract, func(c types.ContractCallback) bool {
+				t.Fatalf("unexpected callback: %d", c.ID)
+				return false
+			})
+			// refund goes to the registrant
+			assert.Equal(t, creatorBalance.Add(escrow...).String(), keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr).String())
+			assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+		})
+	}
+}
+
+func TestExecuteDueCallbacks(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	recipient := RandomAccountAddress(t)
+	stealMsgBz := mustMarshal(t, sudoMsg{StealFunds: stealFundsMsg{
+		Recipient: recipient.String(),
+		Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1, "denom")},
+	}})
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	keepers.Faucet.Fund(parentCtx, example.CreatorAddr, sdk.NewInt64Coin("stake", 10))
+
+	specs := map[string]struct {
+		msg           []byte
+		interval      uint64
+		gasLimit      uint64
+		escrow        sdk.Coins
+		expSuccess    bool
+		expRefund     sdk.Coins
+		expReschedule bool
+	}{
+		"one time": {
+			msg:        stealMsgBz,
+			gasLimit:   1_000_000,
+			escrow:     sdk.NewCoins(sdk.NewInt64Coin("stake", 3)),
+			expSuccess: true,
+			expRefund:  sdk.NewCoins(sdk.NewInt64Coin("stake", 2)),
+		},
+		"interval": {
+			msg:           stealMsgBz,
+			interval:      2,
+			gasLimit:      1_000_000,
+			escrow:        sdk.NewCoins(sdk.NewInt64Coin("stake", 3)),
+			expSuccess:    true,
+			expReschedule: true,
+		},
+		"interval with escrow exhausted": {
+			msg:        stealMsgBz,
+			interval:   2,
+			gasLimit:   1_000_000,
+			escrow:     sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+			expSuccess: true,
+			expRefund:  sdk.NewCoins(),
+		},
+		"out of gas": {
+			msg:       stealMsgBz,
+			gasLimit:  1,
+			escrow:    sdk.NewCoins(sdk.NewInt64Coin("stake", 3)),
+			expRefund: sdk.NewCoins(sdk.NewInt64Coin("stake", 2)),
+		},
+		"contract error": {
+			msg:       []byte(`{"unknown":{}}`),
+			gasLimit:  1_000_000,
+			escrow:    sdk.NewCoins(sdk.NewInt64Coin("stake", 3)),
+			expRefund: sdk.NewCoins(sdk.NewInt64Coin("stake", 2)),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			startHeight := ctx.BlockHeight() + 1
+			callbackID, err := k.registerCallback(ctx, example.CreatorAddr, example.Contract, spec.msg, startHeight, spec.interval, spec.gasLimit, fee, spec.escrow, DefaultAuthorizationPolicy{})
+			require.NoError(t, err)
+			creatorBalance := keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr)
+			feeCollectorBalance := keepers.BankKeeper.GetAllBalances(ctx, feeCollectorAddr)
+
+			// not due yet
+			k.ExecuteDueCallbacks(ctx)
+			require.NotNil(t, k.GetCallback(ctx, callbackID))
+			assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+
+			// when
+			ctx = ctx.WithBlockHeight(startHeight).WithEventManager(sdk.NewEventManager())
+			k.ExecuteDueCallbacks(ctx)
+
+			// then
+			var gotEvents []sdk.Event
+			for _, e := range ctx.EventManager().Events() {
+				if e.Type == types.EventTypeCallback {
+					gotEvents = append(gotEvents, e)
+				}
+			}
+			require.Len(t, gotEvents, 1)
+			attrs := attrsToStringMap(gotEvents[0].Attributes)
+			assert.Equal(t, example.Contract.String(), attrs[types.AttributeKeyContractAddr])
+			if spec.expSuccess {
+				assert.Equal(t, "true", attrs[types.AttributeKeyCallbackSuccess])
+				assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)), keepers.BankKeeper.GetAllBalances(ctx, recipient))
+			} else {
+				assert.Equal(t, "false", attrs[types.AttributeKeyCallbackSuccess])
+				assert.NotEmpty(t, attrs[types.AttributeKeyCallbackError])
+				assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+			}
+			// fee is charged in any case
+			assert.Equal(t, feeCollectorBalance.Add(fee...).String(), keepers.BankKeeper.GetAllBalances(ctx, feeCollectorAddr).String())
+
+			got := k.GetCallback(ctx, callbackID)
+			if !spec.expReschedule {
+				assert.Nil(t, got)
+				assert.Equal(t, creatorBalance.Add(spec.expRefund...).String(), keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr).String())
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, startHeight+int64(spec.interval), got.NextHeight)
+			assert.Equal(t, spec.escrow.Sub(fee...), got.Escrow)
+			assert.Equal(t, creatorBalance.String(), keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr).String())
+
+			// executed again at next height only
+			ctx = ctx.WithBlockHeight(got.NextHeight - 1)
+			k.ExecuteDueCallbacks(ctx)
+			assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)), keepers.BankKeeper.GetAllBalances(ctx, recipient))
+			ctx = ctx.WithBlockHeight(got.NextHeight)
+			k.ExecuteDueCallbacks(ctx)
+			assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 2)), keepers.BankKeeper.GetAllBalances(ctx, recipient))
+		})
+	}
+}
+
+func TestExecuteDueCallbacksRefundsEscrowOnFailure(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	escrow := sdk.NewCoins(sdk.NewInt64Coin("stake", 3))
+	keepers.Faucet.Fund(parentCtx, example.CreatorAddr, escrow...)
+
+	specs := map[string]struct {
+		failRefund bool
+		expRefund  sdk.Coins
+	}{
+		"escrow refunded": {
+			expRefund: escrow,
+		},
+		"refund fails": {
+			failRefund: true,
+			expRefund:  sdk.NewCoins(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			startHeight := ctx.BlockHeight() + 1
+			callbackID, err := k.registerCallback(ctx, example.CreatorAddr, example.Contract, []byte(`{}`), startHeight, 0, 1_000_000, fee, escrow, DefaultAuthorizationPolicy{})
+			require.NoError(t, err)
+			creatorBalance := keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr)
+			moduleBalance := keepers.BankKeeper.GetAllBalances(ctx, moduleAddr)
+			// the fee can not be charged so that the callback fails
+			bankKeeper := k.bankKeeper
+			k.bankKeeper = failingCallbackBankKeeper{BankKeeper: bankKeeper, failRefund: spec.failRefund}
+			t.Cleanup(func() { k.bankKeeper = bankKeeper })
+
+			// when
+			ctx = ctx.WithBlockHeight(startHeight)
+			k.ExecuteDueCallbacks(ctx)
+
+			// then
+			assert.Nil(t, k.GetCallback(ctx, callbackID))
+			assert.Equal(t, creatorBalance.Add(spec.expRefund...).String(), keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr).String())
+			assert.Equal(t, moduleBalance.Sub(spec.expRefund...).String(), keepers.BankKeeper.GetAllBalances(ctx, moduleAddr).String())
+		})
+	}
+}
+
+// failingCallbackBankKeeper rejects the callback fee transfers and optionally the refunds
+type failingCallbackBankKeeper struct {
+	types.BankKeeper
+	failRefund bool
+}
+
+func (b failingCallbackBankKeeper) SendCoinsFromModuleToModule(context.Context, string, string, sdk.Coins) error {
+	return errors.New("testing")
+}
+
+func (b failingCallbackBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if b.failRefund {
+		return errors.New("testing")
+	}
+	return b.BankKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+func TestExecuteDueCallbacksRecoversPanics(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	escrow := sdk.NewCoins(sdk.NewInt64Coin("stake", 3))
+	keepers.Faucet.Fund(parentCtx, example.CreatorAddr, escrow...)
+
+	specs := map[string]struct {
+		setup     func(t *testing.T)
+		expRefund sdk.Coins
+	}{
+		"contract panics": {
+			setup: func(t *testing.T) {
+				wasmVM := k.wasmVM
+				k.wasmVM = &wasmtesting.MockWasmEngine{SudoFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					panic("testing")
+				}}
+				t.Cleanup(func() { k.wasmVM = wasmVM })
+			},
+			expRefund: escrow.Sub(fee...),
+		},
+		"fee transfer panics": {
+			setup: func(t *testing.T) {
+				bankKeeper := k.bankKeeper
+				k.bankKeeper = panickingCallbackBankKeeper{BankKeeper: bankKeeper}
+				t.Cleanup(func() { k.bankKeeper = bankKeeper })
+			},
+			expRefund: escrow,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			startHeight := ctx.BlockHeight() + 1
+			callbackID, err := k.registerCallback(ctx, example.CreatorAddr, example.Contract, []byte(`{}`), startHeight, 0, 1_000_000, fee, escrow, DefaultAuthorizationPolicy{})
+			require.NoError(t, err)
+			creatorBalance := keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr)
+			spec.setup(t)
+
+			// when
+			ctx = ctx.WithBlockHeight(startHeight)
+			require.NotPanics(t, func() { k.ExecuteDueCallbacks(ctx) })
+
+			// then
+			assert.Nil(t, k.GetCallback(ctx, callbackID))
+			assert.Equal(t, creatorBalance.Add(spec.expRefund...).String(), keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr).String())
+		})
+	}
+}
+
+// panickingCallbackBankKeeper panics on the callback fee transfers
+type panickingCallbackBankKeeper struct {
+	types.BankKeeper
+}
+
+func (b panickingCallbackBankKeeper) SendCoinsFromModuleToModule(context.Context, string, string, sdk.Coins) error {
+	panic("testing")
+}
+
+func TestExecuteDueCallbacksMaxPerBlock(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	startHeight := ctx.BlockHeight() + 1
+	for i := 0; i <= types.MaxCallbacksPerBlock; i++ {
+		_, err := k.registerCallback(ctx, example.CreatorAddr, example.Contract, []byte(`{}`), startHeight, 0, 1, nil, nil, DefaultAuthorizationPolicy{})
+		require.NoError(t, err)
+	}
+	countCallbacks := func() int {
+		var count int
+		k.IterateCallbacks(ctx, func(types.ContractCallback) bool {
+			count++
+			return false
+		})
+		return count
+	}
+
+	// when
+	ctx = ctx.WithBlockHeight(startHeight)
+	k.ExecuteDueCallbacks(ctx)
+
+	// then remaining callback is executed in the next block
+	assert.Equal(t, 1, countCallbacks())
+	ctx = ctx.WithBlockHeight(startHeight + 1)
+	k.ExecuteDueCallbacks(ctx)
+	assert.Equal(t, 0, countCallbacks())
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// storeCodeChunk stages a chunk of wasm code in an upload session. An upload id of 0 starts a new session.
+// The index must match the number of chunks stored before so that chunks can not be reordered or duplicated.
+// Every chunk extends the session by types.CodeUploadTimeout blocks.
+func (k Keeper) storeCodeChunk(ctx context.Context, sender sdk.AccAddress, uploadID, index uint64, chunk []byte) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var session types.CodeUploadSession
+	if uploadID == 0 {
+		session = types.CodeUploadSession{
+			ID:     k.mustAutoIncrementID(sdkCtx, types.KeySequenceUploadID),
+			Sender: sender.String(),
+		}
+	} else {
+		existing, err := k.getActiveCodeUploadSession(sdkCtx, uploadID, sender)
+		if err != nil {
+			return 0, err
+		}
+		if err := k.deleteCodeUploadExpiryIndex(sdkCtx, *existing); err != nil {
+			return 0, err
+		}
+		session = *existing
+	}
+	if index != session.ChunkCount {
+		return 0, errorsmod.Wrapf(types.ErrInvalid, "chunk index: expected %d, got %d", session.ChunkCount, index)
+	}
+	if session.ChunkCount >= types.MaxCodeUploadChunks {
+		return 0, errorsmod.Wrapf(types.ErrLimit, "upload must not exceed %d chunks", types.MaxCodeUploadChunks)
+	}
+	if maxSize := k.GetParams(sdkCtx).WasmSizeLimit(); session.TotalSize+uint64(len(chunk)) > uint64(maxSize) {
+		return 0, errorsmod.Wrapf(types.ErrLimit, "upload must not exceed %d bytes", maxSize)
+	}
+
+	store := k.storeService.OpenKVStore(sdkCtx)
+	if err := store.Set(types.GetCodeUploadChunkKey(session.ID, index), chunk); err != nil {
+		return 0, err
+	}
+	session.ChunkCount++
+	session.TotalSize += uint64(len(chunk))
+	session.ExpiryHeight = sdkCtx.BlockHeight() + types.CodeUploadTimeout
+	if err := k.storeCodeUploadSession(sdkCtx, session); err != nil {
+		return 0, err
+	}
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
+		types.EventTypeStoreCodeChunk,
+		sdk.NewAttribute(types.AttributeKeyUploadID, strconv.FormatUint(session.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyChunkIndex, strconv.FormatUint(index, 10)),
+		sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(session.ExpiryHeight, 10)),
+	), &types.EventStoreCodeChunk{UploadID: session.ID, ChunkIndex: index, ExpiryHeight: session.ExpiryHeight})
+	return session.ID, nil
+}
+
+// finalizeCodeUpload concatenates the chunks of an upload session and stores them as new wasm code when the sha256
+// hash of the concatenated chunks matches the given checksum. The session is removed on success only.
+func (k Keeper) finalizeCodeUpload(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	uploadID uint64,
+	checksum []byte,
+	instantiateAccess *types.AccessConfig,
+	authZ types.AuthorizationPolicy,
+) (uint64, []byte, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	session, err := k.getActiveCodeUploadSession(sdkCtx, uploadID, sender)
+	if err != nil {
+		return 0, nil, err
+	}
+	// authorize before the session is consumed so that the chunks can be finalized later when the access changes
+	if instantiateAccess, err = k.authorizeCodeUpload(sdkCtx, sender, instantiateAccess, authZ); err != nil {
+		return 0, nil, err
+	}
+
+	wasmCode := make([]byte, 0, session.TotalSize)
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(sdkCtx)), types.GetCodeUploadChunksPrefix(uploadID))
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		wasmCode = append(wasmCode, iter.Value()...)
+	}
+	iter.Close()
+	if got := sha256.Sum256(wasmCode); !bytes.Equal(got[:], checksum) {
+		return 0, nil, errorsmod.Wrap(types.ErrInvalid, "checksum does not match uploaded chunks")
+	}
+
+	if err := k.deleteCodeUploadSession(sdkCtx, *session); err != nil {
+		return 0, nil, err
+	}
+	return k.create(sdkCtx, sender, wasmCode, instantiateAccess, authZ)
+}
+
+// getActiveCodeUploadSession returns the upload session when it was started by the sender and has not expired
+func (k Keeper) getActiveCodeUploadSession(ctx sdk.Context, uploadID uint64, sender sdk.AccAddress) (*types.CodeUploadSession, error) {
+	session := k.GetCodeUploadSession(ctx, uploadID)
+	if session == nil {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "upload session %d", uploadID)
+	}
+	if session.Sender != sender.String() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify upload session")
+	}
+	if ctx.BlockHeight() > session.ExpiryHeight {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "upload session expired at height %d", session.ExpiryHeight)
+	}
+	return session, nil
+}
+
+// PruneExpiredCodeUploads removes the upload sessions and their staged chunks that expired before the current block
+// height. At most types.MaxCodeUploadsPrunedPerBlock sessions are removed, the remaining ones are pruned in the next
+// blocks then.
+func (k Keeper) PruneExpiredCodeUploads(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CodeUploadByExpiryIndexPrefix)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(sdkCtx.BlockHeight())))
+	var uploadIDs []uint64
+	for ; iter.Valid() && len(uploadIDs) < types.MaxCodeUploadsPrunedPerBlock; iter.Next() {
+		uploadIDs = append(uploadIDs, sdk.BigEndianToUint64(iter.Key()[8:]))
+	}
+	iter.Close()
+
+	for _, uploadID := range uploadIDs {
+		session := k.GetCodeUploadSession(sdkCtx, uploadID)
+		if session == nil {
+			continue
+		}
+		if err := k.deleteCodeUploadSession(sdkCtx, *session); err != nil {
+			panic(err)
+		}
+		k.emitActionEvent(sdkCtx, sdk.NewEvent(
+			types.EventTypePruneCodeUpload,
+			sdk.NewAttribute(types.AttributeKeyUploadID, strconv.FormatUint(uploadID, 10)),
+		), &types.EventPruneCodeUpload{UploadID: uploadID})
+	}
+}
+
+// GetCodeUploadSession returns the upload session or nil when none exists
+func (k Keeper) GetCodeUploadSession(ctx context.Context, uploadID uint64) *types.CodeUploadSession {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetCodeUploadSessionKey(uploadID))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var session types.CodeUploadSession
+	k.cdc.MustUnmarshal(bz, &session)
+	return &session
+}
+
+// storeCodeUploadSession persists the upload session together with the expiry secondary index
+func (k Keeper) storeCodeUploadSession(ctx context.Context, session types.CodeUploadSession) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetCodeUploadSessionKey(session.ID), k.cdc.MustMarshal(&session)); err != nil {
+		return err
+	}
+	return store.Set(types.GetCodeUploadByExpiryIndexKey(session.ExpiryHeight, session.ID), []byte{})
+}
+
+func (k Keeper) deleteCodeUploadExpiryIndex(ctx context.Context, session types.CodeUploadSession) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetCodeUploadByExpiryIndexKey(session.ExpiryHeight, session.ID))
+}
+
+// deleteCodeUploadSession removes the upload session with all staged chunks
+func (k Keeper) deleteCodeUploadSession(ctx context.Context, session types.CodeUploadSession) error {
+	store := k.storeService.OpenKVStore(ctx)
+	for i := range session.ChunkCount {
+		if err := store.Delete(types.GetCodeUploadChunkKey(session.ID, i)); err != nil {
+			return err
+		}
+	}
+	if err := store.Delete(types.GetCodeUploadSessionKey(session.ID)); err != nil {
+		return err
+	}
+	return k.deleteCodeUploadExpiryIndex(ctx, session)
+}
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"os"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestStoreCodeChunk(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	myAddr := RandomAccountAddress(t)
+	uploadID, err := k.storeCodeChunk(parentCtx, myAddr, 0, 0, []byte("foo"))
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		sender   sdk.AccAddress
+		uploadID uint64
+		index    uint64
+		chunk    []byte
+		height   int64
+		expErr   *errorsmod.Error
+	}{
+		"next chunk": {
+			sender:   myAddr,
+			uploadID: uploadID,
+			index:    1,
+			chunk:    []byte("bar"),
+		},
+		"new session": {
+			sender: myAddr,
+			chunk:  []byte("bar"),
+		},
+		"last block before expiry": {
+			sender:   myAddr,
+			uploadID: uploadID,
+			index:    1,
+			chunk:    []byte("bar"),
+			height:   parentCtx.BlockHeight() + types.CodeUploadTimeout,
+		},
+		"expired": {
+			sender:   myAddr,
+			uploadID: uploadID,
+			index:    1,
+			chunk:    []byte("bar"),
+			height:   parentCtx.BlockHeight() + types.CodeUploadTimeout + 1,
+			expErr:   types.ErrInvalid,
+		},
+		"duplicate index": {
+			sender:   myAddr,
+			uploadID: uploadID,
+			index:    0,
+			chunk:    []byte("bar"),
+			expErr:   types.ErrInvalid,
+		},
+		"index gap": {
+			sender:   myAddr,
+			uploadID: uploadID,
+			index:    2,
+			chunk:    []byte("bar"),
+			expErr:   types.ErrInvalid,
+		},
+		"other sender": {
+			sender:   RandomAccountAddress(t),
+			uploadID: uploadID,
+			index:    1,
+			chunk:    []byte("bar"),
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown session": {
+			sender:   myAddr,
+			uploadID: uploadID + 1,
+			index:    1,
+			chunk:    []byte("bar"),
+			expErr:   types.ErrNotFound,
+		},
+		"total size exceeds max": {
+			sender:   myAddr,
+			uploadID: uploadID,
+			index:    1,
+			chunk:    make([]byte, types.MaxWasmSize-2),
+			expErr:   types.ErrLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if spec.height != 0 {
+				ctx = ctx.WithBlockHeight(spec.height)
+			}
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotUploadID, gotErr := k.storeCodeChunk(ctx, spec.sender, spec.uploadID, spec.index, spec.chunk)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Equal(t, uint64(1), k.GetCodeUploadSession(ctx, uploadID).ChunkCount)
+				return
+			}
+			require.NoError(t, gotErr)
+			session := k.GetCodeUploadSession(ctx, gotUploadID)
+			require.NotNil(t, session)
+			assert.Equal(t, spec.index+1, session.ChunkCount)
+			assert.Equal(t, spec.sender.String(), session.Sender)
+			assert.Equal(t, ctx.BlockHeight()+types.CodeUploadTimeout, session.ExpiryHeight)
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, types.EventTypeStoreCodeChunk, em.Events()[0].Type)
+			assert.Equal(t, proto.MessageName(&types.EventStoreCodeChunk{}), em.Events()[1].Type)
+		})
+	}
+}
+
+func TestFinalizeCodeUpload(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creator := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 100000))
+	gzippedWasm, err := os.ReadFile("./testdata/hackatom.wasm.gzip")
+	require.NoError(t, err)
+
+	// upload in 3 chunks
+	chunkSize := len(gzippedWasm)/3 + 1
+	var uploadID uint64
+	for i := range uint64(3) {
+		end := min(int(i+1)*chunkSize, len(gzippedWasm))
+		uploadID, err = k.storeCodeChunk(parentCtx, creator, uploadID, i, gzippedWasm[int(i)*chunkSize:end])
+		require.NoError(t, err)
+	}
+	uploadChecksum := sha256.Sum256(gzippedWasm)
+	wasmChecksum := sha256.Sum256(hackatomWasm)
+
+	specs := map[string]struct {
+		sender   sdk.AccAddress
+		checksum []byte
+		setup    func(ctx sdk.Context)
+		expErr   *errorsmod.Error
+	}{
+		"all good": {
+			sender:   creator,
+			checksum: uploadChecksum[:],
+		},
+		"checksum of uncompressed code": {
+			sender:   creator,
+			checksum: wasmChecksum[:],
+			expErr:   types.ErrInvalid,
+		},
+		"other sender": {
+			sender:   RandomAccountAddress(t),
+			checksum: uploadChecksum[:],
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"not authorized to create code": {
+			sender:   creator,
+			checksum: uploadChecksum[:],
+			setup: func(ctx sdk.Context) {
+				params := types.DefaultParams()
+				params.CodeUploadAccess = types.AllowNobody
+				require.NoError(t, k.SetParams(ctx, params))
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if spec.setup != nil {
+				spec.setup(ctx)
+			}
+
+			// when
+			gotCodeID, gotChecksum, gotErr := k.finalizeCodeUpload(ctx, spec.sender, uploadID, spec.checksum, &types.AllowEverybody, DefaultAuthorizationPolicy{})
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.NotNil(t, k.GetCodeUploadSession(ctx, uploadID))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, wasmChecksum[:], gotChecksum)
+			codeInfo := k.GetCodeInfo(ctx, gotCodeID)
+			require.NotNil(t, codeInfo)
+			assert.Equal(t, types.AllowEverybody, codeInfo.InstantiateConfig)
+			// and session removed
+			assert.Nil(t, k.GetCodeUploadSession(ctx, uploadID))
+			store := k.storeService.OpenKVStore(ctx)
+			for i := range uint64(3) {
+				bz, err := store.Get(types.GetCodeUploadChunkKey(uploadID, i))
+				require.NoError(t, err)
+				assert.Nil(t, bz)
+			}
+		})
+	}
+}
+
+func TestPruneExpiredCodeUploads(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	myAddr := RandomAccountAddress(t)
+	startHeight := ctx.BlockHeight()
+	abandonedID, err := k.storeCodeChunk(ctx, myAddr, 0, 0, []byte("foo"))
+	require.NoError(t, err)
+	activeID, err := k.storeCodeChunk(ctx, myAddr, 0, 0, []byte("foo"))
+	require.NoError(t, err)
+	// extend the active session
+	_, err = k.storeCodeChunk(ctx.WithBlockHeight(startHeight+1), myAddr, activeID, 1, []byte("bar"))
+	require.NoError(t, err)
+
+	// when not expired
+	k.PruneExpiredCodeUploads(ctx.WithBlockHeight(startHeight + types.CodeUploadTimeout))
+	// then
+	assert.NotNil(t, k.GetCodeUploadSession(ctx, abandonedID))
+	assert.NotNil(t, k.GetCodeUploadSession(ctx, activeID))
+
+	// when expired
+	em := sdk.NewEventManager()
+	k.PruneExpiredCodeUploads(ctx.WithBlockHeight(startHeight + types.CodeUploadTimeout + 1).WithEventManager(em))
+	// then
+	assert.Nil(t, k.GetCodeUploadSession(ctx, abandonedID))
+	assert.NotNil(t, k.GetCodeUploadSession(ctx, activeID))
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetCodeUploadChunkKey(abandonedID, 0))
+	require.NoError(t, err)
+	assert.Nil(t, bz)
+	require.Len(t, em.Events(), 2)
+	assert.Equal(t, types.EventTypePruneCodeUpload, em.Events()[0].Type)
+	assert.Equal(t, proto.MessageName(&types.EventPruneCodeUpload{}), em.Events()[1].Type)
+}
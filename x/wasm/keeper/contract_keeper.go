@@ -33,6 +33,43 @@ type decoratedKeeper interface {
 	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	setContractInfoExtension(ctx context.Context, contract sdk.AccAddress, extra types.ContractInfoExtension) error
 	setAccessConfig(ctx context.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, authz types.AuthorizationPolicy) error
+	deleteCode(ctx context.Context, codeID uint64, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error
+	setContractFrozen(ctx context.Context, contractAddress sdk.AccAddress, frozen bool) error
+	registerCallback(
+		ctx context.Context,
+		sender, contractAddress sdk.AccAddress,
+		msg []byte,
+		startHeight int64,
+		interval, gasLimit uint64,
+		fee, escrow sdk.Coins,
+		authZ types.AuthorizationPolicy,
+	) (uint64, error)
+	cancelCallback(ctx context.Context, callbackID uint64, caller sdk.AccAddress, authZ types.AuthorizationPolicy) (sdk.Coins, error)
+	proposeContractAdmin(ctx context.Context, contractAddress, caller, newAdmin sdk.AccAddress, expiryHeight uint64, authZ types.AuthorizationPolicy) error
+	acceptContractAdmin(ctx context.Context, contractAddress, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error
+	cancelAdminProposal(ctx context.Context, contractAddress, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error
+	setContractMigrationDelay(ctx context.Context, contractAddress, caller sdk.AccAddress, delay uint64, authZ types.AuthorizationPolicy) error
+	cancelMigration(ctx context.Context, contractAddress, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error
+	migrateContractsByCode(
+		ctx context.Context,
+		fromCodeID, toCodeID uint64,
+		msg []byte,
+		limit uint64,
+		startAfter, caller sdk.AccAddress,
+		authZ types.AuthorizationPolicy,
+	) ([]types.MigrateContractResult, error)
+	storeCodeChunk(ctx context.Context, sender sdk.AccAddress, uploadID, index uint64, chunk []byte) (uint64, error)
+	finalizeCodeUpload(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		uploadID uint64,
+		checksum []byte,
+		instantiateAccess *types.AccessConfig,
+		authZ types.AuthorizationPolicy,
+	) (uint64, []byte, error)
+	sponsorContractStorage(ctx context.Context, contractAddress, sponsor sdk.AccAddress, authZ types.AuthorizationPolicy) error
+	clearContractStorageSponsor(ctx context.Context, contractAddress, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error
+	setContractStateQuota(ctx context.Context, contractAddress, caller sdk.AccAddress, maxStateSize uint64, authZ types.AuthorizationPolicy) error
 	ClassicAddressGenerator() AddressGenerator
 }
 
@@ -130,3 +167,103 @@ func (p PermissionedKeeper) SetContractInfoExtension(ctx sdk.Context, contract s
 func (p PermissionedKeeper) SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig) error {
 	return p.nested.setAccessConfig(ctx, codeID, caller, newConfig, p.authZPolicy)
 }
+
+// DeleteCode removes a code that is not used by any contract anymore.
+func (p PermissionedKeeper) DeleteCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress) error {
+	return p.nested.deleteCode(ctx, codeID, caller, p.authZPolicy)
+}
+
+// FreezeContract rejects any execution of the contract until it is unfrozen.
+func (p PermissionedKeeper) FreezeContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	return p.nested.setContractFrozen(ctx, contractAddress, true)
+}
+
+// UnfreezeContract allows the execution of a frozen contract again.
+func (p PermissionedKeeper) UnfreezeContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	return p.nested.setContractFrozen(ctx, contractAddress, false)
+}
+
+// RegisterCallback schedules a sudo call into the contract at the end of a block.
+func (p PermissionedKeeper) RegisterCallback(
+	ctx sdk.Context,
+	sender, contractAddress sdk.AccAddress,
+	msg []byte,
+	startHeight int64,
+	interval, gasLimit uint64,
+	fee, escrow sdk.Coins,
+) (uint64, error) {
+	return p.nested.registerCallback(ctx, sender, contractAddress, msg, startHeight, interval, gasLimit, fee, escrow, p.authZPolicy)
+}
+
+// CancelCallback removes a scheduled callback and refunds the remaining escrow to the registrant.
+func (p PermissionedKeeper) CancelCallback(ctx sdk.Context, callbackID uint64, caller sdk.AccAddress) (sdk.Coins, error) {
+	return p.nested.cancelCallback(ctx, callbackID, caller, p.authZPolicy)
+}
+
+// ProposeContractAdmin starts a two-step admin transfer that must be accepted by the new admin.
+func (p PermissionedKeeper) ProposeContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, expiryHeight uint64) error {
+	return p.nested.proposeContractAdmin(ctx, contractAddress, caller, newAdmin, expiryHeight, p.authZPolicy)
+}
+
+// AcceptContractAdmin completes a pending admin transfer.
+func (p PermissionedKeeper) AcceptContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error {
+	return p.nested.acceptContractAdmin(ctx, contractAddress, caller, p.authZPolicy)
+}
+
+// CancelAdminProposal removes a pending admin transfer.
+func (p PermissionedKeeper) CancelAdminProposal(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error {
+	return p.nested.cancelAdminProposal(ctx, contractAddress, caller, p.authZPolicy)
+}
+
+// SetContractMigrationDelay sets the number of blocks a migration by the admin is queued before it is executed.
+func (p PermissionedKeeper) SetContractMigrationDelay(ctx sdk.Context, contractAddress, caller sdk.AccAddress, delay uint64) error {
+	return p.nested.setContractMigrationDelay(ctx, contractAddress, caller, delay, p.authZPolicy)
+}
+
+// CancelMigration removes a pending migration.
+func (p PermissionedKeeper) CancelMigration(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error {
+	return p.nested.cancelMigration(ctx, contractAddress, caller, p.authZPolicy)
+}
+
+// MigrateContractsByCode migrates a batch of contracts of a code to a new code version.
+func (p PermissionedKeeper) MigrateContractsByCode(
+	ctx sdk.Context,
+	fromCodeID, toCodeID uint64,
+	msg []byte,
+	limit uint64,
+	startAfter, caller sdk.AccAddress,
+) ([]types.MigrateContractResult, error) {
+	return p.nested.migrateContractsByCode(ctx, fromCodeID, toCodeID, msg, limit, startAfter, caller, p.authZPolicy)
+}
+
+// StoreCodeChunk stages a chunk of wasm code in an upload session. An upload id of 0 starts a new session.
+func (p PermissionedKeeper) StoreCodeChunk(ctx sdk.Context, sender sdk.AccAddress, uploadID, index uint64, chunk []byte) (uint64, error) {
+	return p.nested.storeCodeChunk(ctx, sender, uploadID, index, chunk)
+}
+
+// FinalizeCodeUpload stores the concatenated chunks of an upload session as new wasm code.
+func (p PermissionedKeeper) FinalizeCodeUpload(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	uploadID uint64,
+	checksum []byte,
+	instantiateAccess *types.AccessConfig,
+) (codeID uint64, codeChecksum []byte, err error) {
+	return p.nested.finalizeCodeUpload(ctx, sender, uploadID, checksum, instantiateAccess, p.authZPolicy)
+}
+
+// SponsorContractStorage makes the sponsor pay the storage deposit of the contract. The sponsor must be
+// authorized to modify the contract.
+func (p PermissionedKeeper) SponsorContractStorage(ctx sdk.Context, contractAddress, sponsor sdk.AccAddress) error {
+	return p.nested.sponsorContractStorage(ctx, contractAddress, sponsor, p.authZPolicy)
+}
+
+// ClearContractStorageSponsor refunds the storage deposit to the sponsor and removes it.
+func (p PermissionedKeeper) ClearContractStorageSponsor(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error {
+	return p.nested.clearContractStorageSponsor(ctx, contractAddress, caller, p.authZPolicy)
+}
+
+// SetContractStateQuota sets the maximum number of bytes the contract can store in its state.
+func (p PermissionedKeeper) SetContractStateQuota(ctx sdk.Context, contractAddress, caller sdk.AccAddress, maxStateSize uint64) error {
+	return p.nested.setContractStateQuota(ctx, contractAddress, caller, maxStateSize, p.authZPolicy)
+}
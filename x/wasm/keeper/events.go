@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -63,3 +64,28 @@ func contractSDKEventAttributes(customAttributes []wasmvmtypes.EventAttribute, c
 	}
 	return attrs, nil
 }
+
+// emitActionEvent emits the legacy event of a module action followed by the typed event
+func (k Keeper) emitActionEvent(ctx sdk.Context, legacy sdk.Event, typed proto.Message) {
+	emitActionEvent(ctx, k.legacyEventsDisabled, legacy, typed)
+}
+
+// emitActionEvent emits the legacy event of a module action followed by the typed event. The legacy event is skipped
+// when disabled in the node config, except in submessages that pass their events to the calling contract in the reply.
+// Contracts must get the same events on all nodes.
+func emitActionEvent(ctx sdk.Context, legacyEventsDisabled bool, legacy sdk.Event, typed proto.Message) {
+	if !legacyEventsDisabled || types.ContractReplyEvents(ctx) {
+		ctx.EventManager().EmitEvent(legacy)
+	}
+	if err := ctx.EventManager().EmitTypedEvent(typed); err != nil {
+		panic(err)
+	}
+}
+
+// typedEventPrefix is the proto package of the typed events
+const typedEventPrefix = "cosmwasm.wasm.v1."
+
+// isTypedEvent returns true for the typed events of this module. They are not passed to contracts.
+func isTypedEvent(e sdk.Event) bool {
+	return strings.HasPrefix(e.Type, typedEventPrefix)
+}
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -281,6 +283,53 @@ func TestNewWasmModuleEvent(t *testing.T) {
 	}
 }
 
+func TestEmitActionEvent(t *testing.T) {
+	legacyEvt := sdk.NewEvent(types.EventTypePinCode, sdk.NewAttribute(types.AttributeKeyCodeID, "1"))
+	typedEvt := &types.EventPinCode{CodeID: 1}
+	specs := map[string]struct {
+		legacyEventsDisabled bool
+		replyEvents          bool
+		exp                  sdk.Events
+	}{
+		"legacy and typed event": {
+			exp: sdk.Events{legacyEvt, typedEvent(t, typedEvt)},
+		},
+		"legacy events disabled": {
+			legacyEventsDisabled: true,
+			exp:                  sdk.Events{typedEvent(t, typedEvt)},
+		},
+		"legacy events disabled but passed to contract": {
+			legacyEventsDisabled: true,
+			replyEvents:          true,
+			exp:                  sdk.Events{legacyEvt, typedEvent(t, typedEvt)},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			em := sdk.NewEventManager()
+			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(em)
+			if spec.replyEvents {
+				ctx = types.WithContractReplyEvents(ctx)
+			}
+			k := Keeper{legacyEventsDisabled: spec.legacyEventsDisabled}
+
+			// when
+			k.emitActionEvent(ctx, legacyEvt, typedEvt)
+
+			// then
+			assert.Equal(t, spec.exp, em.Events())
+		})
+	}
+}
+
+// typedEvent returns the event that is emitted for the typed event
+func typedEvent(t *testing.T, evt proto.Message) sdk.Event {
+	t.Helper()
+	e, err := sdk.TypedEventToEvent(evt)
+	require.NoError(t, err)
+	return e
+}
+
 // returns true when a wasm module event was emitted for this contract already
 func hasWasmModuleEvent(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
 	for _, e := range ctx.EventManager().Events() {
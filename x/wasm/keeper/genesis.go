@@ -61,6 +61,45 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 		}
 	}
 
+	for i, checksum := range data.CodeRemovalQueue {
+		if err := keeper.importCodeRemoval(ctx, checksum); err != nil {
+			return nil, errorsmod.Wrapf(err, "code removal number %d", i)
+		}
+	}
+
+	var maxCallbackID uint64
+	for i, callback := range data.Callbacks {
+		if err := keeper.importCallback(ctx, callback); err != nil {
+			return nil, errorsmod.Wrapf(err, "callback number %d", i)
+		}
+		if callback.ID > maxCallbackID {
+			maxCallbackID = callback.ID
+		}
+	}
+	if maxCallbackID != 0 {
+		if err := keeper.importAutoIncrementID(ctx, types.KeySequenceCallbackID, maxCallbackID+1); err != nil {
+			return nil, errorsmod.Wrap(err, "callback sequence")
+		}
+	}
+
+	for i, transfer := range data.PendingAdminTransfers {
+		if err := keeper.importPendingAdminTransfer(ctx, transfer); err != nil {
+			return nil, errorsmod.Wrapf(err, "pending admin transfer number %d", i)
+		}
+	}
+
+	for i, migration := range data.PendingMigrations {
+		if err := keeper.importPendingMigration(ctx, migration); err != nil {
+			return nil, errorsmod.Wrapf(err, "pending migration number %d", i)
+		}
+	}
+
+	for i, usage := range data.StorageUsages {
+		if err := keeper.importContractStorageUsage(ctx, usage); err != nil {
+			return nil, errorsmod.Wrapf(err, "storage usage number %d", i)
+		}
+	}
+
 	// sanity check seq values
 	seqVal, err := keeper.PeekAutoIncrementID(ctx, types.KeySequenceCodeID)
 	if err != nil {
@@ -120,6 +159,31 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		return false
 	})
 
+	keeper.IterateCodeRemovalQueue(ctx, func(checksum []byte) bool {
+		genState.CodeRemovalQueue = append(genState.CodeRemovalQueue, checksum)
+		return false
+	})
+
+	keeper.IterateCallbacks(ctx, func(callback types.ContractCallback) bool {
+		genState.Callbacks = append(genState.Callbacks, callback)
+		return false
+	})
+
+	keeper.IteratePendingAdminTransfers(ctx, func(transfer types.PendingAdminTransfer) bool {
+		genState.PendingAdminTransfers = append(genState.PendingAdminTransfers, transfer)
+		return false
+	})
+
+	keeper.IteratePendingMigrations(ctx, func(migration types.PendingMigration) bool {
+		genState.PendingMigrations = append(genState.PendingMigrations, migration)
+		return false
+	})
+
+	keeper.IterateContractStorageUsages(ctx, func(usage types.ContractStorageUsage) bool {
+		genState.StorageUsages = append(genState.StorageUsages, usage)
+		return false
+	})
+
 	for _, k := range [][]byte{types.KeySequenceCodeID, types.KeySequenceInstanceID} {
 		id, err := keeper.PeekAutoIncrementID(ctx, k)
 		if err != nil {
@@ -88,6 +88,43 @@ func TestGenesisExportImport(t *testing.T) {
 		require.NoError(t, wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...))
 		err = wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
 		require.NoError(t, err)
+		if i%5 == 0 {
+			checksum := sha256.Sum256([]byte(fmt.Sprintf("deleted code %d", i)))
+			require.NoError(t, wasmKeeper.importCodeRemoval(srcCtx, checksum[:]))
+		}
+		err = wasmKeeper.storeCallback(srcCtx, types.ContractCallback{
+			ID:         wasmKeeper.mustAutoIncrementID(srcCtx, types.KeySequenceCallbackID),
+			Contract:   contractAddr.String(),
+			Sender:     creatorAddr.String(),
+			Msg:        []byte(`{}`),
+			NextHeight: int64(i + 1),
+			Interval:   uint64(i),
+			GasLimit:   100_000,
+			Fee:        sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			Escrow:     sdk.NewCoins(sdk.NewInt64Coin("denom", int64(i))),
+		})
+		require.NoError(t, err)
+		err = wasmKeeper.storePendingAdminTransfer(srcCtx, contractAddr, types.PendingAdminTransfer{
+			Contract:      contractAddr.String(),
+			ProposedAdmin: creatorAddr.String(),
+			ExpiryHeight:  uint64(i),
+		})
+		require.NoError(t, err)
+		err = wasmKeeper.storePendingMigration(srcCtx, types.PendingMigration{
+			Contract:      contractAddr.String(),
+			Sender:        creatorAddr.String(),
+			CodeID:        codeID,
+			Msg:           []byte(`{}`),
+			ExecuteHeight: int64(i + 1),
+		})
+		require.NoError(t, err)
+		err = wasmKeeper.storeContractStorageUsage(srcCtx, types.ContractStorageUsage{
+			Contract:    contractAddr.String(),
+			StoredBytes: uint64(i),
+			Deposit:     sdk.NewCoins(sdk.NewInt64Coin("denom", int64(i))),
+			Sponsor:     creatorAddr.String(),
+		})
+		require.NoError(t, err)
 	}
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
@@ -106,6 +143,22 @@ func TestGenesisExportImport(t *testing.T) {
 	rand.Shuffle(len(exportedState.Sequences), func(i, j int) {
 		exportedState.Sequences[i], exportedState.Sequences[j] = exportedState.Sequences[j], exportedState.Sequences[i]
 	})
+	require.Len(t, exportedState.CodeRemovalQueue, 5)
+	rand.Shuffle(len(exportedState.CodeRemovalQueue), func(i, j int) {
+		exportedState.CodeRemovalQueue[i], exportedState.CodeRemovalQueue[j] = exportedState.CodeRemovalQueue[j], exportedState.CodeRemovalQueue[i]
+	})
+	rand.Shuffle(len(exportedState.Callbacks), func(i, j int) {
+		exportedState.Callbacks[i], exportedState.Callbacks[j] = exportedState.Callbacks[j], exportedState.Callbacks[i]
+	})
+	rand.Shuffle(len(exportedState.PendingAdminTransfers), func(i, j int) {
+		exportedState.PendingAdminTransfers[i], exportedState.PendingAdminTransfers[j] = exportedState.PendingAdminTransfers[j], exportedState.PendingAdminTransfers[i]
+	})
+	rand.Shuffle(len(exportedState.PendingMigrations), func(i, j int) {
+		exportedState.PendingMigrations[i], exportedState.PendingMigrations[j] = exportedState.PendingMigrations[j], exportedState.PendingMigrations[i]
+	})
+	rand.Shuffle(len(exportedState.StorageUsages), func(i, j int) {
+		exportedState.StorageUsages[i], exportedState.StorageUsages[j] = exportedState.StorageUsages[j], exportedState.StorageUsages[i]
+	})
 	exportedGenesis, err := wasmKeeper.cdc.MarshalJSON(exportedState)
 	require.NoError(t, err)
 
@@ -121,6 +174,10 @@ func TestGenesisExportImport(t *testing.T) {
 		require.NoError(t, err)
 		err = wasmKeeper.addToContractCreatorSecondaryIndex(srcCtx, creatorAddress, history[0].Updated, address)
 		require.NoError(t, err)
+		err = wasmKeeper.addToContractAdminSecondaryIndex(srcCtx, info.AdminAddr(), info.Created, address)
+		require.NoError(t, err)
+		err = wasmKeeper.addToContractLabelIndex(srcCtx, info.Label, address)
+		require.NoError(t, err)
 		return false
 	})
 
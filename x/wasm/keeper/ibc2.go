@@ -139,20 +139,28 @@ func (k Keeper) OnAckIBC2Packet(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBC2AcknowledgeMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc2-ack-packet")
+	ctx, trace := startCallTrace(ctx, "ibc2-ack-packet", contractAddr)
+	defer func() { err = trace.finish(err) }()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := assertNotFrozen(contractAddr, contractInfo); err != nil {
+		return err
+	}
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, contractInfo.CodeID)
 
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBC2PacketAck(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return err
+	}
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -174,8 +182,16 @@ func (k Keeper) OnRecvIBC2Packet(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBC2PacketReceiveMsg,
-) channeltypesv2.RecvPacketResult {
+) (result channeltypesv2.RecvPacketResult) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc2-recv-packet")
+	ctx, trace := startCallTrace(ctx, "ibc2-recv-packet", contractAddr)
+	defer func() {
+		var err error
+		if result.Status == channeltypesv2.PacketStatus_Failure {
+			err = errorsmod.Wrap(types.ErrExecuteFailed, string(result.Acknowledgement))
+		}
+		_ = trace.finish(err)
+	}()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return channeltypesv2.RecvPacketResult{
@@ -183,13 +199,25 @@ func (k Keeper) OnRecvIBC2Packet(
 			Acknowledgement: []byte(err.Error()),
 		}
 	}
+	if err := assertNotFrozen(contractAddr, contractInfo); err != nil {
+		return channeltypesv2.RecvPacketResult{
+			Status:          channeltypesv2.PacketStatus_Failure,
+			Acknowledgement: []byte(err.Error()),
+		}
+	}
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, contractInfo.CodeID)
 
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBC2PacketReceive(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return channeltypesv2.RecvPacketResult{
+			Status:          channeltypesv2.PacketStatus_Failure,
+			Acknowledgement: []byte(err.Error()),
+		}
+	}
 	if execErr != nil {
 		panic(execErr) // let the contract fully abort an IBC packet receive.
 		// Throwing a panic here instead of an error ack will revert
@@ -241,20 +269,28 @@ func (k Keeper) OnTimeoutIBC2Packet(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBC2PacketTimeoutMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc2-timeout-packet")
+	ctx, trace := startCallTrace(ctx, "ibc2-timeout-packet", contractAddr)
+	defer func() { err = trace.finish(err) }()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := assertNotFrozen(contractAddr, contractInfo); err != nil {
+		return err
+	}
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, contractInfo.CodeID)
 
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBC2PacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return err
+	}
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -276,20 +312,28 @@ func (k Keeper) OnSendIBC2Packet(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBC2PacketSendMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc2-send-packet")
+	ctx, trace := startCallTrace(ctx, "ibc2-send-packet", contractAddr)
+	defer func() { err = trace.finish(err) }()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := assertNotFrozen(contractAddr, contractInfo); err != nil {
+		return err
+	}
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, contractInfo.CodeID)
 
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBC2PacketSend(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return err
+	}
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
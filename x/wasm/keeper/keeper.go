@@ -14,7 +14,9 @@ import (
 
 	wasmvm "github.com/CosmWasm/wasmvm/v3"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/cosmos/gogoproto/proto"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"go.opentelemetry.io/otel/trace"
 
 	"cosmossdk.io/collections"
 	corestoretypes "cosmossdk.io/core/store"
@@ -85,16 +87,20 @@ var defaultAcceptedAccountTypes = map[reflect.Type]struct{}{
 // Keeper will have a reference to Wasm Engine with it's own data directory.
 type Keeper struct {
 	// The (unexposed) keys used to access the stores from the Context.
-	storeService          corestoretypes.KVStoreService
-	cdc                   codec.Codec
-	accountKeeper         types.AccountKeeper
-	bank                  CoinTransferrer
+	storeService  corestoretypes.KVStoreService
+	cdc           codec.Codec
+	accountKeeper types.AccountKeeper
+	bank          CoinTransferrer
+	// bankKeeper holds the escrow of scheduled contract callbacks in the module account
+	bankKeeper            types.BankKeeper
 	wasmVM                types.WasmEngine
 	wasmVMQueryHandler    WasmVMQueryHandler
 	wasmVMResponseHandler WasmVMResponseHandler
 	messenger             Messenger
 	// queryGasLimit is the max wasmvm gas that can be spent on executing a query with a contract
-	queryGasLimit        uint64
+	queryGasLimit uint64
+	// queryCache caches the results of the smart gRPC queries. Nil when disabled.
+	queryCache           *smartQueryCache
 	gasRegister          types.GasRegister
 	maxQueryStackSize    uint32
 	maxCallDepth         uint32
@@ -116,6 +122,12 @@ type Keeper struct {
 
 	// wasmLimits contains the limits sent to wasmvm on init
 	wasmLimits wasmvmtypes.WasmLimits
+
+	// tracer for the OpenTelemetry spans of contract calls. Nil when tracing is not enabled.
+	tracer trace.Tracer
+
+	// legacyEventsDisabled skips the untyped events of the module actions. The typed events are always emitted.
+	legacyEventsDisabled bool
 }
 
 func (k Keeper) getUploadAccessConfig(ctx context.Context) types.AccessConfig {
@@ -154,11 +166,90 @@ func (k Keeper) GetGasRegister() types.GasRegister {
 	return k.gasRegister
 }
 
-func (k Keeper) create(ctx context.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, authZ types.AuthorizationPolicy) (codeID uint64, checksum []byte, err error) {
-	if creator == nil {
-		return 0, checksum, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot be nil")
+// GetEffectiveGasRegister returns the gas register built from the gas register params.
+// The x/wasm module's gas register is returned when no gas register params are set.
+func (k Keeper) GetEffectiveGasRegister(ctx context.Context) types.GasRegister {
+	// reading the params is not charged to the caller
+	params := k.GetParams(sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	if params.GasRegister == nil {
+		return k.gasRegister
+	}
+	return types.NewWasmGasRegister(params.GasRegister.Config())
+}
+
+// getGasRegister returns the gas register that was stored in the context by the GasRegisterDecorator for the
+// current tx. Outside a tx, the gas register is built from the params.
+func (k Keeper) getGasRegister(ctx context.Context) types.GasRegister {
+	if gr, ok := types.GasRegisterFromContext(ctx); ok {
+		return gr
 	}
+	return k.GetEffectiveGasRegister(ctx)
+}
+
+// withGasRegister resolves the gas register once per contract entrypoint and stores it in the returned context,
+// so that the gas meter, the query handler and the runtime gas conversions do not read the params again.
+func (k Keeper) withGasRegister(ctx sdk.Context) (sdk.Context, types.GasRegister) {
+	if gr, ok := types.GasRegisterFromContext(ctx); ok {
+		return ctx, gr
+	}
+	gr := k.GetEffectiveGasRegister(ctx)
+	return types.WithGasRegister(ctx, gr), gr
+}
+
+func (k Keeper) create(
+	ctx context.Context,
+	creator sdk.AccAddress,
+	wasmCode []byte,
+	instantiateAccess *types.AccessConfig,
+	authZ types.AuthorizationPolicy,
+) (codeID uint64, checksum []byte, err error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	wasmCode, instantiateAccess, err = k.prepareCodeUpload(sdkCtx, creator, wasmCode, instantiateAccess, authZ)
+	if err != nil {
+		return 0, checksum, err
+	}
+	return k.storeCode(sdkCtx, creator, wasmCode, *instantiateAccess)
+}
+
+// prepareCodeUpload authorizes the upload and uncompresses the wasm code. See authorizeCodeUpload for the
+// instantiate access defaults.
+func (k Keeper) prepareCodeUpload(
+	sdkCtx sdk.Context,
+	creator sdk.AccAddress,
+	wasmCode []byte,
+	instantiateAccess *types.AccessConfig,
+	authZ types.AuthorizationPolicy,
+) ([]byte, *types.AccessConfig, error) {
+	instantiateAccess, err := k.authorizeCodeUpload(sdkCtx, creator, instantiateAccess, authZ)
+	if err != nil {
+		return nil, nil, err
+	}
+	params := k.GetParams(sdkCtx)
+	if err := params.ValidateProposalWasmCode(wasmCode); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "code bytes")
+	}
+
+	if ioutils.IsGzip(wasmCode) {
+		sdkCtx.GasMeter().ConsumeGas(k.getGasRegister(sdkCtx).UncompressCosts(len(wasmCode)), "Uncompress gzip bytecode")
+		wasmCode, err = ioutils.Uncompress(wasmCode, int64(params.WasmSizeLimit()))
+		if err != nil {
+			return nil, nil, types.ErrCreateFailed.Wrap(errorsmod.Wrap(err, "uncompress wasm archive").Error())
+		}
+	}
+	return wasmCode, instantiateAccess, nil
+}
+
+// authorizeCodeUpload checks that the creator is allowed to upload code with the given instantiate access. The
+// instantiate access defaults to the chain's instantiate access config for the creator when nil.
+func (k Keeper) authorizeCodeUpload(
+	sdkCtx sdk.Context,
+	creator sdk.AccAddress,
+	instantiateAccess *types.AccessConfig,
+	authZ types.AuthorizationPolicy,
+) (*types.AccessConfig, error) {
+	if creator == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot be nil")
+	}
 	// figure out proper instantiate access
 	defaultAccessConfig := k.getInstantiateAccessConfig(sdkCtx).With(creator)
 	if instantiateAccess == nil {
@@ -170,17 +261,21 @@ func (k Keeper) create(ctx context.Context, creator sdk.AccAddress, wasmCode []b
 	}
 
 	if !authZ.CanCreateCode(chainConfigs, creator, *instantiateAccess) {
-		return 0, checksum, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not create code")
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not create code")
 	}
-
-	if ioutils.IsGzip(wasmCode) {
-		sdkCtx.GasMeter().ConsumeGas(k.gasRegister.UncompressCosts(len(wasmCode)), "Uncompress gzip bytecode")
-		wasmCode, err = ioutils.Uncompress(wasmCode, int64(types.MaxWasmSize))
-		if err != nil {
-			return 0, checksum, types.ErrCreateFailed.Wrap(errorsmod.Wrap(err, "uncompress wasm archive").Error())
-		}
+	if err := k.GetParams(sdkCtx).ValidateAddressCount(len(instantiateAccess.Addresses)); err != nil {
+		return nil, errorsmod.Wrap(err, "instantiate access")
 	}
+	return instantiateAccess, nil
+}
 
+// storeCode stores the uncompressed wasm code in the vm and registers a new code id for it.
+func (k Keeper) storeCode(
+	sdkCtx sdk.Context,
+	creator sdk.AccAddress,
+	wasmCode []byte,
+	instantiateAccess types.AccessConfig,
+) (codeID uint64, checksum []byte, err error) {
 	gasLeft := k.runtimeGasForContract(sdkCtx)
 	var gasUsed uint64
 	isSimulation := sdkCtx.ExecMode() == sdk.ExecModeSimulate
@@ -205,18 +300,28 @@ func (k Keeper) create(ctx context.Context, creator sdk.AccAddress, wasmCode []b
 	}
 	codeID = k.mustAutoIncrementID(sdkCtx, types.KeySequenceCodeID)
 	k.Logger(sdkCtx).Debug("storing new contract", "capabilities", requiredCapabilities, "code_id", codeID)
-	codeInfo := types.NewCodeInfo(checksum, creator, *instantiateAccess)
+	codeInfo := types.NewCodeInfo(checksum, creator, instantiateAccess)
 	k.mustStoreCodeInfo(sdkCtx, codeID, codeInfo)
+	if err := k.addToCodeChecksumIndex(sdkCtx, checksum, codeID); err != nil {
+		return 0, checksum, err
+	}
+	if err := k.addToCodeCreatorIndex(sdkCtx, creator, codeID); err != nil {
+		return 0, checksum, err
+	}
 
 	evt := sdk.NewEvent(
 		types.EventTypeStoreCode,
 		sdk.NewAttribute(types.AttributeKeyChecksum, hex.EncodeToString(checksum)),
 		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)), // last element to be compatible with scripts
 	)
+	typedEvt := &types.EventStoreCode{CodeID: codeID, Checksum: checksum, Creator: creator.String()}
 	for _, f := range strings.Split(requiredCapabilities, ",") {
 		evt.AppendAttributes(sdk.NewAttribute(types.AttributeKeyRequiredCapability, strings.TrimSpace(f)))
+		if f = strings.TrimSpace(f); f != "" {
+			typedEvt.RequiredCapabilities = append(typedEvt.RequiredCapabilities, f)
+		}
 	}
-	sdkCtx.EventManager().EmitEvent(evt)
+	k.emitActionEvent(sdkCtx, evt, typedEvt)
 
 	return codeID, checksum, nil
 }
@@ -256,7 +361,74 @@ func (k Keeper) importCode(ctx context.Context, codeID uint64, codeInfo types.Co
 		return errorsmod.Wrapf(types.ErrDuplicate, "duplicate code: %d", codeID)
 	}
 	// 0x01 | codeID (uint64) -> ContractInfo
-	return store.Set(key, k.cdc.MustMarshal(&codeInfo))
+	if err := store.Set(key, k.cdc.MustMarshal(&codeInfo)); err != nil {
+		return err
+	}
+	if err := k.addToCodeChecksumIndex(ctx, codeInfo.CodeHash, codeID); err != nil {
+		return err
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(codeInfo.Creator)
+	if err != nil {
+		return errorsmod.Wrap(err, "creator")
+	}
+	return k.addToCodeCreatorIndex(ctx, creatorAddr, codeID)
+}
+
+// reuseOrCreate returns the id of an existing code with the same checksum and instantiate access config. A new code
+// is stored when none exists.
+func (k Keeper) reuseOrCreate(
+	ctx context.Context,
+	creator sdk.AccAddress,
+	wasmCode []byte,
+	instantiateAccess *types.AccessConfig,
+	authZ types.AuthorizationPolicy,
+) (codeID uint64, checksum []byte, err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	wasmCode, instantiateAccess, err = k.prepareCodeUpload(sdkCtx, creator, wasmCode, instantiateAccess, authZ)
+	if err != nil {
+		return 0, checksum, err
+	}
+	checksum, err = wasmvm.CreateChecksum(wasmCode)
+	if err != nil {
+		return 0, checksum, errorsmod.Wrap(types.ErrCreateFailed, err.Error())
+	}
+	k.IterateCodesByChecksum(sdkCtx, checksum, func(id uint64) bool {
+		codeInfo := k.GetCodeInfo(sdkCtx, id)
+		if codeInfo != nil && codeInfo.InstantiateConfig.Equals(*instantiateAccess) {
+			codeID = id
+			return true
+		}
+		return false
+	})
+	if codeID != 0 {
+		return codeID, checksum, nil
+	}
+	return k.storeCode(sdkCtx, creator, wasmCode, *instantiateAccess)
+}
+
+// codeIDByChecksum resolves the checksum to the first code id that the creator is allowed to instantiate. When no
+// such code exists, the first code id with the checksum is returned so that the instantiation fails on permissions.
+func (k Keeper) codeIDByChecksum(ctx context.Context, checksum []byte, creator sdk.AccAddress, authZ types.AuthorizationPolicy) (uint64, error) {
+	var firstCodeID, codeID uint64
+	k.IterateCodesByChecksum(ctx, checksum, func(id uint64) bool {
+		if firstCodeID == 0 {
+			firstCodeID = id
+		}
+		codeInfo := k.GetCodeInfo(ctx, id)
+		if codeInfo != nil && authZ.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
+			codeID = id
+			return true
+		}
+		return false
+	})
+	switch {
+	case codeID != 0:
+		return codeID, nil
+	case firstCodeID != 0:
+		return firstCodeID, nil
+	default:
+		return 0, errorsmod.Wrapf(types.ErrNotFound, "code with checksum %X", checksum)
+	}
 }
 
 func (k Keeper) instantiate(
@@ -268,7 +440,7 @@ func (k Keeper) instantiate(
 	deposit sdk.Coins,
 	addressGenerator AddressGenerator,
 	authPolicy types.AuthorizationPolicy,
-) (sdk.AccAddress, []byte, error) {
+) (_ sdk.AccAddress, _ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "instantiate")
 
 	if creator == nil {
@@ -280,9 +452,17 @@ func (k Keeper) instantiate(
 	if codeInfo == nil {
 		return nil, nil, types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
 	}
+	params := k.GetParams(sdkCtx)
+	if err := params.ValidateLabelSize(label); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "label")
+	}
+	if err := k.assertLabelAvailable(sdkCtx, params, label); err != nil {
+		return nil, nil, err
+	}
 
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(sdkCtx, codeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, len(initMsg))
+	sdkCtx, gasRegister := k.withGasRegister(sdkCtx)
+	setupCost := gasRegister.SetupContractCost(discount, len(initMsg))
 
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: instantiate")
 
@@ -296,6 +476,11 @@ func (k Keeper) instantiate(
 		// is used for both cases.
 		return nil, nil, types.ErrDuplicate.Wrap("contract address already exists, try a different combination of creator, checksum and salt")
 	}
+	sdkCtx, trace := startCallTrace(sdkCtx, "instantiate", contractAddress)
+	defer func() { err = trace.finish(err) }()
+	sdkCtx, span := k.startSpan(sdkCtx, "instantiate", contractAddress)
+	span.setCodeID(codeID)
+	defer func() { span.end(err) }()
 
 	// check account
 	// every cosmos module can define custom account types when needed. The cosmos-sdk comes with extension points
@@ -341,17 +526,18 @@ func (k Keeper) instantiate(
 	info := types.NewInfo(creator, deposit)
 
 	// create prefixed data store
-	// 0x03 | BuildContractAddressClassic (sdk.AccAddress)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	vmStore := types.NewStoreAdapter(prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(sdkCtx)), prefixStoreKey))
+	vmStore := k.contractStore(sdkCtx, contractAddress, 0)
 
 	// prepare querier
-	querier := k.newQueryHandler(sdkCtx, contractAddress)
+	querier := k.newQueryHandler(sdkCtx, contractAddress, codeID)
 
 	// instantiate wasm contract
 	gasLeft := k.runtimeGasForContract(sdkCtx)
 	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, vmStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(sdkCtx, gasUsed)
+	if err := vmStore.Err(); err != nil {
+		return nil, nil, err
+	}
 	if err != nil {
 		return nil, nil, errorsmod.Wrap(types.ErrVMError, err.Error())
 	}
@@ -390,6 +576,14 @@ func (k Keeper) instantiate(
 	if err != nil {
 		return nil, nil, err
 	}
+	err = k.addToContractAdminSecondaryIndex(sdkCtx, admin, createdAt, contractAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = k.addToContractLabelIndex(sdkCtx, label, contractAddress)
+	if err != nil {
+		return nil, nil, err
+	}
 	err = k.appendToContractHistory(sdkCtx, contractAddress, historyEntry)
 	if err != nil {
 		return nil, nil, err
@@ -397,11 +591,17 @@ func (k Keeper) instantiate(
 
 	k.mustStoreContractInfo(sdkCtx, contractAddress, &contractInfo)
 
-	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
 		types.EventTypeInstantiate,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
-	))
+	), &types.EventInstantiate{
+		Contract: contractAddress.String(),
+		CodeID:   codeID,
+		Creator:  creator.String(),
+		Admin:    contractInfo.Admin,
+		Label:    contractInfo.Label,
+	})
 
 	sdkCtx = types.WithSubMsgAuthzPolicy(sdkCtx, authPolicy.SubMessageAuthorizationPolicy(types.AuthZActionInstantiate))
 	data, err := k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
@@ -413,16 +613,24 @@ func (k Keeper) instantiate(
 }
 
 // Execute executes the contract instance
-func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (_ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "execute")
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx, trace := startCallTrace(sdk.UnwrapSDKContext(ctx), "execute", contractAddress)
+	defer func() { err = trace.finish(err) }()
+	sdkCtx, span := k.startSpan(sdkCtx, "execute", contractAddress)
+	defer func() { span.end(err) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	span.setCodeID(contractInfo.CodeID)
+	if err := assertNotFrozen(contractAddress, contractInfo); err != nil {
+		return nil, err
+	}
 
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, len(msg))
+	sdkCtx, gasRegister := k.withGasRegister(sdkCtx)
+	setupCost := gasRegister.SetupContractCost(discount, len(msg))
 
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: execute")
 
@@ -437,10 +645,13 @@ func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddr
 	info := types.NewInfo(caller, coins)
 
 	// prepare querier
-	querier := k.newQueryHandler(sdkCtx, contractAddress)
+	querier := k.newQueryHandler(sdkCtx, contractAddress, contractInfo.CodeID)
 	gasLeft := k.runtimeGasForContract(sdkCtx)
 	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, prefixStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(sdkCtx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return nil, err
+	}
 	if execErr != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, execErr.Error())
 	}
@@ -452,10 +663,10 @@ func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddr
 		return nil, types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrExecuteFailed, res.Err))
 	}
 
-	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
 		types.EventTypeExecute,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
-	))
+	), &types.EventExecute{Contract: contractAddress.String(), Sender: caller.String()})
 
 	data, err := k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
 	if err != nil {
@@ -465,6 +676,8 @@ func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddr
 	return data, nil
 }
 
+// migrate migrates the contract to the new code immediately. A contract with a migration delay can only be
+// migrated by a caller that can bypass the delay. Otherwise the migration must be queued, see migrateOrQueue.
 func (k Keeper) migrate(
 	ctx context.Context,
 	contractAddress sdk.AccAddress,
@@ -473,26 +686,64 @@ func (k Keeper) migrate(
 	msg []byte,
 	authZ types.AuthorizationPolicy,
 ) ([]byte, error) {
-	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "migrate")
-
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo, newCodeInfo, err := k.checkMigrationPermissions(ctx, contractAddress, caller, newCodeID, authZ)
+	if err != nil {
+		return nil, err
+	}
+	if contractInfo.MigrationDelay != 0 && !authZ.CanBypassMigrationDelay(caller) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not bypass migration delay")
+	}
+	return k.migrateContract(ctx, contractAddress, contractInfo, caller, newCodeID, newCodeInfo, msg, authZ)
+}
 
+// checkMigrationPermissions returns the contract and the new code info when the caller is allowed to migrate the
+// contract to the new code.
+func (k Keeper) checkMigrationPermissions(
+	ctx context.Context,
+	contractAddress sdk.AccAddress,
+	caller sdk.AccAddress,
+	newCodeID uint64,
+	authZ types.AuthorizationPolicy,
+) (*types.ContractInfo, *types.CodeInfo, error) {
 	contractInfo := k.GetContractInfo(ctx, contractAddress)
 	if contractInfo == nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
 	}
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not migrate")
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not migrate")
+	}
+	if contractInfo.Frozen && !authZ.CanMigrateFrozenContract(caller) {
+		return nil, nil, errorsmod.Wrapf(types.ErrContractFrozen, "address %s", contractAddress.String())
 	}
 
 	newCodeInfo := k.GetCodeInfo(ctx, newCodeID)
 	if newCodeInfo == nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown code")
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown code")
 	}
 
 	if !authZ.CanInstantiateContract(newCodeInfo.InstantiateConfig, caller) {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "to use new code")
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "to use new code")
 	}
+	return contractInfo, newCodeInfo, nil
+}
+
+func (k Keeper) migrateContract(
+	ctx context.Context,
+	contractAddress sdk.AccAddress,
+	contractInfo *types.ContractInfo,
+	caller sdk.AccAddress,
+	newCodeID uint64,
+	newCodeInfo *types.CodeInfo,
+	msg []byte,
+	authZ types.AuthorizationPolicy,
+) (_ []byte, err error) {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "migrate")
+
+	sdkCtx, trace := startCallTrace(sdk.UnwrapSDKContext(ctx), "migrate", contractAddress)
+	defer func() { err = trace.finish(err) }()
+	sdkCtx, span := k.startSpan(sdkCtx, "migrate", contractAddress)
+	span.setCodeID(newCodeID)
+	defer func() { span.end(err) }()
 
 	// check for IBC flag
 	report, err := k.wasmVM.AnalyzeCode(newCodeInfo.CodeHash)
@@ -524,7 +775,16 @@ func (k Keeper) migrate(
 	if report.ContractMigrateVersion == nil ||
 		oldReport.ContractMigrateVersion == nil ||
 		*report.ContractMigrateVersion != *oldReport.ContractMigrateVersion {
-		response, err = k.callMigrateEntrypoint(sdkCtx, contractAddress, wasmvmtypes.Checksum(newCodeInfo.CodeHash), msg, newCodeID, caller, oldReport.ContractMigrateVersion)
+		response, err = k.callMigrateEntrypoint(
+			sdkCtx,
+			contractAddress,
+			wasmvmtypes.Checksum(newCodeInfo.CodeHash),
+			msg,
+			newCodeID,
+			caller,
+			oldReport.ContractMigrateVersion,
+			contractInfo.MaxStateSize,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -549,11 +809,11 @@ func (k Keeper) migrate(
 
 	contractInfo.IBC2PortID = PortIDForContractV2(contractAddress)
 
-	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
 		types.EventTypeMigrate,
 		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(newCodeID, 10)),
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
-	))
+	), &types.EventMigrate{Contract: contractAddress.String(), CodeID: newCodeID, Sender: caller.String()})
 
 	var data []byte
 
@@ -578,6 +838,62 @@ func (k Keeper) migrate(
 	return data, nil
 }
 
+// migrateContractsByCode migrates up to limit contracts of the given code to the new code version. The contracts
+// are processed in the order of the code secondary index, skipping all contracts up to and including startAfter.
+// startAfter may be a contract that was migrated in a previous batch already. A failing contract migration is
+// reverted and reported in the results without aborting the batch.
+func (k Keeper) migrateContractsByCode(
+	ctx context.Context,
+	fromCodeID, toCodeID uint64,
+	msg []byte,
+	limit uint64,
+	startAfter, caller sdk.AccAddress,
+	authZ types.AuthorizationPolicy,
+) ([]types.MigrateContractResult, error) {
+	if !k.containsCodeInfo(ctx, toCodeID) {
+		return nil, types.ErrNoSuchCodeFn(toCodeID).Wrapf("code id %d", toCodeID)
+	}
+	if limit == 0 || limit > types.MaxContractsMigrateBatch {
+		limit = types.MaxContractsMigrateBatch
+	}
+
+	// collect the addresses first as the migrations modify the secondary index
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractByCodeIDSecondaryIndexPrefix(fromCodeID))
+	var startKey []byte
+	if len(startAfter) != 0 {
+		// a contract migrated in a previous batch has left the index already, so the position is restored
+		// from the history entry it was indexed with
+		entry, found := k.lastContractHistoryEntryForCode(ctx, startAfter, fromCodeID)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrNotFound, "start after contract %s for code id %d", startAfter.String(), fromCodeID)
+		}
+		startKey = append(entry.Updated.Bytes(), startAfter...)
+	}
+	var contracts []sdk.AccAddress
+	iter := prefixStore.Iterator(startKey, nil)
+	for ; iter.Valid() && uint64(len(contracts)) < limit; iter.Next() {
+		if bytes.Equal(iter.Key(), startKey) {
+			continue
+		}
+		contracts = append(contracts, iter.Key()[types.AbsoluteTxPositionLen:])
+	}
+	iter.Close()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	results := make([]types.MigrateContractResult, len(contracts))
+	for i, contractAddress := range contracts {
+		cacheCtx, commit := sdkCtx.CacheContext()
+		data, err := k.migrate(cacheCtx, contractAddress, caller, toCodeID, msg, authZ)
+		results[i] = types.MigrateContractResult{Contract: contractAddress.String(), Success: err == nil, Data: data}
+		if err != nil {
+			results[i].Error = k.redactError(sdkCtx, err).Error()
+			continue
+		}
+		commit()
+	}
+	return results, nil
+}
+
 func (k Keeper) callMigrateEntrypoint(
 	sdkCtx sdk.Context,
 	contractAddress sdk.AccAddress,
@@ -586,18 +902,19 @@ func (k Keeper) callMigrateEntrypoint(
 	newCodeID uint64,
 	senderAddress sdk.AccAddress,
 	oldMigrateVersion *uint64,
+	maxStateSize uint64,
 ) (*wasmvmtypes.Response, error) {
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, newChecksum, k.IsPinnedCode(sdkCtx, newCodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, len(msg))
+	sdkCtx, gasRegister := k.withGasRegister(sdkCtx)
+	setupCost := gasRegister.SetupContractCost(discount, len(msg))
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: migrate")
 
 	env := types.NewEnv(sdkCtx, k.txHash, contractAddress)
 
 	// prepare querier
-	querier := k.newQueryHandler(sdkCtx, contractAddress)
+	querier := k.newQueryHandler(sdkCtx, contractAddress, newCodeID)
 
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	vmStore := types.NewStoreAdapter(prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(sdkCtx)), prefixStoreKey))
+	vmStore := k.contractStore(sdkCtx, contractAddress, maxStateSize)
 	gasLeft := k.runtimeGasForContract(sdkCtx)
 
 	migrateInfo := wasmvmtypes.MigrateInfo{
@@ -607,6 +924,9 @@ func (k Keeper) callMigrateEntrypoint(
 	res, gasUsed, err := k.wasmVM.MigrateWithInfo(newChecksum, env, msg, migrateInfo, vmStore, cosmwasmAPI, &querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
 
 	k.consumeRuntimeGas(sdkCtx, gasUsed)
+	if err := vmStore.Err(); err != nil {
+		return nil, err
+	}
 	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, err.Error())
 	}
@@ -628,26 +948,37 @@ func (k Keeper) callMigrateEntrypoint(
 // customized though by passing a new policy with the context. See types.WithSubMsgAuthzPolicy.
 // The policy will be read in msgServer.selectAuthorizationPolicy and used for sub-message executions.
 // This is an extension point for some very advanced scenarios only. Use with care!
-func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) (_ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "sudo")
+	sdkCtx, trace := startCallTrace(sdk.UnwrapSDKContext(ctx), "sudo", contractAddress)
+	defer func() { err = trace.finish(err) }()
+	sdkCtx, span := k.startSpan(sdkCtx, "sudo", contractAddress)
+	defer func() { span.end(err) }()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	span.setCodeID(contractInfo.CodeID)
+	if err := assertNotFrozen(contractAddress, contractInfo); err != nil {
+		return nil, err
+	}
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, len(msg))
+	sdkCtx, gasRegister := k.withGasRegister(sdkCtx)
+	setupCost := gasRegister.SetupContractCost(discount, len(msg))
 
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: sudo")
 
 	env := types.NewEnv(sdkCtx, k.txHash, contractAddress)
 
 	// prepare querier
-	querier := k.newQueryHandler(sdkCtx, contractAddress)
+	querier := k.newQueryHandler(sdkCtx, contractAddress, contractInfo.CodeID)
 	gasLeft := k.runtimeGasForContract(sdkCtx)
 	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(sdkCtx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return nil, err
+	}
 	if execErr != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, execErr.Error())
 	}
@@ -659,10 +990,10 @@ func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []
 		return nil, types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrExecuteFailed, res.Err))
 	}
 
-	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
 		types.EventTypeSudo,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
-	))
+	), &types.EventSudo{Contract: contractAddress.String()})
 
 	// sudo submessages are executed with the default authorization policy
 	data, err := k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
@@ -673,24 +1004,37 @@ func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []
 	return data, nil
 }
 
+// redactError returns the deterministic error that a contract receives for a failed submessage or query in the
+// format that is selected by the RedactedErrorJSON param
+func (k Keeper) redactError(ctx sdk.Context, err error) error {
+	unmeteredCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	return redactError(err, k.GetParams(unmeteredCtx).RedactedErrorJSON)
+}
+
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
-func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) (_ []byte, err error) {
+	ctx, trace := startCallTrace(ctx, "reply", contractAddress)
+	defer func() { err = trace.finish(err) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	replyCosts := k.gasRegister.ReplyCosts(true, reply)
+	ctx, gasRegister := k.withGasRegister(ctx)
+	replyCosts := gasRegister.ReplyCosts(true, reply)
 	ctx.GasMeter().ConsumeGas(replyCosts, "Loading CosmWasm module: reply")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddress)
 
 	// prepare querier
-	querier := k.newQueryHandler(ctx, contractAddress)
+	querier := k.newQueryHandler(ctx, contractAddress, contractInfo.CodeID)
 	gasLeft := k.runtimeGasForContract(ctx)
 
 	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return nil, err
+	}
 	if execErr != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, execErr.Error())
 	}
@@ -702,10 +1046,10 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 		return nil, types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrExecuteFailed, res.Err))
 	}
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
+	k.emitActionEvent(ctx, sdk.NewEvent(
 		types.EventTypeReply,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
-	))
+	), &types.EventReply{Contract: contractAddress.String()})
 
 	data, err := k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
 	if err != nil {
@@ -715,6 +1059,14 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 	return data, nil
 }
 
+// assertNotFrozen returns an error when the contract was frozen by the authority
+func assertNotFrozen(contractAddress sdk.AccAddress, contractInfo types.ContractInfo) error {
+	if contractInfo.Frozen {
+		return errorsmod.Wrapf(types.ErrContractFrozen, "address %s", contractAddress.String())
+	}
+	return nil
+}
+
 // addToContractCodeSecondaryIndex adds element to the index for contracts-by-codeid queries
 func (k Keeper) addToContractCodeSecondaryIndex(ctx context.Context, contractAddress sdk.AccAddress, entry types.ContractCodeHistoryEntry) error {
 	store := k.storeService.OpenKVStore(ctx)
@@ -743,6 +1095,92 @@ func (k Keeper) IterateContractsByCreator(ctx context.Context, creator sdk.AccAd
 	}
 }
 
+// addToContractAdminSecondaryIndex adds element to the index for contracts-by-admin queries. Contracts without an
+// admin are not indexed.
+func (k Keeper) addToContractAdminSecondaryIndex(ctx context.Context, adminAddress sdk.AccAddress, position *types.AbsoluteTxPosition, contractAddress sdk.AccAddress) error {
+	if len(adminAddress) == 0 {
+		return nil
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetContractByAdminSecondaryIndexKey(adminAddress, position.Bytes(), contractAddress), []byte{})
+}
+
+// removeFromContractAdminSecondaryIndex removes element from the index for contracts-by-admin queries
+func (k Keeper) removeFromContractAdminSecondaryIndex(ctx context.Context, adminAddress sdk.AccAddress, position *types.AbsoluteTxPosition, contractAddress sdk.AccAddress) error {
+	if len(adminAddress) == 0 {
+		return nil
+	}
+	return k.storeService.OpenKVStore(ctx).Delete(types.GetContractByAdminSecondaryIndexKey(adminAddress, position.Bytes(), contractAddress))
+}
+
+// updateContractAdmin sets the new admin of the contract and moves the contract in the admin index. The contract info
+// is not persisted.
+func (k Keeper) updateContractAdmin(ctx context.Context, contractAddress sdk.AccAddress, contractInfo *types.ContractInfo, newAdmin sdk.AccAddress) error {
+	if err := k.removeFromContractAdminSecondaryIndex(ctx, contractInfo.AdminAddr(), contractInfo.Created, contractAddress); err != nil {
+		return err
+	}
+	if err := k.addToContractAdminSecondaryIndex(ctx, newAdmin, contractInfo.Created, contractAddress); err != nil {
+		return err
+	}
+	contractInfo.Admin = newAdmin.String()
+	return nil
+}
+
+// IterateContractsByAdmin iterates over all contracts with given admin address in order of creation time asc.
+func (k Keeper) IterateContractsByAdmin(ctx context.Context, admin sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractsByAdminPrefix(admin))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if cb(key[types.AbsoluteTxPositionLen:]) {
+			return
+		}
+	}
+}
+
+// addToContractLabelIndex adds element to the index for contracts-by-label queries. The contract address is stored
+// as value.
+func (k Keeper) addToContractLabelIndex(ctx context.Context, label string, contractAddress sdk.AccAddress) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.GetContractByLabelIndexKey(label, contractAddress), contractAddress)
+}
+
+// removeFromContractLabelIndex removes element from the index for contracts-by-label queries
+func (k Keeper) removeFromContractLabelIndex(ctx context.Context, label string, contractAddress sdk.AccAddress) error {
+	return k.storeService.OpenKVStore(ctx).Delete(types.GetContractByLabelIndexKey(label, contractAddress))
+}
+
+// IterateContractsByLabel iterates over all contracts with exactly the given label in order of the address asc.
+func (k Keeper) IterateContractsByLabel(ctx context.Context, label string, cb func(address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractsByExactLabelPrefix(label))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Value()) {
+			return
+		}
+	}
+}
+
+// assertLabelAvailable returns an ErrDuplicateLabel error when unique contract labels are enforced by the params and
+// the label is used by a contract already
+func (k Keeper) assertLabelAvailable(ctx context.Context, params types.Params, label string) error {
+	if !params.UniqueContractLabels {
+		return nil
+	}
+	var used bool
+	k.IterateContractsByLabel(ctx, label, func(sdk.AccAddress) bool {
+		used = true
+		return true
+	})
+	if used {
+		return errorsmod.Wrapf(types.ErrDuplicateLabel, "label %q", label)
+	}
+	return nil
+}
+
 // IterateContractsByCode iterates over all contracts with given codeID ASC on code update time.
 func (k Keeper) IterateContractsByCode(ctx context.Context, codeID uint64, cb func(address sdk.AccAddress) bool) {
 	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractByCodeIDSecondaryIndexPrefix(codeID))
@@ -766,14 +1204,20 @@ func (k Keeper) setContractAdmin(ctx context.Context, contractAddress, caller, n
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
-	newAdminStr := newAdmin.String()
-	contractInfo.Admin = newAdminStr
+	// a direct admin update supersedes any pending two-step transfer
+	if err := k.deletePendingAdminTransfer(sdkCtx, contractAddress); err != nil {
+		return err
+	}
+	if err := k.updateContractAdmin(sdkCtx, contractAddress, contractInfo, newAdmin); err != nil {
+		return err
+	}
+	newAdminStr := contractInfo.Admin
 	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
-	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
 		types.EventTypeUpdateContractAdmin,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 		sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdminStr),
-	))
+	), &types.EventUpdateAdmin{Contract: contractAddress.String(), NewAdmin: newAdminStr})
 
 	return nil
 }
@@ -787,14 +1231,51 @@ func (k Keeper) setContractLabel(ctx context.Context, contractAddress, caller sd
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
+	params := k.GetParams(sdkCtx)
+	if err := params.ValidateLabelSize(newLabel); err != nil {
+		return errorsmod.Wrap(err, "label")
+	}
+	if newLabel != contractInfo.Label {
+		if err := k.assertLabelAvailable(sdkCtx, params, newLabel); err != nil {
+			return err
+		}
+	}
+	if err := k.removeFromContractLabelIndex(sdkCtx, contractInfo.Label, contractAddress); err != nil {
+		return err
+	}
+	if err := k.addToContractLabelIndex(sdkCtx, newLabel, contractAddress); err != nil {
+		return err
+	}
 	contractInfo.Label = newLabel
 	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
-	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
 		types.EventTypeUpdateContractLabel,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 		sdk.NewAttribute(types.AttributeKeyNewLabel, newLabel),
-	))
+	), &types.EventUpdateContractLabel{Contract: contractAddress.String(), NewLabel: newLabel})
+
+	return nil
+}
 
+// setContractFrozen sets or clears the frozen flag of a contract. Frozen contracts reject any execution.
+func (k Keeper) setContractFrozen(ctx context.Context, contractAddress sdk.AccAddress, frozen bool) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	contractInfo.Frozen = frozen
+	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
+	eventType := types.EventTypeUnfreezeContract
+	var typedEvt proto.Message = &types.EventUnfreezeContract{Contract: contractAddress.String()}
+	if frozen {
+		eventType = types.EventTypeFreezeContract
+		typedEvt = &types.EventFreezeContract{Contract: contractAddress.String()}
+	}
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+	), typedEvt)
 	return nil
 }
 
@@ -858,12 +1339,36 @@ func (k Keeper) mustGetLastContractHistoryEntry(ctx context.Context, contractAdd
 	panic(fmt.Sprintf("no history for %s", contractAddr.String()))
 }
 
+// lastContractHistoryEntryForCode returns the most recent history element of the contract for the given code id.
+// This is the entry that the contract is or was indexed with in the contracts-by-code index.
+func (k Keeper) lastContractHistoryEntryForCode(ctx context.Context, contractAddr sdk.AccAddress, codeID uint64) (types.ContractCodeHistoryEntry, bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractCodeHistoryElementPrefix(contractAddr))
+	iter := prefixStore.ReverseIterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if len(iter.Key()) != 8 { // add extra safety in a mixed contract length environment
+			continue
+		}
+		var e types.ContractCodeHistoryEntry
+		k.cdc.MustUnmarshal(iter.Value(), &e)
+		if e.CodeID == codeID && e.Updated != nil {
+			return e, true
+		}
+	}
+	return types.ContractCodeHistoryEntry{}, false
+}
+
 // QuerySmart queries the smart contract itself.
-func (k Keeper) QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+func (k Keeper) QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) (_ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "query-smart")
+	sdkCtx, trace := startCallTrace(sdk.UnwrapSDKContext(ctx), "query-smart", contractAddr)
+	defer func() { err = trace.finish(err) }()
+	sdkCtx, span := k.startSpan(sdkCtx, "query-smart", contractAddr)
+	defer func() { span.end(err) }()
 
 	// checks and increase query stack size
-	sdkCtx, err := checkAndIncreaseQueryStackSize(sdk.UnwrapSDKContext(ctx), k.maxQueryStackSize)
+	sdkCtx, err = checkAndIncreaseQueryStackSize(sdkCtx, k.maxQueryStackSize)
 	if err != nil {
 		return nil, err
 	}
@@ -872,13 +1377,15 @@ func (k Keeper) QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req
 	if err != nil {
 		return nil, err
 	}
+	span.setCodeID(contractInfo.CodeID)
 
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, len(req))
+	sdkCtx, gasRegister := k.withGasRegister(sdkCtx)
+	setupCost := gasRegister.SetupContractCost(discount, len(req))
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: query")
 
 	// prepare querier
-	querier := k.newQueryHandler(sdkCtx, contractAddr)
+	querier := k.newQueryHandler(sdkCtx, contractAddr, contractInfo.CodeID)
 
 	env := types.NewEnv(sdkCtx, k.txHash, contractAddr)
 	queryResult, gasUsed, qErr := k.wasmVM.Query(codeInfo.CodeHash, env, req, prefixStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), k.runtimeGasForContract(sdkCtx), costJSONDeserialization)
@@ -979,7 +1486,7 @@ func (k Keeper) QueryRawRange(ctx context.Context, contractAddress sdk.AccAddres
 }
 
 // internal helper function
-func (k Keeper) contractInstance(ctx context.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, wasmvm.KVStore, error) {
+func (k Keeper) contractInstance(ctx context.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, *contractStateStore, error) {
 	store := k.storeService.OpenKVStore(ctx)
 
 	contractBz, err := store.Get(types.GetContractAddressKey(contractAddress))
@@ -1004,9 +1511,7 @@ func (k Keeper) contractInstance(ctx context.Context, contractAddress sdk.AccAdd
 	}
 	var codeInfo types.CodeInfo
 	k.cdc.MustUnmarshal(codeInfoBz, &codeInfo)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey)
-	return contractInfo, codeInfo, types.NewStoreAdapter(prefixStore), nil
+	return contractInfo, codeInfo, k.contractStore(sdk.UnwrapSDKContext(ctx), contractAddress, contractInfo.MaxStateSize), nil
 }
 
 func (k Keeper) LoadAsyncAckPacket(ctx context.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, error) {
@@ -1167,6 +1672,52 @@ func (k Keeper) IterateCodeInfos(ctx context.Context, cb func(uint64, types.Code
 	}
 }
 
+// IterateCodesByChecksum iterates over the ids of all codes that reference the given checksum, in ascending order.
+func (k Keeper) IterateCodesByChecksum(ctx context.Context, checksum []byte, cb func(codeID uint64) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetCodesByChecksumPrefix(checksum))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(binary.BigEndian.Uint64(iter.Key())) {
+			return
+		}
+	}
+}
+
+// addToCodeChecksumIndex adds element to the index for codes-by-checksum queries
+func (k Keeper) addToCodeChecksumIndex(ctx context.Context, checksum []byte, codeID uint64) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.GetCodeByChecksumIndexKey(checksum, codeID), []byte{})
+}
+
+// removeFromCodeChecksumIndex removes element from the index for codes-by-checksum queries
+func (k Keeper) removeFromCodeChecksumIndex(ctx context.Context, checksum []byte, codeID uint64) error {
+	return k.storeService.OpenKVStore(ctx).Delete(types.GetCodeByChecksumIndexKey(checksum, codeID))
+}
+
+// IterateCodesByCreator iterates over the ids of all codes that were stored by the given creator, in ascending order.
+func (k Keeper) IterateCodesByCreator(ctx context.Context, creator sdk.AccAddress, cb func(codeID uint64) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetCodesByCreatorPrefix(creator))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(binary.BigEndian.Uint64(iter.Key())) {
+			return
+		}
+	}
+}
+
+// addToCodeCreatorIndex adds element to the index for codes-by-creator queries
+func (k Keeper) addToCodeCreatorIndex(ctx context.Context, creator sdk.AccAddress, codeID uint64) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.GetCodeByCreatorIndexKey(creator, codeID), []byte{})
+}
+
+// removeFromCodeCreatorIndex removes element from the index for codes-by-creator queries
+func (k Keeper) removeFromCodeCreatorIndex(ctx context.Context, creator sdk.AccAddress, codeID uint64) error {
+	return k.storeService.OpenKVStore(ctx).Delete(types.GetCodeByCreatorIndexKey(creator, codeID))
+}
+
 func (k Keeper) GetByteCode(ctx context.Context, codeID uint64) ([]byte, error) {
 	store := k.storeService.OpenKVStore(ctx)
 	var codeInfo types.CodeInfo
@@ -1198,10 +1749,10 @@ func (k Keeper) pinCode(ctx context.Context, codeID uint64) error {
 		return err
 	}
 
-	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+	k.emitActionEvent(sdk.UnwrapSDKContext(ctx), sdk.NewEvent(
 		types.EventTypePinCode,
 		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
-	))
+	), &types.EventPinCode{CodeID: codeID})
 	return nil
 }
 
@@ -1221,13 +1772,132 @@ func (k Keeper) unpinCode(ctx context.Context, codeID uint64) error {
 		return err
 	}
 
-	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+	k.emitActionEvent(sdk.UnwrapSDKContext(ctx), sdk.NewEvent(
 		types.EventTypeUnpinCode,
 		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
-	))
+	), &types.EventUnpinCode{CodeID: codeID})
 	return nil
 }
 
+// deleteCode removes the code info of a code that is not used by any contract anymore.
+// When no other code id references the same checksum, the wasm blob is scheduled for removal
+// from the wasmvm cache at the end of the block.
+func (k Keeper) deleteCode(ctx context.Context, codeID uint64, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	if !authZ.CanDeleteCode(sdk.MustAccAddressFromBech32(codeInfo.Creator), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not delete code")
+	}
+	var inUse bool
+	k.IterateContractsByCode(ctx, codeID, func(_ sdk.AccAddress) bool {
+		inUse = true
+		return true
+	})
+	if !inUse {
+		k.IteratePendingMigrations(ctx, func(migration types.PendingMigration) bool {
+			inUse = migration.CodeID == codeID
+			return inUse
+		})
+	}
+	if inUse {
+		return errorsmod.Wrapf(types.ErrCodeInUse, "code id %d", codeID)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetCodeKey(codeID)); err != nil {
+		return err
+	}
+	if err := store.Delete(types.GetPinnedCodeIndexPrefix(codeID)); err != nil {
+		return err
+	}
+	if err := k.removeFromCodeChecksumIndex(ctx, codeInfo.CodeHash, codeID); err != nil {
+		return err
+	}
+	if err := k.removeFromCodeCreatorIndex(ctx, sdk.MustAccAddressFromBech32(codeInfo.Creator), codeID); err != nil {
+		return err
+	}
+	var shared bool
+	k.IterateCodesByChecksum(ctx, codeInfo.CodeHash, func(uint64) bool {
+		shared = true
+		return true
+	})
+	if !shared {
+		// the wasmvm cache is not part of the state so that the removal is deferred until the end of the block
+		// to not lose the blob when the tx is reverted
+		if err := store.Set(types.GetCodeRemovalQueueKey(codeInfo.CodeHash), []byte{1}); err != nil {
+			return err
+		}
+	}
+
+	k.emitActionEvent(sdk.UnwrapSDKContext(ctx), sdk.NewEvent(
+		types.EventTypeDeleteCode,
+		sdk.NewAttribute(types.AttributeKeyChecksum, hex.EncodeToString(codeInfo.CodeHash)),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+	), &types.EventDeleteCode{CodeID: codeID, Checksum: codeInfo.CodeHash})
+	return nil
+}
+
+// RemoveDeletedCodes unpins and removes the wasm blobs that were scheduled for removal by deleteCode from the wasmvm
+// cache. Checksums that were stored again in the meantime are kept.
+func (k Keeper) RemoveDeletedCodes(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var checksums [][]byte
+	k.IterateCodeRemovalQueue(ctx, func(checksum []byte) bool {
+		checksums = append(checksums, checksum)
+		return false
+	})
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CodeRemovalQueuePrefix)
+	for _, checksum := range checksums {
+		store.Delete(checksum)
+		var stored bool
+		k.IterateCodesByChecksum(ctx, checksum, func(uint64) bool {
+			stored = true
+			return true
+		})
+		if stored {
+			continue
+		}
+		// the cache is local to this node, a failure must not stop the chain
+		if err := k.wasmVM.Unpin(checksum); err != nil {
+			k.Logger(sdkCtx).Error("failed to unpin deleted code", "checksum", hex.EncodeToString(checksum), "error", err)
+			continue
+		}
+		if err := k.wasmVM.RemoveCode(checksum); err != nil {
+			k.Logger(sdkCtx).Error("failed to remove deleted code from wasmvm cache", "checksum", hex.EncodeToString(checksum), "error", err)
+		}
+	}
+}
+
+// IterateCodeRemovalQueue iterates over the checksums that are scheduled for removal from the wasmvm cache
+func (k Keeper) IterateCodeRemovalQueue(ctx context.Context, cb func(checksum []byte) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CodeRemovalQueuePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(bytes.Clone(iter.Key())) {
+			return
+		}
+	}
+}
+
+// importCodeRemoval schedules the checksum for removal from the wasmvm cache. Used in genesis import only.
+func (k Keeper) importCodeRemoval(ctx context.Context, checksum []byte) error {
+	store := k.storeService.OpenKVStore(ctx)
+	key := types.GetCodeRemovalQueueKey(checksum)
+	ok, err := store.Has(key)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return errorsmod.Wrapf(types.ErrDuplicate, "code removal: %X", checksum)
+	}
+	return store.Set(key, []byte{1})
+}
+
 // IsPinnedCode returns true when codeID is pinned in wasmvm cache
 func (k Keeper) IsPinnedCode(ctx context.Context, codeID uint64) bool {
 	store := k.storeService.OpenKVStore(ctx)
@@ -1297,6 +1967,9 @@ func (k Keeper) setAccessConfig(ctx context.Context, codeID uint64, caller sdk.A
 	if !authz.CanModifyCodeAccessConfig(sdk.MustAccAddressFromBech32(info.Creator), caller, isSubset) {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify code access config")
 	}
+	if err := k.GetParams(ctx).ValidateAddressCount(len(newConfig.Addresses)); err != nil {
+		return errorsmod.Wrap(err, "instantiate access")
+	}
 
 	info.InstantiateConfig = newConfig
 	k.mustStoreCodeInfo(ctx, codeID, *info)
@@ -1309,7 +1982,7 @@ func (k Keeper) setAccessConfig(ctx context.Context, codeID uint64, caller sdk.A
 		attr := sdk.NewAttribute(types.AttributeKeyAuthorizedAddresses, strings.Join(addrs, ","))
 		evt.Attributes = append(evt.Attributes, attr.ToKVPair())
 	}
-	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(evt)
+	k.emitActionEvent(sdk.UnwrapSDKContext(ctx), evt, &types.EventUpdateInstantiateConfig{CodeID: codeID, InstantiateConfig: newConfig})
 	return nil
 }
 
@@ -1323,7 +1996,10 @@ func (k *Keeper) handleContractResponse(
 	data []byte,
 	evts wasmvmtypes.Array[wasmvmtypes.Event],
 ) ([]byte, error) {
-	attributeGasCost := k.gasRegister.EventCosts(attrs, evts)
+	if err := k.settleStorageDeposit(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	attributeGasCost := k.getGasRegister(ctx).EventCosts(attrs, evts)
 	ctx.GasMeter().ConsumeGas(attributeGasCost, "Custom contract event attributes")
 	// emit all events from this contract itself
 	if len(attrs) != 0 {
@@ -1351,12 +2027,17 @@ func (k Keeper) runtimeGasForContract(ctx sdk.Context) uint64 {
 	if meter.Limit() == math.MaxUint64 { // infinite gas meter and not out of gas
 		return math.MaxUint64
 	}
-	return k.gasRegister.ToWasmVMGas(meter.Limit() - meter.GasConsumedToLimit())
+	return k.getGasRegister(ctx).ToWasmVMGas(meter.Limit() - meter.GasConsumedToLimit())
 }
 
 func (k Keeper) consumeRuntimeGas(ctx sdk.Context, gas uint64) {
-	consumed := k.gasRegister.FromWasmVMGas(gas)
+	consumed := k.getGasRegister(ctx).FromWasmVMGas(gas)
 	ctx.GasMeter().ConsumeGas(consumed, "wasm contract")
+	if ctx.ExecMode() == sdk.ExecModeSimulate {
+		if tracer, ok := types.CallTracerFromContext(ctx); ok {
+			tracer.AddWasmGas(gas)
+		}
+	}
 	// throw OutOfGas error if we ran out (got exactly to zero due to better limit enforcing)
 	if ctx.GasMeter().IsOutOfGas() {
 		panic(storetypes.ErrorOutOfGas{Descriptor: "Wasm engine function execution"})
@@ -1437,11 +2118,23 @@ func (k Keeper) importContract(ctx context.Context, contractAddr sdk.AccAddress,
 	if err != nil {
 		return err
 	}
+	err = k.addToContractAdminSecondaryIndex(ctx, c.AdminAddr(), c.Created, contractAddr)
+	if err != nil {
+		return err
+	}
+	err = k.addToContractLabelIndex(ctx, c.Label, contractAddr)
+	if err != nil {
+		return err
+	}
 	return k.importContractState(ctx, contractAddr, state)
 }
 
-func (k Keeper) newQueryHandler(ctx sdk.Context, contractAddress sdk.AccAddress) QueryHandler {
-	return NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.gasRegister)
+// newQueryHandler returns the querier for a contract call. The code id of the called contract is stored in the
+// querier context for the instrumented wasm engine.
+func (k Keeper) newQueryHandler(ctx sdk.Context, contractAddress sdk.AccAddress, codeID uint64) QueryHandler {
+	q := NewQueryHandler(types.WithCodeID(ctx, codeID), k.wasmVMQueryHandler, contractAddress, k.getGasRegister(ctx))
+	q.redactErrorFn = k.redactError
+	return q
 }
 
 // MultipliedGasMeter wraps the GasMeter from context and multiplies all reads by out defined multiplier
@@ -1461,7 +2154,7 @@ func (m MultipliedGasMeter) GasConsumed() storetypes.Gas {
 }
 
 func (k Keeper) gasMeter(ctx sdk.Context) MultipliedGasMeter {
-	return NewMultipliedGasMeter(ctx.GasMeter(), k.gasRegister)
+	return NewMultipliedGasMeter(ctx.GasMeter(), k.getGasRegister(ctx))
 }
 
 // Logger returns a module-specific logger.
@@ -1475,7 +2168,9 @@ func moduleLogger(ctx sdk.Context) log.Logger {
 
 // Querier creates a new grpc querier instance
 func Querier(k *Keeper) *GrpcQuerier {
-	return NewGrpcQuerier(k.cdc, k.storeService, k, k.queryGasLimit)
+	q := NewGrpcQuerier(k.cdc, k.storeService, k, k.queryGasLimit)
+	q.queryCache = k.queryCache
+	return q
 }
 
 // QueryGasLimit returns the gas limit for smart queries.
@@ -46,6 +46,7 @@ func NewKeeper(
 		wasmVM:               nil,
 		accountKeeper:        accountKeeper,
 		bank:                 NewBankCoinTransferrer(bankKeeper),
+		bankKeeper:           bankKeeper,
 		accountPruner:        NewVestingCoinBurner(bankKeeper),
 		queryGasLimit:        nodeConfig.SmartQueryGasLimit,
 		gasRegister:          types.NewDefaultWasmGasRegister(),
@@ -56,9 +57,13 @@ func NewKeeper(
 		propagateGovAuthorization: map[types.AuthorizationPolicyAction]struct{}{
 			types.AuthZActionInstantiate: {},
 		},
-		authority:  authority,
-		txHash:     func(data []byte) []byte { sum := sha256.Sum256(data); return sum[:] },
-		wasmLimits: vmConfig.WasmLimits,
+		authority:            authority,
+		txHash:               func(data []byte) []byte { sum := sha256.Sum256(data); return sum[:] },
+		wasmLimits:           vmConfig.WasmLimits,
+		legacyEventsDisabled: nodeConfig.DisableLegacyEvents,
+	}
+	if nodeConfig.SmartQueryCacheSize != 0 {
+		keeper.queryCache = newSmartQueryCache(nodeConfig.SmartQueryCacheSize)
 	}
 	keeper.messenger = NewDefaultMessageHandler(keeper, router, ics4Wrapper, channelKeeperV2, bankKeeper, cdc, portSource)
 	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distrKeeper, channelKeeper, keeper)
@@ -90,6 +95,8 @@ func NewKeeper(
 		o.apply(keeper)
 	}
 	// not updatable, yet
-	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(NewMessageDispatcher(keeper.messenger, keeper))
+	dispatcher := NewMessageDispatcher(keeper.messenger, keeper)
+	dispatcher.legacyEventsDisabled = keeper.legacyEventsDisabled
+	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(dispatcher)
 	return *keeper
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	_ "embed"
 	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 	"github.com/cometbft/cometbft/libs/rand"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
 	fuzz "github.com/google/gofuzz"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -81,8 +83,17 @@ func TestCreateSuccess(t *testing.T) {
 	require.Equal(t, hackatomWasm, storedCode)
 	// and events emitted
 	codeHash := testdata.ChecksumHackatom
-	exp := sdk.Events{sdk.NewEvent("store_code", sdk.NewAttribute("code_checksum", codeHash), sdk.NewAttribute("code_id", "1"))}
-	assert.Equal(t, exp, em.Events())
+	require.Len(t, em.Events(), 2)
+	exp := sdk.NewEvent("store_code", sdk.NewAttribute("code_checksum", codeHash), sdk.NewAttribute("code_id", "1"))
+	assert.Equal(t, exp, em.Events()[0])
+	gotTypedEvt, err := sdk.ParseTypedEvent(abci.Event(em.Events()[1]))
+	require.NoError(t, err)
+	require.IsType(t, &types.EventStoreCode{}, gotTypedEvt)
+	assert.Equal(t, uint64(1), gotTypedEvt.(*types.EventStoreCode).CodeID)
+	expChecksum, err := hex.DecodeString(codeHash)
+	require.NoError(t, err)
+	assert.Equal(t, expChecksum, gotTypedEvt.(*types.EventStoreCode).Checksum.Bytes())
+	assert.Equal(t, creator.String(), gotTypedEvt.(*types.EventStoreCode).Creator)
 }
 
 func TestCreateNilCreatorAddress(t *testing.T) {
@@ -128,6 +139,68 @@ func TestCreateInvalidWasmCode(t *testing.T) {
 	require.Error(t, err, "potatoes are not valid WASM code")
 }
 
+func TestCreateWithParamsLimits(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	creator := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 100000))
+	gzippedWasm, err := os.ReadFile("./testdata/hackatom.wasm.gzip")
+	require.NoError(t, err)
+	anyOfTwo := types.AccessTypeAnyOfAddresses.With(RandomAccountAddress(t), RandomAccountAddress(t))
+
+	specs := map[string]struct {
+		wasmCode       []byte
+		instantiateCfg *types.AccessConfig
+		params         func(*types.Params)
+		expErr         *errorsmod.Error
+	}{
+		"within limits": {
+			wasmCode: hackatomWasm,
+			params: func(p *types.Params) {
+				p.MaxProposalWasmSize = uint64(len(hackatomWasm))
+			},
+		},
+		"code exceeds max proposal wasm size": {
+			wasmCode: hackatomWasm,
+			params: func(p *types.Params) {
+				p.MaxProposalWasmSize = uint64(len(hackatomWasm) - 1)
+			},
+			expErr: types.ErrLimit,
+		},
+		"uncompressed code exceeds max wasm size": {
+			wasmCode: gzippedWasm,
+			params: func(p *types.Params) {
+				p.MaxWasmSize = uint64(len(hackatomWasm) - 1)
+			},
+			expErr: types.ErrCreateFailed,
+		},
+		"instantiate permission exceeds max address count": {
+			wasmCode:       hackatomWasm,
+			instantiateCfg: &anyOfTwo,
+			params: func(p *types.Params) {
+				p.MaxAddressCount = 1
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			params := types.DefaultParams()
+			spec.params(&params)
+			require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+
+			// when
+			_, _, gotErr := keepers.ContractKeeper.Create(ctx, creator, spec.wasmCode, spec.instantiateCfg)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
 func TestCreateStoresInstantiatePermission(t *testing.T) {
 	var (
 		deposit                = sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
@@ -383,6 +456,126 @@ func TestCreateWithGzippedPayload(t *testing.T) {
 	require.Equal(t, hackatomWasm, storedCode)
 }
 
+func TestReuseOrCreate(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	creator := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 100000))
+	existingCodeID, existingChecksum, err := k.create(parentCtx, creator, hackatomWasm, &types.AllowEverybody, DefaultAuthorizationPolicy{})
+	require.NoError(t, err)
+	gzippedWasm, err := os.ReadFile("./testdata/hackatom.wasm.gzip")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		wasmCode  []byte
+		access    *types.AccessConfig
+		expReused bool
+	}{
+		"same checksum and access config": {
+			wasmCode:  hackatomWasm,
+			access:    &types.AllowEverybody,
+			expReused: true,
+		},
+		"same gzipped code": {
+			wasmCode:  gzippedWasm,
+			access:    &types.AllowEverybody,
+			expReused: true,
+		},
+		"different access config": {
+			wasmCode: hackatomWasm,
+			access:   &types.AllowNobody,
+		},
+		"different checksum": {
+			wasmCode: testdata.BurnerContractWasm(),
+			access:   &types.AllowEverybody,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+
+			// when
+			gotCodeID, gotChecksum, gotErr := k.reuseOrCreate(ctx, creator, spec.wasmCode, spec.access, DefaultAuthorizationPolicy{})
+
+			// then
+			require.NoError(t, gotErr)
+			if spec.expReused {
+				assert.Equal(t, existingCodeID, gotCodeID)
+				assert.Equal(t, existingChecksum, gotChecksum)
+				nextCodeID, err := k.PeekAutoIncrementID(ctx, types.KeySequenceCodeID)
+				require.NoError(t, err)
+				assert.Equal(t, existingCodeID+1, nextCodeID)
+				return
+			}
+			assert.NotEqual(t, existingCodeID, gotCodeID)
+			codeInfo := k.GetCodeInfo(ctx, gotCodeID)
+			require.NotNil(t, codeInfo)
+			assert.Equal(t, *spec.access, codeInfo.InstantiateConfig)
+		})
+	}
+}
+
+func TestReuseOrCreateUnauthorized(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+	_, _, err := k.create(ctx, creator, hackatomWasm, &types.AllowEverybody, DefaultAuthorizationPolicy{})
+	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.CodeUploadAccess = types.AllowNobody
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// when
+	_, _, gotErr := k.reuseOrCreate(ctx, creator, hackatomWasm, &types.AllowEverybody, DefaultAuthorizationPolicy{})
+
+	// then
+	require.ErrorIs(t, gotErr, sdkerrors.ErrUnauthorized)
+}
+
+func TestCodeIDByChecksum(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+	_, checksum, err := k.create(ctx, creator, hackatomWasm, &types.AllowNobody, DefaultAuthorizationPolicy{})
+	require.NoError(t, err)
+	everybodyCodeID, _, err := k.create(ctx, creator, hackatomWasm, &types.AllowEverybody, DefaultAuthorizationPolicy{})
+	require.NoError(t, err)
+	onlyNobodyCodeID, onlyNobodyChecksum, err := k.create(ctx, creator, testdata.BurnerContractWasm(), &types.AllowNobody, DefaultAuthorizationPolicy{})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		checksum  []byte
+		expCodeID uint64
+		expErr    *errorsmod.Error
+	}{
+		"first code the creator can instantiate": {
+			checksum:  checksum,
+			expCodeID: everybodyCodeID,
+		},
+		"first code when none can be instantiated": {
+			checksum:  onlyNobodyChecksum,
+			expCodeID: onlyNobodyCodeID,
+		},
+		"unknown checksum": {
+			checksum: bytes.Repeat([]byte{1}, 32),
+			expErr:   types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotCodeID, gotErr := k.codeIDByChecksum(ctx, spec.checksum, RandomAccountAddress(t), DefaultAuthorizationPolicy{})
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expCodeID, gotCodeID)
+		})
+	}
+}
+
 func TestCreateWithBrokenGzippedPayload(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.ContractKeeper
@@ -426,7 +619,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x1c479), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x1d9ee), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -448,12 +641,101 @@ func TestInstantiate(t *testing.T) {
 	expEvt := sdk.Events{
 		sdk.NewEvent("instantiate",
 			sdk.NewAttribute("_contract_address", gotContractAddr.String()), sdk.NewAttribute("code_id", "1")),
+		typedEvent(t, &types.EventInstantiate{
+			Contract: gotContractAddr.String(),
+			CodeID:   example.CodeID,
+			Creator:  creator.String(),
+			Label:    "demo contract 1",
+		}),
 		sdk.NewEvent("wasm",
 			sdk.NewAttribute("_contract_address", gotContractAddr.String()), sdk.NewAttribute("Let the", "hacking begin")),
 	}
 	assert.Equal(t, expEvt, em.Events())
 }
 
+func TestInstantiateWithGasRegisterFromParams(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+
+	instantiateGas := func(instanceCost storetypes.Gas) storetypes.Gas {
+		ctx, _ := parentCtx.CacheContext()
+		params := types.DefaultParams()
+		gr := types.NewGasRegisterParams(types.DefaultGasRegisterConfig())
+		gr.InstanceCost = instanceCost
+		params.GasRegister = &gr
+		require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+		_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsgBz, "demo contract", nil)
+		require.NoError(t, err)
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	// when
+	defaultGas := instantiateGas(types.DefaultInstanceCost)
+	increasedGas := instantiateGas(types.DefaultInstanceCost + 1_000)
+
+	// then
+	assert.Equal(t, defaultGas+1_000, increasedGas)
+}
+
+func TestWithGasRegister(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	myGasRegister := wasmtesting.MockGasRegister{}
+	paramsConfig := types.DefaultGasRegisterConfig()
+	paramsConfig.InstanceCost++
+	paramsGasRegister := types.NewGasRegisterParams(paramsConfig)
+
+	specs := map[string]struct {
+		setup func(ctx sdk.Context) sdk.Context
+		exp   types.GasRegister
+	}{
+		"gas register from context": {
+			setup: func(ctx sdk.Context) sdk.Context {
+				params := types.DefaultParams()
+				params.GasRegister = &paramsGasRegister
+				require.NoError(t, k.SetParams(ctx, params))
+				return types.WithGasRegister(ctx, myGasRegister)
+			},
+			exp: myGasRegister,
+		},
+		"gas register from params": {
+			setup: func(ctx sdk.Context) sdk.Context {
+				params := types.DefaultParams()
+				params.GasRegister = &paramsGasRegister
+				require.NoError(t, k.SetParams(ctx, params))
+				return ctx
+			},
+			exp: types.NewWasmGasRegister(paramsConfig),
+		},
+		"no gas register params": {
+			setup: func(ctx sdk.Context) sdk.Context { return ctx },
+			exp:   k.GetGasRegister(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctx = spec.setup(ctx)
+
+			// when
+			gotCtx, gotGasRegister := k.withGasRegister(ctx)
+
+			// then
+			assert.Equal(t, spec.exp, gotGasRegister)
+			fromCtx, ok := types.GasRegisterFromContext(gotCtx)
+			require.True(t, ok)
+			assert.Equal(t, spec.exp, fromCtx)
+		})
+	}
+}
+
 func TestInstantiateWithDeposit(t *testing.T) {
 	var (
 		bob  = bytes.Repeat([]byte{1}, types.SDKAddrLen)
@@ -717,6 +999,98 @@ func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	require.Nil(t, addr)
 }
 
+func TestInstantiateWithParamsLabelLimit(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+	params := types.DefaultParams()
+	params.MaxLabelSize = 5
+	require.NoError(t, keepers.WasmKeeper.SetParams(parentCtx, params))
+
+	specs := map[string]struct {
+		label  string
+		expErr *errorsmod.Error
+	}{
+		"label within limit": {
+			label: "label",
+		},
+		"label exceeds limit": {
+			label:  "labels",
+			expErr: types.ErrLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+
+			// when
+			_, _, gotErr := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsgBz, spec.label, nil)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestInstantiateWithUniqueContractLabels(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+	_, _, err := keepers.ContractKeeper.Instantiate(parentCtx, example.CodeID, example.CreatorAddr, nil, initMsgBz, "my label", nil)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		unique bool
+		label  string
+		expErr *errorsmod.Error
+	}{
+		"unique labels - new label": {
+			unique: true,
+			label:  "other label",
+		},
+		"unique labels - label in use": {
+			unique: true,
+			label:  "my label",
+			expErr: types.ErrDuplicateLabel,
+		},
+		"unique labels - label prefix in use": {
+			unique: true,
+			label:  "my",
+		},
+		"duplicate labels allowed": {
+			label: "my label",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			params := types.DefaultParams()
+			params.UniqueContractLabels = spec.unique
+			require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+
+			// when
+			_, _, gotErr := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsgBz, spec.label, nil)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
 func TestContractErrorRedacting(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 
@@ -738,7 +1112,7 @@ func TestContractErrorRedacting(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "addr_validate errored: invalid address")
 
-	err = redactError(err)
+	err = redactError(err, false)
 	// contract error should not be redacted
 	require.Contains(t, err.Error(), "addr_validate errored: invalid address")
 }
@@ -978,10 +1352,11 @@ func TestExecute(t *testing.T) {
 	assert.Equal(t, sdk.Coins{}, bankKeeper.GetAllBalances(ctx, contractAcct.GetAddress()))
 
 	// and events emitted
-	require.Len(t, em.Events(), 9)
+	require.Len(t, em.Events(), 10)
 	expEvt := sdk.NewEvent("execute",
 		sdk.NewAttribute("_contract_address", addr.String()))
 	assert.Equal(t, expEvt, em.Events()[3], prettyEvents(t, em.Events()))
+	assert.Equal(t, typedEvent(t, &types.EventExecute{Contract: addr.String(), Sender: fred.String()}), em.Events()[4])
 
 	t.Logf("Duration: %v (%d gas)\n", diff, gasAfter-gasBefore)
 }
@@ -1493,6 +1868,191 @@ func TestMigrateReplacesTheSecondIndex(t *testing.T) {
 	require.False(t, exists)
 }
 
+func TestMigrateContractsByCode(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	newCodeExample := StoreBurnerExampleContract(t, parentCtx, keepers)
+	_, otherAdmin := keyPubAddr()
+	keepers.Faucet.Fund(parentCtx, otherAdmin, example.InitialAmount...)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	initMsgBz := HackatomExampleInitMsg{Verifier: example.CreatorAddr, Beneficiary: example.CreatorAddr}.GetBytes(t)
+	for _, admin := range []sdk.AccAddress{example.CreatorAddr, otherAdmin, example.CreatorAddr} {
+		_, _, err := keepers.ContractKeeper.Instantiate(parentCtx, example.CodeID, admin, admin, initMsgBz, "demo contract", deposit)
+		require.NoError(t, err)
+	}
+	var contracts []sdk.AccAddress
+	k.IterateContractsByCode(parentCtx, example.CodeID, func(address sdk.AccAddress) bool {
+		contracts = append(contracts, address)
+		return false
+	})
+	require.Len(t, contracts, 3)
+	migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+
+	specs := map[string]struct {
+		limit       uint64
+		startAfter  sdk.AccAddress
+		expMigrated []sdk.AccAddress
+		expErr      *errorsmod.Error
+	}{
+		"all": {
+			expMigrated: contracts,
+		},
+		"with limit": {
+			limit:       2,
+			expMigrated: contracts[:2],
+		},
+		"with start after": {
+			startAfter:  contracts[0],
+			expMigrated: contracts[1:],
+		},
+		"with start after and limit": {
+			startAfter:  contracts[0],
+			limit:       1,
+			expMigrated: contracts[1:2],
+		},
+		"start after last contract": {
+			startAfter: contracts[2],
+		},
+		"unknown start after contract": {
+			startAfter: RandomAccountAddress(t),
+			expErr:     types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+
+			// when
+			gotResults, gotErr := k.migrateContractsByCode(ctx, example.CodeID, newCodeExample.CodeID, migMsgBz, spec.limit, spec.startAfter, RandomAccountAddress(t), GovAuthorizationPolicy{})
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Len(t, gotResults, len(spec.expMigrated))
+			for i, contract := range spec.expMigrated {
+				assert.Equal(t, contract.String(), gotResults[i].Contract)
+				assert.True(t, gotResults[i].Success)
+				assert.Empty(t, gotResults[i].Error)
+				assert.Equal(t, newCodeExample.CodeID, k.GetContractInfo(ctx, contract).CodeID)
+			}
+		})
+	}
+}
+
+func TestMigrateContractsByCodeWithFailures(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	newCodeExample := StoreBurnerExampleContract(t, ctx, keepers)
+	_, otherAdmin := keyPubAddr()
+	keepers.Faucet.Fund(ctx, otherAdmin, example.InitialAmount...)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	initMsgBz := HackatomExampleInitMsg{Verifier: example.CreatorAddr, Beneficiary: example.CreatorAddr}.GetBytes(t)
+	admins := make(map[string]sdk.AccAddress)
+	for _, admin := range []sdk.AccAddress{example.CreatorAddr, otherAdmin, example.CreatorAddr} {
+		contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, admin, admin, initMsgBz, "demo contract", deposit)
+		require.NoError(t, err)
+		admins[contractAddr.String()] = admin
+	}
+	migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+
+	// when migrated by the admin of some contracts only
+	gotResults, gotErr := k.migrateContractsByCode(ctx, example.CodeID, newCodeExample.CodeID, migMsgBz, 0, nil, example.CreatorAddr, DefaultAuthorizationPolicy{})
+
+	// then
+	require.NoError(t, gotErr)
+	require.Len(t, gotResults, 3)
+	for _, r := range gotResults {
+		contractAddr := sdk.MustAccAddressFromBech32(r.Contract)
+		if admins[r.Contract].Equals(otherAdmin) {
+			assert.False(t, r.Success)
+			assert.NotEmpty(t, r.Error)
+			assert.Equal(t, example.CodeID, k.GetContractInfo(ctx, contractAddr).CodeID)
+			continue
+		}
+		assert.True(t, r.Success)
+		assert.Empty(t, r.Error)
+		assert.Equal(t, newCodeExample.CodeID, k.GetContractInfo(ctx, contractAddr).CodeID)
+	}
+}
+
+func TestMigrateContractsByCodeInBatches(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	newCodeExample := StoreBurnerExampleContract(t, ctx, keepers)
+	_, otherAdmin := keyPubAddr()
+	keepers.Faucet.Fund(ctx, otherAdmin, example.InitialAmount...)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	initMsgBz := HackatomExampleInitMsg{Verifier: example.CreatorAddr, Beneficiary: example.CreatorAddr}.GetBytes(t)
+	admins := make(map[string]sdk.AccAddress)
+	for _, admin := range []sdk.AccAddress{example.CreatorAddr, otherAdmin, example.CreatorAddr, example.CreatorAddr, otherAdmin} {
+		contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, admin, admin, initMsgBz, "demo contract", deposit)
+		require.NoError(t, err)
+		admins[contractAddr.String()] = admin
+	}
+	var contracts []sdk.AccAddress
+	k.IterateContractsByCode(ctx, example.CodeID, func(address sdk.AccAddress) bool {
+		contracts = append(contracts, address)
+		return false
+	})
+	require.Len(t, contracts, 5)
+	migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+
+	// when migrated in batches of two, continuing after the last contract of each batch
+	var gotContracts []string
+	var gotSuccess []bool
+	var startAfter sdk.AccAddress
+	for batch := 0; ; batch++ {
+		require.Less(t, batch, len(contracts))
+		gotResults, gotErr := k.migrateContractsByCode(ctx, example.CodeID, newCodeExample.CodeID, migMsgBz, 2, startAfter, example.CreatorAddr, DefaultAuthorizationPolicy{})
+		require.NoError(t, gotErr)
+		if len(gotResults) == 0 {
+			break
+		}
+		for _, r := range gotResults {
+			gotContracts = append(gotContracts, r.Contract)
+			gotSuccess = append(gotSuccess, r.Success)
+		}
+		startAfter = sdk.MustAccAddressFromBech32(gotResults[len(gotResults)-1].Contract)
+	}
+
+	// then all contracts were processed exactly once
+	var expContracts []string
+	var expSuccess []bool
+	var expRemaining []sdk.AccAddress
+	for _, c := range contracts {
+		expContracts = append(expContracts, c.String())
+		migrated := admins[c.String()].Equals(example.CreatorAddr)
+		expSuccess = append(expSuccess, migrated)
+		if !migrated {
+			expRemaining = append(expRemaining, c)
+		}
+	}
+	assert.Equal(t, expContracts, gotContracts)
+	assert.Equal(t, expSuccess, gotSuccess)
+	// and only the contracts that failed are left with the old code
+	var gotRemaining []sdk.AccAddress
+	k.IterateContractsByCode(ctx, example.CodeID, func(address sdk.AccAddress) bool {
+		gotRemaining = append(gotRemaining, address)
+		return false
+	})
+	assert.Equal(t, expRemaining, gotRemaining)
+}
+
+func TestMigrateContractsByCodeUnknownCode(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	_, gotErr := keepers.WasmKeeper.migrateContractsByCode(ctx, example.CodeID, example.CodeID+1, []byte(`{}`), 0, nil, example.CreatorAddr, GovAuthorizationPolicy{})
+	require.Error(t, gotErr)
+	assert.Equal(t, example.CodeID, keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).CodeID)
+}
+
 func TestMigrateWithDispatchedMessage(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.ContractKeeper
@@ -1535,6 +2095,14 @@ func TestMigrateWithDispatchedMessage(t *testing.T) {
 				{"_contract_address": contractAddr},
 			},
 		},
+		{
+			"Type": "cosmwasm.wasm.v1.EventMigrate",
+			"Attr": []dict{
+				{"code_id": `"2"`},
+				{"contract": `"` + contractAddr.String() + `"`},
+				{"sender": `"` + fred.String() + `"`},
+			},
+		},
 		{
 			"Type": "wasm",
 			"Attr": []dict{
@@ -1722,10 +2290,11 @@ func TestSudo(t *testing.T) {
 	balance := bankKeeper.GetBalance(ctx, comAcct.GetAddress(), "denom")
 	assert.Equal(t, sdk.NewInt64Coin("denom", 76543), balance)
 	// and events emitted
-	require.Len(t, em.Events(), 4, prettyEvents(t, em.Events()))
+	require.Len(t, em.Events(), 5, prettyEvents(t, em.Events()))
 	expEvt := sdk.NewEvent("sudo",
 		sdk.NewAttribute("_contract_address", addr.String()))
 	assert.Equal(t, expEvt, em.Events()[0])
+	assert.Equal(t, typedEvent(t, &types.EventSudo{Contract: addr.String()}), em.Events()[1])
 }
 
 func prettyEvents(t *testing.T, events sdk.Events) string {
@@ -1911,7 +2480,10 @@ func TestPinCode(t *testing.T) {
 	assert.True(t, k.IsPinnedCode(ctx, myCodeID))
 
 	// and events
-	exp := sdk.Events{sdk.NewEvent("pin_code", sdk.NewAttribute("code_id", "1"))}
+	exp := sdk.Events{
+		sdk.NewEvent("pin_code", sdk.NewAttribute("code_id", "1")),
+		typedEvent(t, &types.EventPinCode{CodeID: 1}),
+	}
 	assert.Equal(t, exp, em.Events())
 }
 
@@ -1945,10 +2517,138 @@ func TestUnpinCode(t *testing.T) {
 	assert.False(t, k.IsPinnedCode(ctx, myCodeID))
 
 	// and events
-	exp := sdk.Events{sdk.NewEvent("unpin_code", sdk.NewAttribute("code_id", "1"))}
+	exp := sdk.Events{
+		sdk.NewEvent("unpin_code", sdk.NewAttribute("code_id", "1")),
+		typedEvent(t, &types.EventUnpinCode{CodeID: 1}),
+	}
 	assert.Equal(t, exp, em.Events())
 }
 
+func TestDeleteCode(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	var removedChecksums []wasmvm.Checksum
+	mock := wasmtesting.MockWasmEngine{
+		PinFn:   func(checksum wasmvm.Checksum) error { return nil },
+		UnpinFn: func(checksum wasmvm.Checksum) error { return nil },
+		RemoveCodeFn: func(checksum wasmvm.Checksum) error {
+			removedChecksums = append(removedChecksums, checksum)
+			return nil
+		},
+	}
+	wasmtesting.MakeInstantiable(&mock)
+	example := StoreRandomContract(t, parentCtx, keepers, &mock)
+	usedCode := StoreRandomContract(t, parentCtx, keepers, &mock)
+	_, _, err := keepers.ContractKeeper.Instantiate(parentCtx, usedCode.CodeID, usedCode.CreatorAddr, nil, []byte("{}"), "label", nil)
+	require.NoError(t, err)
+
+	sharedWasmCode := append(wasmIdent, rand.Bytes(10)...)
+	sharedCodeID, _, err := k.create(parentCtx, example.CreatorAddr, sharedWasmCode, nil, DefaultAuthorizationPolicy{})
+	require.NoError(t, err)
+	otherSharedCodeID, _, err := k.create(parentCtx, example.CreatorAddr, sharedWasmCode, nil, DefaultAuthorizationPolicy{})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		codeID     uint64
+		caller     sdk.AccAddress
+		policy     types.AuthorizationPolicy
+		pinned     bool
+		expErr     error
+		expRemoved bool
+	}{
+		"creator": {
+			codeID:     example.CodeID,
+			caller:     example.CreatorAddr,
+			policy:     DefaultAuthorizationPolicy{},
+			expRemoved: true,
+		},
+		"gov": {
+			codeID:     example.CodeID,
+			caller:     RandomAccountAddress(t),
+			policy:     GovAuthorizationPolicy{},
+			expRemoved: true,
+		},
+		"pinned code": {
+			codeID:     example.CodeID,
+			caller:     example.CreatorAddr,
+			policy:     DefaultAuthorizationPolicy{},
+			pinned:     true,
+			expRemoved: true,
+		},
+		"checksum used by other code": {
+			codeID: sharedCodeID,
+			caller: example.CreatorAddr,
+			policy: DefaultAuthorizationPolicy{},
+		},
+		"unauthorized": {
+			codeID: example.CodeID,
+			caller: RandomAccountAddress(t),
+			policy: DefaultAuthorizationPolicy{},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"code in use": {
+			codeID: usedCode.CodeID,
+			caller: usedCode.CreatorAddr,
+			policy: DefaultAuthorizationPolicy{},
+			expErr: types.ErrCodeInUse,
+		},
+		"unknown code": {
+			codeID: 100,
+			caller: example.CreatorAddr,
+			policy: GovAuthorizationPolicy{},
+			expErr: types.ErrNoSuchCodeFn(100),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			removedChecksums = nil
+			if spec.pinned {
+				require.NoError(t, k.pinCode(ctx, spec.codeID))
+			}
+			var checksum []byte
+			if codeInfo := k.GetCodeInfo(ctx, spec.codeID); codeInfo != nil {
+				checksum = codeInfo.CodeHash
+			}
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.deleteCode(ctx.WithEventManager(em), spec.codeID, spec.caller, spec.policy)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetCodeInfo(ctx, spec.codeID))
+			assert.False(t, k.IsPinnedCode(ctx, spec.codeID))
+			k.IterateCodesByChecksum(ctx, checksum, func(codeID uint64) bool {
+				assert.NotEqual(t, spec.codeID, codeID)
+				return false
+			})
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, "delete_code", em.Events()[0].Type)
+			assert.Equal(t, typedEvent(t, &types.EventDeleteCode{CodeID: spec.codeID, Checksum: checksum}), em.Events()[1])
+
+			// and wasm blob removed at the end of the block
+			k.RemoveDeletedCodes(ctx)
+			if !spec.expRemoved {
+				assert.Empty(t, removedChecksums)
+				assert.NotNil(t, k.GetCodeInfo(ctx, otherSharedCodeID))
+				return
+			}
+			require.Len(t, removedChecksums, 1)
+			assert.Equal(t, example.Checksum, []byte(removedChecksums[0]))
+			// and queue is empty
+			removedChecksums = nil
+			k.RemoveDeletedCodes(ctx)
+			assert.Empty(t, removedChecksums)
+		})
+	}
+}
+
 func TestInitializePinnedCodes(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	k := keepers.WasmKeeper
@@ -2125,7 +2825,10 @@ func TestReply(t *testing.T) {
 				return &wasmvmtypes.Response{Data: []byte("foo")}, 1, nil
 			},
 			expData: []byte("foo"),
-			expEvt:  sdk.Events{sdk.NewEvent("reply", sdk.NewAttribute("_contract_address", example.Contract.String()))},
+			expEvt: sdk.Events{
+				sdk.NewEvent("reply", sdk.NewAttribute("_contract_address", example.Contract.String())),
+				typedEvent(t, &types.EventReply{Contract: example.Contract.String()}),
+			},
 		},
 		"with query": {
 			replyFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, reply wasmvmtypes.Reply, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
@@ -2141,7 +2844,10 @@ func TestReply(t *testing.T) {
 				return &wasmvmtypes.Response{Data: []byte("foo")}, 1, nil
 			},
 			expData: []byte("foo"),
-			expEvt:  sdk.Events{sdk.NewEvent("reply", sdk.NewAttribute("_contract_address", example.Contract.String()))},
+			expEvt: sdk.Events{
+				sdk.NewEvent("reply", sdk.NewAttribute("_contract_address", example.Contract.String())),
+				typedEvent(t, &types.EventReply{Contract: example.Contract.String()}),
+			},
 		},
 		"with query error handled": {
 			replyFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, reply wasmvmtypes.Reply, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
@@ -2151,7 +2857,10 @@ func TestReply(t *testing.T) {
 				return &wasmvmtypes.Response{Data: []byte("foo")}, 1, nil
 			},
 			expData: []byte("foo"),
-			expEvt:  sdk.Events{sdk.NewEvent("reply", sdk.NewAttribute("_contract_address", example.Contract.String()))},
+			expEvt: sdk.Events{
+				sdk.NewEvent("reply", sdk.NewAttribute("_contract_address", example.Contract.String())),
+				typedEvent(t, &types.EventReply{Contract: example.Contract.String()}),
+			},
 		},
 		"error": {
 			replyFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, reply wasmvmtypes.Reply, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
@@ -2494,9 +3203,10 @@ func TestSetAccessConfig(t *testing.T) {
 			}
 			require.NoError(t, gotErr)
 			// and event emitted
-			require.Len(t, em.Events(), 1)
+			require.Len(t, em.Events(), 2)
 			assert.Equal(t, "update_code_access_config", em.Events()[0].Type)
 			assert.Equal(t, spec.expEvts, attrsToStringMap(em.Events()[0].Attributes))
+			assert.Equal(t, typedEvent(t, &types.EventUpdateInstantiateConfig{CodeID: codeID, InstantiateConfig: spec.newConfig}), em.Events()[1])
 		})
 	}
 }
@@ -2755,13 +3465,14 @@ func TestSetContractAdmin(t *testing.T) {
 			require.NoError(t, gotErr)
 			assert.Equal(t, spec.expAdmin, k.GetContractInfo(ctx, example.Contract).Admin)
 			// and event emitted
-			require.Len(t, em.Events(), 1)
+			require.Len(t, em.Events(), 2)
 			assert.Equal(t, "update_contract_admin", em.Events()[0].Type)
 			exp := map[string]string{
 				"_contract_address": example.Contract.String(),
 				"new_admin_address": spec.expAdmin,
 			}
 			assert.Equal(t, exp, attrsToStringMap(em.Events()[0].Attributes))
+			assert.Equal(t, typedEvent(t, &types.EventUpdateAdmin{Contract: example.Contract.String(), NewAdmin: spec.expAdmin}), em.Events()[1])
 		})
 	}
 }
@@ -2873,13 +3584,184 @@ func TestSetContractLabel(t *testing.T) {
 			require.NoError(t, gotErr)
 			assert.Equal(t, spec.newLabel, k.GetContractInfo(ctx, spec.contract).Label)
 			// and event emitted
-			require.Len(t, em.Events(), 1)
+			require.Len(t, em.Events(), 2)
 			assert.Equal(t, "update_contract_label", em.Events()[0].Type)
 			exp := map[string]string{
 				"_contract_address": spec.contract.String(),
 				"new_label":         spec.newLabel,
 			}
 			assert.Equal(t, exp, attrsToStringMap(em.Events()[0].Attributes))
+			assert.Equal(t, typedEvent(t, &types.EventUpdateContractLabel{Contract: spec.contract.String(), NewLabel: spec.newLabel}), em.Events()[1])
+		})
+	}
+}
+
+func TestSetContractLabelWithUniqueContractLabels(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+	myContract, _, err := keepers.ContractKeeper.Instantiate(parentCtx, example.CodeID, example.CreatorAddr, example.CreatorAddr, initMsgBz, "my label", nil)
+	require.NoError(t, err)
+	_, _, err = keepers.ContractKeeper.Instantiate(parentCtx, example.CodeID, example.CreatorAddr, nil, initMsgBz, "other label", nil)
+	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.UniqueContractLabels = true
+	require.NoError(t, k.SetParams(parentCtx, params))
+
+	contractsByLabel := func(ctx sdk.Context, label string) []sdk.AccAddress {
+		var r []sdk.AccAddress
+		k.IterateContractsByLabel(ctx, label, func(addr sdk.AccAddress) bool {
+			r = append(r, addr)
+			return false
+		})
+		return r
+	}
+	specs := map[string]struct {
+		newLabel string
+		expErr   *errorsmod.Error
+	}{
+		"new label": {
+			newLabel: "new label",
+		},
+		"same label": {
+			newLabel: "my label",
+		},
+		"label of other contract": {
+			newLabel: "other label",
+			expErr:   types.ErrDuplicateLabel,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+
+			// when
+			gotErr := k.setContractLabel(ctx, myContract, example.CreatorAddr, spec.newLabel, DefaultAuthorizationPolicy{})
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, []sdk.AccAddress{myContract}, contractsByLabel(ctx, spec.newLabel))
+			if spec.newLabel != "my label" {
+				assert.Empty(t, contractsByLabel(ctx, "my label"))
+			}
+		})
+	}
+}
+
+func TestSetContractFrozen(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+
+	specs := map[string]struct {
+		frozen      bool
+		contract    sdk.AccAddress
+		expEvt      string
+		expTypedEvt proto.Message
+		expErr      bool
+	}{
+		"freeze": {
+			frozen:      true,
+			contract:    example.Contract,
+			expEvt:      "freeze_contract",
+			expTypedEvt: &types.EventFreezeContract{Contract: example.Contract.String()},
+		},
+		"unfreeze": {
+			frozen:      false,
+			contract:    example.Contract,
+			expEvt:      "unfreeze_contract",
+			expTypedEvt: &types.EventUnfreezeContract{Contract: example.Contract.String()},
+		},
+		"unknown contract": {
+			frozen:   true,
+			contract: RandomAccountAddress(t),
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			gotErr := k.setContractFrozen(ctx, spec.contract, spec.frozen)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.frozen, k.GetContractInfo(ctx, spec.contract).Frozen)
+			// and event emitted
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, spec.expEvt, em.Events()[0].Type)
+			exp := map[string]string{"_contract_address": spec.contract.String()}
+			assert.Equal(t, exp, attrsToStringMap(em.Events()[0].Attributes))
+			assert.Equal(t, typedEvent(t, spec.expTypedEvt), em.Events()[1])
+		})
+	}
+}
+
+func TestFrozenContract(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+
+	sudoMsgBz := mustMarshal(t, sudoMsg{StealFunds: stealFundsMsg{
+		Recipient: RandomBech32AccountAddress(t),
+		Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1, "denom")},
+	}})
+	migMsgBz := mustMarshal(t, struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: RandomAccountAddress(t)})
+
+	specs := map[string]struct {
+		frozen bool
+		expErr *errorsmod.Error
+	}{
+		"frozen": {
+			frozen: true,
+			expErr: types.ErrContractFrozen,
+		},
+		"not frozen": {
+			frozen: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			require.NoError(t, k.setContractFrozen(ctx, example.Contract, spec.frozen))
+
+			assertErr := func(t *testing.T, gotErr error) {
+				t.Helper()
+				if spec.expErr != nil {
+					require.ErrorIs(t, gotErr, spec.expErr)
+					return
+				}
+				require.NoError(t, gotErr)
+			}
+			// queries are not affected
+			_, gotErr := k.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+			require.NoError(t, gotErr)
+
+			_, gotErr = k.Sudo(ctx, example.Contract, sudoMsgBz)
+			assertErr(t, gotErr)
+
+			_, gotErr = k.execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+			assertErr(t, gotErr)
+
+			_, gotErr = k.migrate(ctx, example.Contract, example.CreatorAddr, example.CodeID, migMsgBz, DefaultAuthorizationPolicy{})
+			assertErr(t, gotErr)
+
+			// gov can always migrate
+			_, gotErr = k.migrate(ctx, example.Contract, RandomAccountAddress(t), example.CodeID, migMsgBz, GovAuthorizationPolicy{})
+			require.NoError(t, gotErr)
 		})
 	}
 }
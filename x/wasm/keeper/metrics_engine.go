@@ -0,0 +1,319 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+const (
+	labelEntrypoint = "entrypoint"
+	labelCodeID     = "code_id"
+	labelChecksum   = "checksum"
+	labelErrorClass = "error_class"
+	// labelOther is used for the code id and checksum of codes that are not in the allowlist
+	labelOther = "other"
+)
+
+const entrypointQuerySmart = "query-smart"
+
+// error classes of failed contract calls
+const (
+	errorClassOutOfGas = "out_of_gas"
+	errorClassVM       = "vm"
+	errorClassContract = "contract"
+)
+
+// WasmEngineMetrics execution metrics of contract calls to be used with Prometheus
+type WasmEngineMetrics struct {
+	allowedCodeIDs  map[uint64]struct{}
+	Duration        *prometheus.HistogramVec
+	GasUsed         *prometheus.HistogramVec
+	Failures        *prometheus.CounterVec
+	QueryStackDepth *prometheus.HistogramVec
+}
+
+// NewWasmEngineMetrics constructor. Only the codes in the allowlist get their own code id and checksum labels
+// to bound the label cardinality. All other codes are labelled "other".
+func NewWasmEngineMetrics(allowedCodeIDs []uint64) *WasmEngineMetrics {
+	allowed := make(map[uint64]struct{}, len(allowedCodeIDs))
+	for _, id := range allowedCodeIDs {
+		allowed[id] = struct{}{}
+	}
+	callLabels := []string{labelEntrypoint, labelCodeID, labelChecksum}
+	return &WasmEngineMetrics{
+		allowedCodeIDs: allowed,
+		Duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "wasm_contract_call_duration_seconds",
+			Help:    "Duration of contract calls in the wasm engine per entrypoint",
+			Buckets: prometheus.ExponentialBuckets(0.0001, 2, 16),
+		}, callLabels),
+		GasUsed: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "wasm_contract_call_gas",
+			Help:    "Wasm gas used by contract calls per entrypoint",
+			Buckets: prometheus.ExponentialBuckets(1e8, 4, 12),
+		}, callLabels),
+		Failures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "wasm_contract_call_failures_total",
+			Help: "Total number of failed contract calls per entrypoint and error class",
+		}, append(callLabels, labelErrorClass)),
+		QueryStackDepth: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "wasm_contract_query_stack_depth",
+			Help:    "Position in the stack of nested smart queries when a contract is queried",
+			Buckets: prometheus.LinearBuckets(1, 1, int(types.DefaultMaxQueryStackSize)),
+		}, []string{labelCodeID, labelChecksum}),
+	}
+}
+
+// Register registers all metrics
+func (m *WasmEngineMetrics) Register(r prometheus.Registerer) {
+	r.MustRegister(m.Duration, m.GasUsed, m.Failures, m.QueryStackDepth)
+}
+
+// codeLabels returns the code id and checksum labels for the called contract
+func (m *WasmEngineMetrics) codeLabels(checksum wasmvm.Checksum, querier wasmvm.Querier) (string, string) {
+	q, ok := querier.(QueryHandler)
+	if !ok {
+		return labelOther, labelOther
+	}
+	codeID, ok := types.CodeIDFromContext(q.Ctx)
+	if !ok {
+		return labelOther, labelOther
+	}
+	if _, allowed := m.allowedCodeIDs[codeID]; !allowed {
+		return labelOther, labelOther
+	}
+	return strconv.FormatUint(codeID, 10), checksum.String()
+}
+
+// StartCall records the query stack depth for smart queries and the duration, gas and failures of the call
+func (m *WasmEngineMetrics) StartCall(entrypoint string, checksum wasmvm.Checksum, querier wasmvm.Querier) (wasmvm.Querier, func(gasUsed uint64, err error, contractErr string)) {
+	codeID, checksumLabel := m.codeLabels(checksum, querier)
+	if q, ok := querier.(QueryHandler); ok && entrypoint == entrypointQuerySmart {
+		if depth, ok := types.QueryStackSize(q.Ctx); ok {
+			m.QueryStackDepth.WithLabelValues(codeID, checksumLabel).Observe(float64(depth))
+		}
+	}
+	start := time.Now()
+	return querier, func(gasUsed uint64, err error, contractErr string) {
+		m.Duration.WithLabelValues(entrypoint, codeID, checksumLabel).Observe(time.Since(start).Seconds())
+		m.GasUsed.WithLabelValues(entrypoint, codeID, checksumLabel).Observe(float64(gasUsed))
+		if errClass := errorClass(err, contractErr); errClass != "" {
+			m.Failures.WithLabelValues(entrypoint, codeID, checksumLabel, errClass).Inc()
+		}
+	}
+}
+
+// errorClass returns the class of a failed contract call or empty string on success
+func errorClass(err error, contractErr string) string {
+	switch {
+	case errors.As(err, &wasmvmtypes.OutOfGasError{}):
+		return errorClassOutOfGas
+	case err != nil:
+		return errorClassVM
+	case contractErr != "":
+		return errorClassContract
+	default:
+		return ""
+	}
+}
+
+// WasmCallObserver is notified about the contract calls in the instrumented wasm engine
+type WasmCallObserver interface {
+	// StartCall is called before the contract call. It returns the querier to be used for the call and a function
+	// that is called with the results after the call.
+	StartCall(entrypoint string, checksum wasmvm.Checksum, querier wasmvm.Querier) (wasmvm.Querier, func(gasUsed uint64, err error, contractErr string))
+}
+
+var _ types.WasmEngine = (*InstrumentedWasmEngine)(nil)
+
+// InstrumentedWasmEngine decorates a wasm engine to notify an observer about the contract calls
+type InstrumentedWasmEngine struct {
+	types.WasmEngine
+	observer WasmCallObserver
+}
+
+// NewInstrumentedWasmEngine constructor
+func NewInstrumentedWasmEngine(engine types.WasmEngine, observer WasmCallObserver) *InstrumentedWasmEngine {
+	if engine == nil {
+		panic("wasm engine must not be nil")
+	}
+	if observer == nil {
+		panic("observer must not be nil")
+	}
+	return &InstrumentedWasmEngine{WasmEngine: engine, observer: observer}
+}
+
+// instrumentCall runs the contract call with the querier returned by the observer and passes the results back
+func instrumentCall[R any](
+	e *InstrumentedWasmEngine,
+	entrypoint string,
+	checksum wasmvm.Checksum,
+	querier wasmvm.Querier,
+	resultErr func(R) string,
+	call func(q wasmvm.Querier) (R, uint64, error),
+) (R, uint64, error) {
+	q, done := e.observer.StartCall(entrypoint, checksum, querier)
+	res, gasUsed, err := call(q)
+	var contractErr string
+	if err == nil {
+		contractErr = resultErr(res)
+	}
+	done(gasUsed, err, contractErr)
+	return res, gasUsed, err
+}
+
+func contractResultErr(r *wasmvmtypes.ContractResult) string {
+	if r == nil {
+		return ""
+	}
+	return r.Err
+}
+
+func queryResultErr(r *wasmvmtypes.QueryResult) string {
+	if r == nil {
+		return ""
+	}
+	return r.Err
+}
+
+func ibcChannelOpenResultErr(r *wasmvmtypes.IBCChannelOpenResult) string {
+	if r == nil {
+		return ""
+	}
+	return r.Err
+}
+
+func ibcBasicResultErr(r *wasmvmtypes.IBCBasicResult) string {
+	if r == nil {
+		return ""
+	}
+	return r.Err
+}
+
+func ibcReceiveResultErr(r *wasmvmtypes.IBCReceiveResult) string {
+	if r == nil {
+		return ""
+	}
+	return r.Err
+}
+
+func (e *InstrumentedWasmEngine) Instantiate(checksum wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, initMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+	return instrumentCall(e, "instantiate", checksum, querier, contractResultErr, func(q wasmvm.Querier) (*wasmvmtypes.ContractResult, uint64, error) {
+		return e.WasmEngine.Instantiate(checksum, env, info, initMsg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) Execute(checksum wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+	return instrumentCall(e, "execute", checksum, querier, contractResultErr, func(q wasmvm.Querier) (*wasmvmtypes.ContractResult, uint64, error) {
+		return e.WasmEngine.Execute(checksum, env, info, executeMsg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) Query(checksum wasmvm.Checksum, env wasmvmtypes.Env, queryMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+	return instrumentCall(e, entrypointQuerySmart, checksum, querier, queryResultErr, func(q wasmvm.Querier) (*wasmvmtypes.QueryResult, uint64, error) {
+		return e.WasmEngine.Query(checksum, env, queryMsg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) Migrate(checksum wasmvm.Checksum, env wasmvmtypes.Env, migrateMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+	return instrumentCall(e, "migrate", checksum, querier, contractResultErr, func(q wasmvm.Querier) (*wasmvmtypes.ContractResult, uint64, error) {
+		return e.WasmEngine.Migrate(checksum, env, migrateMsg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) MigrateWithInfo(checksum wasmvm.Checksum, env wasmvmtypes.Env, migrateMsg []byte, migrateInfo wasmvmtypes.MigrateInfo, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+	return instrumentCall(e, "migrate", checksum, querier, contractResultErr, func(q wasmvm.Querier) (*wasmvmtypes.ContractResult, uint64, error) {
+		return e.WasmEngine.MigrateWithInfo(checksum, env, migrateMsg, migrateInfo, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) Sudo(checksum wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+	return instrumentCall(e, "sudo", checksum, querier, contractResultErr, func(q wasmvm.Querier) (*wasmvmtypes.ContractResult, uint64, error) {
+		return e.WasmEngine.Sudo(checksum, env, sudoMsg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) Reply(checksum wasmvm.Checksum, env wasmvmtypes.Env, reply wasmvmtypes.Reply, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+	return instrumentCall(e, "reply", checksum, querier, contractResultErr, func(q wasmvm.Querier) (*wasmvmtypes.ContractResult, uint64, error) {
+		return e.WasmEngine.Reply(checksum, env, reply, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) IBCChannelOpen(checksum wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCChannelOpenMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCChannelOpenResult, uint64, error) {
+	return instrumentCall(e, "ibc-open-channel", checksum, querier, ibcChannelOpenResultErr, func(q wasmvm.Querier) (*wasmvmtypes.IBCChannelOpenResult, uint64, error) {
+		return e.WasmEngine.IBCChannelOpen(checksum, env, msg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) IBCChannelConnect(checksum wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCChannelConnectMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+	return instrumentCall(e, "ibc-connect-channel", checksum, querier, ibcBasicResultErr, func(q wasmvm.Querier) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+		return e.WasmEngine.IBCChannelConnect(checksum, env, msg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) IBCChannelClose(checksum wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCChannelCloseMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+	return instrumentCall(e, "ibc-close-channel", checksum, querier, ibcBasicResultErr, func(q wasmvm.Querier) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+		return e.WasmEngine.IBCChannelClose(checksum, env, msg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) IBCPacketReceive(checksum wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCPacketReceiveMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCReceiveResult, uint64, error) {
+	return instrumentCall(e, "ibc-recv-packet", checksum, querier, ibcReceiveResultErr, func(q wasmvm.Querier) (*wasmvmtypes.IBCReceiveResult, uint64, error) {
+		return e.WasmEngine.IBCPacketReceive(checksum, env, msg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) IBCPacketAck(checksum wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCPacketAckMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+	return instrumentCall(e, "ibc-ack-packet", checksum, querier, ibcBasicResultErr, func(q wasmvm.Querier) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+		return e.WasmEngine.IBCPacketAck(checksum, env, msg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) IBCPacketTimeout(checksum wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCPacketTimeoutMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+	return instrumentCall(e, "ibc-timeout-packet", checksum, querier, ibcBasicResultErr, func(q wasmvm.Querier) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+		return e.WasmEngine.IBCPacketTimeout(checksum, env, msg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) IBCSourceCallback(checksum wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCSourceCallbackMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+	return instrumentCall(e, "ibc-source-chain-callback", checksum, querier, ibcBasicResultErr, func(q wasmvm.Querier) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+		return e.WasmEngine.IBCSourceCallback(checksum, env, msg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) IBCDestinationCallback(checksum wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCDestinationCallbackMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+	return instrumentCall(e, "ibc-destination-chain-callback", checksum, querier, ibcBasicResultErr, func(q wasmvm.Querier) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+		return e.WasmEngine.IBCDestinationCallback(checksum, env, msg, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) IBC2PacketAck(checksum wasmvm.Checksum, env wasmvmtypes.Env, payload wasmvmtypes.IBC2AcknowledgeMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+	return instrumentCall(e, "ibc2-ack-packet", checksum, querier, ibcBasicResultErr, func(q wasmvm.Querier) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+		return e.WasmEngine.IBC2PacketAck(checksum, env, payload, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) IBC2PacketReceive(checksum wasmvm.Checksum, env wasmvmtypes.Env, payload wasmvmtypes.IBC2PacketReceiveMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCReceiveResult, uint64, error) {
+	return instrumentCall(e, "ibc2-recv-packet", checksum, querier, ibcReceiveResultErr, func(q wasmvm.Querier) (*wasmvmtypes.IBCReceiveResult, uint64, error) {
+		return e.WasmEngine.IBC2PacketReceive(checksum, env, payload, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) IBC2PacketTimeout(checksum wasmvm.Checksum, env wasmvmtypes.Env, packet wasmvmtypes.IBC2PacketTimeoutMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+	return instrumentCall(e, "ibc2-timeout-packet", checksum, querier, ibcBasicResultErr, func(q wasmvm.Querier) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+		return e.WasmEngine.IBC2PacketTimeout(checksum, env, packet, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
+
+func (e *InstrumentedWasmEngine) IBC2PacketSend(checksum wasmvm.Checksum, env wasmvmtypes.Env, packet wasmvmtypes.IBC2PacketSendMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+	return instrumentCall(e, "ibc2-send-packet", checksum, querier, ibcBasicResultErr, func(q wasmvm.Querier) (*wasmvmtypes.IBCBasicResult, uint64, error) {
+		return e.WasmEngine.IBC2PacketSend(checksum, env, packet, store, goapi, q, gasMeter, gasLimit, deserCost)
+	})
+}
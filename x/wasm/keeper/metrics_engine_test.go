@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestInstrumentedWasmEngineExecute(t *testing.T) {
+	checksum := wasmvm.Checksum(make([]byte, 32))
+	ctx := sdk.Context{}.WithContext(context.Background())
+
+	specs := map[string]struct {
+		codeID        uint64
+		result        *wasmvmtypes.ContractResult
+		err           error
+		expCodeID     string
+		expChecksum   string
+		expErrorClass string
+	}{
+		"allowed code": {
+			codeID:      1,
+			result:      &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}},
+			expCodeID:   "1",
+			expChecksum: checksum.String(),
+		},
+		"other code": {
+			codeID:      2,
+			result:      &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}},
+			expCodeID:   labelOther,
+			expChecksum: labelOther,
+		},
+		"contract error": {
+			codeID:        1,
+			result:        &wasmvmtypes.ContractResult{Err: "testing"},
+			expCodeID:     "1",
+			expChecksum:   checksum.String(),
+			expErrorClass: errorClassContract,
+		},
+		"vm error": {
+			codeID:        1,
+			err:           errors.New("testing"),
+			expCodeID:     "1",
+			expChecksum:   checksum.String(),
+			expErrorClass: errorClassVM,
+		},
+		"out of gas": {
+			codeID:        1,
+			err:           wasmvmtypes.OutOfGasError{},
+			expCodeID:     "1",
+			expChecksum:   checksum.String(),
+			expErrorClass: errorClassOutOfGas,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			m := NewWasmEngineMetrics([]uint64{1})
+			engine := NewInstrumentedWasmEngine(&wasmtesting.MockWasmEngine{
+				ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					return spec.result, 123, spec.err
+				},
+			}, m)
+			querier := QueryHandler{Ctx: types.WithCodeID(ctx, spec.codeID)}
+
+			// when
+			gotRes, gotGas, gotErr := engine.Execute(checksum, wasmvmtypes.Env{}, wasmvmtypes.MessageInfo{}, nil, nil, wasmvm.GoAPI{}, querier, nil, 0, wasmvmtypes.UFraction{})
+
+			// then
+			assert.Equal(t, spec.result, gotRes)
+			assert.Equal(t, uint64(123), gotGas)
+			assert.Equal(t, spec.err, gotErr)
+			gotLabels, gotCount, gotSum := gatherHistogram(t, m.GasUsed)
+			assert.Equal(t, map[string]string{labelEntrypoint: "execute", labelCodeID: spec.expCodeID, labelChecksum: spec.expChecksum}, gotLabels)
+			assert.Equal(t, uint64(1), gotCount)
+			assert.Equal(t, float64(123), gotSum)
+			if spec.expErrorClass == "" {
+				assert.Equal(t, 0, testutil.CollectAndCount(m.Failures))
+				return
+			}
+			assert.Equal(t, float64(1), testutil.ToFloat64(m.Failures.WithLabelValues("execute", spec.expCodeID, spec.expChecksum, spec.expErrorClass)))
+		})
+	}
+}
+
+func TestInstrumentedWasmEngineQueryStackDepth(t *testing.T) {
+	m := NewWasmEngineMetrics(nil)
+	engine := NewInstrumentedWasmEngine(&wasmtesting.MockWasmEngine{
+		QueryFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, queryMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+			return &wasmvmtypes.QueryResult{Ok: []byte(`{}`)}, 1, nil
+		},
+	}, m)
+	ctx := types.WithQueryStackSize(sdk.Context{}.WithContext(context.Background()), 3)
+
+	// when
+	_, _, err := engine.Query(wasmvm.Checksum(make([]byte, 32)), wasmvmtypes.Env{}, nil, nil, wasmvm.GoAPI{}, QueryHandler{Ctx: ctx}, nil, 0, wasmvmtypes.UFraction{})
+
+	// then
+	require.NoError(t, err)
+	gotLabels, gotCount, gotSum := gatherHistogram(t, m.QueryStackDepth)
+	assert.Equal(t, map[string]string{labelCodeID: labelOther, labelChecksum: labelOther}, gotLabels)
+	assert.Equal(t, uint64(1), gotCount)
+	assert.Equal(t, float64(3), gotSum)
+}
+
+// gatherHistogram returns the labels, sample count and sum of the single series of a histogram
+func gatherHistogram(t *testing.T, c prometheus.Collector) (map[string]string, uint64, float64) {
+	t.Helper()
+	r := prometheus.NewPedanticRegistry()
+	r.MustRegister(c)
+	mfs, err := r.Gather()
+	require.NoError(t, err)
+	require.Len(t, mfs, 1)
+	require.Len(t, mfs[0].GetMetric(), 1)
+	metric := mfs[0].GetMetric()[0]
+	labels := make(map[string]string)
+	for _, l := range metric.GetLabel() {
+		labels[l.GetName()] = l.GetValue()
+	}
+	return labels, metric.GetHistogram().GetSampleCount(), metric.GetHistogram().GetSampleSum()
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"fmt"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
@@ -34,12 +36,15 @@ type Messenger interface {
 // replyer is a subset of keeper that can handle replies to submessages
 type replyer interface {
 	reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error)
+	redactError(ctx sdk.Context, err error) error
 }
 
 // MessageDispatcher coordinates message sending and submessage reply/ state commits
 type MessageDispatcher struct {
 	messenger Messenger
 	keeper    replyer
+	// legacyEventsDisabled skips the untyped submessage error events
+	legacyEventsDisabled bool
 }
 
 // NewMessageDispatcher constructor
@@ -101,10 +106,23 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		default:
 			return nil, errorsmod.Wrap(types.ErrInvalid, "replyOn value")
 		}
+		trace := traceCall(ctx, func() *types.CallTraceNode {
+			return &types.CallTraceNode{
+				Type:     types.CallTraceTypeSubmessage,
+				Name:     traceName(msg.Msg),
+				Contract: contractAddr.String(),
+				SubMsgID: msg.ID,
+				ReplyOn:  msg.ReplyOn.String(),
+			}
+		})
+
 		// first, we build a sub-context which we can use inside the submessages
 		subCtx, commit := ctx.CacheContext()
 		em := sdk.NewEventManager()
 		subCtx = subCtx.WithEventManager(em)
+		if msg.Msg.Wasm != nil && (msg.ReplyOn == wasmvmtypes.ReplySuccess || msg.ReplyOn == wasmvmtypes.ReplyAlways) {
+			subCtx = types.WithContractReplyEvents(subCtx)
+		}
 
 		// check how much gas left locally, optionally wrap the gas meter
 		gasRemaining := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
@@ -119,6 +137,13 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		} else {
 			events, data, msgResponses, err = d.messenger.DispatchMsg(subCtx, contractAddr, ibcPort, msg.Msg)
 		}
+		var redacted error
+		if err != nil {
+			// Issue #759 - we don't return error string for worries of non-determinism
+			moduleLogger(ctx).Debug("Redacting submessage error", "cause", err)
+			redacted = d.keeper.redactError(ctx, err)
+		}
+		trace.setError(err, redacted)
 
 		// if it succeeds, commit state changes from submessage, and pass on events to Event Manager
 		var filteredEvents []sdk.Event
@@ -129,6 +154,11 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 			if msg.Msg.Wasm == nil {
 				filteredEvents = []sdk.Event{}
 			} else {
+				// the submessage errors of nested calls contain non-deterministic text and the typed events are
+				// not passed to keep the events of the reply unchanged
+				filteredEvents = slices.DeleteFunc(slices.Clone(filteredEvents), func(e sdk.Event) bool {
+					return e.Type == types.EventTypeSubMsgError || isTypedEvent(e)
+				})
 				for _, e := range filteredEvents {
 					attributes := e.Attributes
 					sort.SliceStable(attributes, func(i, j int) bool {
@@ -140,9 +170,11 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 
 		// we only callback if requested. Short-circuit here the cases we don't want to
 		if (msg.ReplyOn == wasmvmtypes.ReplySuccess || msg.ReplyOn == wasmvmtypes.ReplyNever) && err != nil {
+			trace.end()
 			return nil, err
 		}
 		if msg.ReplyOn == wasmvmtypes.ReplyNever || (msg.ReplyOn == wasmvmtypes.ReplyError && err == nil) {
+			trace.end()
 			continue
 		}
 
@@ -178,10 +210,9 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 				},
 			}
 		} else {
-			// Issue #759 - we don't return error string for worries of non-determinism
-			moduleLogger(ctx).Debug("Redacting submessage error", "cause", err)
+			d.emitSubMsgErrorEvent(ctx, contractAddr, msg.ID, err, redacted)
 			result = wasmvmtypes.SubMsgResult{
-				Err: redactError(err).Error(),
+				Err: redacted.Error(),
 			}
 		}
 
@@ -195,6 +226,7 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		// we can ignore any result returned as there is nothing to do with the data
 		// and the events are already in the ctx.EventManager()
 		rspData, err := d.keeper.reply(ctx, contractAddr, reply)
+		trace.end()
 		switch {
 		case err != nil:
 			return nil, errorsmod.Wrap(err, "reply")
@@ -206,7 +238,8 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 }
 
 // Issue #759 - we don't return error string for worries of non-determinism
-func redactError(err error) error {
+// The json format is enabled by the RedactedErrorJSON param, the legacy text otherwise.
+func redactError(err error, jsonFormat bool) error {
 	// Do not redact system errors
 	// SystemErrors must be created in x/wasm and we can ensure determinism
 	if wasmvmtypes.ToSystemError(err) != nil {
@@ -220,13 +253,30 @@ func redactError(err error) error {
 		return err
 	}
 
-	// FIXME: do we want to hardcode some constant string mappings here as well?
-	// Or better document them? (SDK error string may change on a patch release to fix wording)
-	// sdk/11 is out of gas
-	// sdk/5 is insufficient funds (on bank send)
-	// (we can theoretically redact less in the future, but this is a first step to safety)
+	// the error text may differ between nodes, so that the contract gets the codespace and code only together
+	// with a fixed reason text for the allowlisted errors
 	codespace, code, _ := errorsmod.ABCIInfo(err, false)
-	return fmt.Errorf("codespace: %s, code: %d", codespace, code)
+	if !jsonFormat {
+		return fmt.Errorf("codespace: %s, code: %d", codespace, code)
+	}
+	return types.NewRedactedError(codespace, code)
+}
+
+// emitSubMsgErrorEvent emits the full error text of a failed submessage for indexers. The event is not passed to
+// contracts.
+func (d MessageDispatcher) emitSubMsgErrorEvent(ctx sdk.Context, contractAddr sdk.AccAddress, msgID uint64, err, redacted error) {
+	emitActionEvent(ctx, d.legacyEventsDisabled, sdk.NewEvent(
+		types.EventTypeSubMsgError,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeySubMsgID, strconv.FormatUint(msgID, 10)),
+		sdk.NewAttribute(types.AttributeKeyRedactedError, redacted.Error()),
+		sdk.NewAttribute(types.AttributeKeySubMsgError, err.Error()),
+	), &types.EventSubMsgError{
+		Contract:      contractAddr.String(),
+		SubMsgID:      msgID,
+		RedactedError: redacted.Error(),
+		Error:         err.Error(),
+	})
 }
 
 func filterEvents(events []sdk.Event) []sdk.Event {
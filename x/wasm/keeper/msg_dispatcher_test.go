@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"testing"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
@@ -11,16 +13,37 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
 func TestDispatchSubmessages(t *testing.T) {
+	myContractAddr := BuildContractAddressClassic(1, 1)
+	// the legacy submessage error event followed by the typed event
+	subMsgErrorEvents := func(id uint64, redacted, errMsg string) []sdk.Event {
+		return []sdk.Event{
+			sdk.NewEvent(types.EventTypeSubMsgError,
+				sdk.NewAttribute(types.AttributeKeyContractAddr, myContractAddr.String()),
+				sdk.NewAttribute(types.AttributeKeySubMsgID, strconv.FormatUint(id, 10)),
+				sdk.NewAttribute(types.AttributeKeyRedactedError, redacted),
+				sdk.NewAttribute(types.AttributeKeySubMsgError, errMsg),
+			),
+			typedEvent(t, &types.EventSubMsgError{
+				Contract:      myContractAddr.String(),
+				SubMsgID:      id,
+				RedactedError: redacted,
+				Error:         errMsg,
+			}),
+		}
+	}
 	noReplyCalled := &mockReplyer{}
 	var anyGasLimit uint64 = 1
 	specs := map[string]struct {
@@ -86,6 +109,7 @@ func TestDispatchSubmessages(t *testing.T) {
 			},
 			expData:    []byte("myReplyData"),
 			expCommits: []bool{false},
+			expEvents:  subMsgErrorEvents(0, "codespace: undefined, code: 1", "my error"),
 		},
 		"with reply events": {
 			msgs: []wasmvmtypes.SubMsg{{
@@ -181,6 +205,7 @@ func TestDispatchSubmessages(t *testing.T) {
 			},
 			expData:    []byte("myReplyData"),
 			expCommits: []bool{false},
+			expEvents:  subMsgErrorEvents(0, "codespace: sdk, code: 11", "SubMsg hit gas limit: out of gas"),
 		},
 		"with gas limit - within limit no error": {
 			msgs: []wasmvmtypes.SubMsg{{
@@ -241,6 +266,7 @@ func TestDispatchSubmessages(t *testing.T) {
 			},
 			expData:    []byte("myReplyData:2"),
 			expCommits: []bool{false, false},
+			expEvents:  append(subMsgErrorEvents(1, "codespace: undefined, code: 1", "my error"), subMsgErrorEvents(2, "codespace: undefined, code: 1", "my error")...),
 		},
 		"multiple msg - last non nil reply returned": {
 			msgs: []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplyError}, {ID: 2, ReplyOn: wasmvmtypes.ReplyError}},
@@ -259,6 +285,7 @@ func TestDispatchSubmessages(t *testing.T) {
 			},
 			expData:    []byte("myReplyData:1"),
 			expCommits: []bool{false, false},
+			expEvents:  append(subMsgErrorEvents(1, "codespace: undefined, code: 1", "my error"), subMsgErrorEvents(2, "codespace: undefined, code: 1", "my error")...),
 		},
 		"multiple msg - empty reply can overwrite result": {
 			msgs: []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplyError}, {ID: 2, ReplyOn: wasmvmtypes.ReplyError}},
@@ -277,6 +304,7 @@ func TestDispatchSubmessages(t *testing.T) {
 			},
 			expData:    []byte{},
 			expCommits: []bool{false, false},
+			expEvents:  append(subMsgErrorEvents(1, "codespace: undefined, code: 1", "my error"), subMsgErrorEvents(2, "codespace: undefined, code: 1", "my error")...),
 		},
 		"message event filtered without reply": {
 			msgs: []wasmvmtypes.SubMsg{{
@@ -373,6 +401,34 @@ func TestDispatchSubmessages(t *testing.T) {
 			},
 			expCommits: []bool{true},
 		},
+		"wasm reply gets no submessage error events": {
+			msgs: []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplyAlways, Msg: wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{}}}},
+			replyer: &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					if reply.Result.Err != "" {
+						return nil, errors.New(reply.Result.Err)
+					}
+					if len(reply.Result.Ok.Events) != 1 || reply.Result.Ok.Events[0].Type != "wasm" {
+						return nil, fmt.Errorf("unexpected events: %#v", reply.Result.Ok.Events)
+					}
+					return reply.Result.Ok.Data, nil
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+					// from a nested submessage, it is emitted to the client but not passed to the contract
+					events = subMsgErrorEvents(2, "codespace: undefined, code: 1", "nested error")
+					events = append(events, sdk.NewEvent("wasm", sdk.NewAttribute("random", "data")))
+					return events, [][]byte{[]byte("subData")}, [][]*codectypes.Any{}, nil
+				},
+			},
+			expData:    []byte("subData"),
+			expCommits: []bool{true},
+			expEvents: append(
+				subMsgErrorEvents(2, "codespace: undefined, code: 1", "nested error"),
+				sdk.NewEvent("wasm", sdk.NewAttribute("random", "data")),
+			),
+		},
 		"non-wasm reply events get filtered": {
 			// show events from a stargate message gets filtered out
 			msgs: []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplyAlways, Msg: wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{}}}},
@@ -420,13 +476,13 @@ func TestDispatchSubmessages(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			var mockStore wasmtesting.MockCommitMultiStore
 			em := sdk.NewEventManager()
-			ctx := sdk.Context{}.WithMultiStore(&mockStore).
+			ctx := sdk.Context{}.WithContext(context.Background()).WithMultiStore(&mockStore).
 				WithGasMeter(storetypes.NewGasMeter(100)).
 				WithEventManager(em).WithLogger(log.NewTestLogger(t))
 			d := NewMessageDispatcher(spec.msgHandler, spec.replyer)
 
 			// run the test
-			gotData, gotErr := d.DispatchSubmessages(ctx, RandomAccountAddress(t), "any_port", spec.msgs)
+			gotData, gotErr := d.DispatchSubmessages(ctx, myContractAddr, "any_port", spec.msgs)
 			if spec.expErr {
 				require.Error(t, gotErr)
 				assert.Empty(t, em.Events())
@@ -448,6 +504,50 @@ func TestDispatchSubmessages(t *testing.T) {
 	}
 }
 
+func TestRedactError(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	specs := map[string]struct {
+		src        error
+		jsonFormat bool
+		exp        string
+	}{
+		"legacy format": {
+			src: errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, "details"),
+			exp: "codespace: sdk, code: 5",
+		},
+		"json format": {
+			src:        errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, "details"),
+			jsonFormat: true,
+			exp:        `{"codespace":"sdk","code":5,"reason":"insufficient funds"}`,
+		},
+		"json format without reason": {
+			src:        errors.New("details"),
+			jsonFormat: true,
+			exp:        `{"codespace":"undefined","code":1}`,
+		},
+		"deterministic error not redacted": {
+			src:        types.MarkErrorDeterministic(errors.New("details")),
+			jsonFormat: true,
+			exp:        "details",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.RedactedErrorJSON = spec.jsonFormat
+			require.NoError(t, k.SetParams(ctx, params))
+
+			// when
+			gotErr := k.redactError(ctx, spec.src)
+
+			// then
+			assert.Equal(t, spec.exp, gotErr.Error())
+		})
+	}
+}
+
 type mockReplyer struct {
 	replyFn func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error)
 }
@@ -458,3 +558,7 @@ func (m mockReplyer) reply(ctx sdk.Context, contractAddress sdk.AccAddress, repl
 	}
 	return m.replyFn(ctx, contractAddress, reply)
 }
+
+func (m mockReplyer) redactError(_ sdk.Context, err error) error {
+	return redactError(err, false)
+}
@@ -28,6 +28,9 @@ func (m msgServer) StoreCode(ctx context.Context, msg *types.MsgStoreCode) (*typ
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
+	if err := m.keeper.GetParams(ctx).ValidateWasmCode(msg.WASMByteCode); err != nil {
+		return nil, errorsmod.Wrap(err, "code bytes")
+	}
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "sender")
@@ -35,7 +38,11 @@ func (m msgServer) StoreCode(ctx context.Context, msg *types.MsgStoreCode) (*typ
 
 	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
 
-	codeID, checksum, err := m.keeper.create(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission, policy)
+	createFn := m.keeper.create
+	if msg.ReuseExisting {
+		createFn = m.keeper.reuseOrCreate
+	}
+	codeID, checksum, err := createFn(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission, policy)
 	if err != nil {
 		return nil, err
 	}
@@ -148,13 +155,14 @@ func (m msgServer) MigrateContract(ctx context.Context, msg *types.MsgMigrateCon
 
 	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
 
-	data, err := m.keeper.migrate(ctx, contractAddr, senderAddr, msg.CodeID, msg.Msg, policy)
+	data, executeHeight, err := m.keeper.migrateOrQueue(ctx, contractAddr, senderAddr, msg.CodeID, msg.Msg, policy)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.MsgMigrateContractResponse{
-		Data: data,
+		Data:          data,
+		ExecuteHeight: executeHeight,
 	}, nil
 }
 
@@ -325,6 +333,9 @@ func (m msgServer) StoreAndInstantiateContract(goCtx context.Context, req *types
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.keeper.GetParams(ctx).ValidateWasmCode(req.WASMByteCode); err != nil {
+		return nil, errorsmod.Wrap(err, "code bytes")
+	}
 	policy := m.selectAuthorizationPolicy(ctx, req.Authority)
 
 	codeID, _, err := m.keeper.create(ctx, authorityAddr, req.WASMByteCode, req.InstantiatePermission, policy)
@@ -368,6 +379,9 @@ func (m msgServer) AddCodeUploadParamsAddresses(goCtx context.Context, req *type
 	}
 
 	params.CodeUploadAccess.Addresses = addresses
+	if err := params.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "params")
+	}
 	if err := m.keeper.SetParams(ctx, params); err != nil {
 		return nil, err
 	}
@@ -431,6 +445,9 @@ func (m msgServer) StoreAndMigrateContract(goCtx context.Context, req *types.Msg
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.keeper.GetParams(ctx).ValidateWasmCode(req.WASMByteCode); err != nil {
+		return nil, errorsmod.Wrap(err, "code bytes")
+	}
 	policy := m.selectAuthorizationPolicy(ctx, req.Authority)
 
 	codeID, checksum, err := m.keeper.create(ctx, authorityAddr, req.WASMByteCode, req.InstantiatePermission, policy)
@@ -477,3 +494,420 @@ func (m msgServer) UpdateContractLabel(ctx context.Context, msg *types.MsgUpdate
 
 	return &types.MsgUpdateContractLabelResponse{}, nil
 }
+
+// DeleteCode removes a code that is not used by any contract anymore.
+func (m msgServer) DeleteCode(ctx context.Context, msg *types.MsgDeleteCode) (*types.MsgDeleteCodeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.deleteCode(ctx, msg.CodeID, senderAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteCodeResponse{}, nil
+}
+
+// FreezeContract rejects any execution of a contract until it is unfrozen.
+func (m msgServer) FreezeContract(ctx context.Context, req *types.MsgFreezeContract) (*types.MsgFreezeContractResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.setContractFrozen(ctx, contractAddr, true); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeContractResponse{}, nil
+}
+
+// UnfreezeContract allows the execution of a frozen contract again.
+func (m msgServer) UnfreezeContract(ctx context.Context, req *types.MsgUnfreezeContract) (*types.MsgUnfreezeContractResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.setContractFrozen(ctx, contractAddr, false); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeContractResponse{}, nil
+}
+
+// RegisterCallback schedules a sudo call into a contract at the end of a block.
+func (m msgServer) RegisterCallback(ctx context.Context, msg *types.MsgRegisterCallback) (*types.MsgRegisterCallbackResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	callbackID, err := m.keeper.registerCallback(ctx, senderAddr, contractAddr, msg.Msg, msg.StartHeight, msg.Interval, msg.GasLimit, msg.Fee, msg.Escrow, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCallbackResponse{CallbackID: callbackID}, nil
+}
+
+// CancelCallback removes a scheduled callback and refunds the remaining escrow.
+func (m msgServer) CancelCallback(ctx context.Context, msg *types.MsgCancelCallback) (*types.MsgCancelCallbackResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	refund, err := m.keeper.cancelCallback(ctx, msg.CallbackID, senderAddr, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelCallbackResponse{Refund: refund}, nil
+}
+
+// ProposeAdmin starts a two-step admin transfer
+func (m msgServer) ProposeAdmin(ctx context.Context, msg *types.MsgProposeAdmin) (*types.MsgProposeAdminResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+	newAdminAddr, err := sdk.AccAddressFromBech32(msg.NewAdmin)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "new admin")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.proposeContractAdmin(ctx, contractAddr, senderAddr, newAdminAddr, msg.ExpiryHeight, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeAdminResponse{}, nil
+}
+
+// AcceptAdmin completes a pending admin transfer
+func (m msgServer) AcceptAdmin(ctx context.Context, msg *types.MsgAcceptAdmin) (*types.MsgAcceptAdminResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.acceptContractAdmin(ctx, contractAddr, senderAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptAdminResponse{}, nil
+}
+
+// CancelAdminProposal removes a pending admin transfer
+func (m msgServer) CancelAdminProposal(ctx context.Context, msg *types.MsgCancelAdminProposal) (*types.MsgCancelAdminProposalResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.cancelAdminProposal(ctx, contractAddr, senderAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAdminProposalResponse{}, nil
+}
+
+// UpdateMigrationDelay sets the migration delay of a contract
+func (m msgServer) UpdateMigrationDelay(ctx context.Context, msg *types.MsgUpdateMigrationDelay) (*types.MsgUpdateMigrationDelayResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.setContractMigrationDelay(ctx, contractAddr, senderAddr, msg.MigrationDelay, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateMigrationDelayResponse{}, nil
+}
+
+// CancelMigration removes a pending migration
+func (m msgServer) CancelMigration(ctx context.Context, msg *types.MsgCancelMigration) (*types.MsgCancelMigrationResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.cancelMigration(ctx, contractAddr, senderAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelMigrationResponse{}, nil
+}
+
+// MigrateContractsByCode migrates a batch of contracts of a code to a new code version
+func (m msgServer) MigrateContractsByCode(ctx context.Context, req *types.MsgMigrateContractsByCode) (*types.MsgMigrateContractsByCodeResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	authorityAddr, err := sdk.AccAddressFromBech32(req.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "authority")
+	}
+	var startAfter sdk.AccAddress
+	if req.StartAfter != "" {
+		if startAfter, err = sdk.AccAddressFromBech32(req.StartAfter); err != nil {
+			return nil, errorsmod.Wrap(err, "start after")
+		}
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, req.Authority)
+
+	results, err := m.keeper.migrateContractsByCode(ctx, req.FromCodeID, req.ToCodeID, req.Msg, req.Limit, startAfter, authorityAddr, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateContractsByCodeResponse{Results: results}, nil
+}
+
+// InstantiateContractByChecksum instantiate a new contract for the code with the given checksum with classic sequence
+// based address generation
+func (m msgServer) InstantiateContractByChecksum(ctx context.Context, msg *types.MsgInstantiateContractByChecksum) (*types.MsgInstantiateContractByChecksumResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	var adminAddr sdk.AccAddress
+	if msg.Admin != "" {
+		if adminAddr, err = sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return nil, errorsmod.Wrap(err, "admin")
+		}
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	codeID, err := m.keeper.codeIDByChecksum(ctx, msg.Checksum, senderAddr, policy)
+	if err != nil {
+		return nil, err
+	}
+	contractAddr, data, err := m.keeper.instantiate(ctx, codeID, senderAddr, adminAddr, msg.Msg, msg.Label, msg.Funds, m.keeper.ClassicAddressGenerator(), policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInstantiateContractByChecksumResponse{
+		Address: contractAddr.String(),
+		Data:    data,
+		CodeID:  codeID,
+	}, nil
+}
+
+// StoreCodeChunk stages a chunk of wasm code that is too large to be uploaded within a single tx
+func (m msgServer) StoreCodeChunk(ctx context.Context, msg *types.MsgStoreCodeChunk) (*types.MsgStoreCodeChunkResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	uploadID, err := m.keeper.storeCodeChunk(ctx, senderAddr, msg.UploadID, msg.Index, msg.Chunk)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgStoreCodeChunkResponse{UploadID: uploadID}, nil
+}
+
+// FinalizeCodeUpload stores the code of an upload session
+func (m msgServer) FinalizeCodeUpload(ctx context.Context, msg *types.MsgFinalizeCodeUpload) (*types.MsgFinalizeCodeUploadResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	codeID, checksum, err := m.keeper.finalizeCodeUpload(ctx, senderAddr, msg.UploadID, msg.Checksum, msg.InstantiatePermission, policy)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgFinalizeCodeUploadResponse{
+		CodeID:   codeID,
+		Checksum: checksum,
+	}, nil
+}
+
+// SponsorContractStorage makes the sender pay the storage deposit of a contract
+func (m msgServer) SponsorContractStorage(ctx context.Context, msg *types.MsgSponsorContractStorage) (*types.MsgSponsorContractStorageResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.sponsorContractStorage(ctx, contractAddr, senderAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSponsorContractStorageResponse{}, nil
+}
+
+// ClearContractStorageSponsor refunds the storage deposit to the sponsor of a contract
+func (m msgServer) ClearContractStorageSponsor(ctx context.Context, msg *types.MsgClearContractStorageSponsor) (*types.MsgClearContractStorageSponsorResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.clearContractStorageSponsor(ctx, contractAddr, senderAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClearContractStorageSponsorResponse{}, nil
+}
+
+// UpdateStateQuota sets the state size quota of a contract
+func (m msgServer) UpdateStateQuota(ctx context.Context, msg *types.MsgUpdateStateQuota) (*types.MsgUpdateStateQuotaResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.setContractStateQuota(ctx, contractAddr, senderAddr, msg.MaxStateSize, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateStateQuotaResponse{}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -131,6 +132,39 @@ func WithVMCacheMetrics(r prometheus.Registerer) Option {
 	})
 }
 
+// WithSmartQueryCacheMetrics registers the hit and miss counters of the smart query cache when it is enabled
+func WithSmartQueryCacheMetrics(r prometheus.Registerer) Option {
+	return postOptsFn(func(k *Keeper) {
+		if k.queryCache != nil {
+			k.queryCache.Register(r)
+		}
+	})
+}
+
+// WithWasmEngineMetrics decorates the wasm engine to record execution metrics of contract calls.
+// Only the codes in the allowlist get their own code id and checksum labels to bound the label cardinality.
+func WithWasmEngineMetrics(r prometheus.Registerer, allowedCodeIDs ...uint64) Option {
+	return WithWasmEngineDecorator(func(old types.WasmEngine) types.WasmEngine {
+		m := NewWasmEngineMetrics(allowedCodeIDs)
+		m.Register(r)
+		return NewInstrumentedWasmEngine(old, m)
+	})
+}
+
+// WithTracerProvider enables OpenTelemetry spans for the contract calls in the keeper, the calls into the wasm engine,
+// the messages dispatched and the query plugin calls.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	if tp == nil {
+		panic("must not be nil")
+	}
+	return postOptsFn(func(k *Keeper) {
+		k.tracer = tp.Tracer(tracerName)
+		k.wasmVM = NewInstrumentedWasmEngine(k.wasmVM, wasmVMSpans{tracer: k.tracer})
+		k.messenger = tracingMessenger{Messenger: k.messenger, tracer: k.tracer}
+		k.wasmVMQueryHandler = tracingQueryHandler{WasmVMQueryHandler: k.wasmVMQueryHandler, tracer: k.tracer}
+	})
+}
+
 // WithGasRegister set a new gas register to implement custom gas costs.
 // When the "gas multiplier" for wasmvm gas conversion is modified inside the new register,
 // make sure to also use `WithApiCosts` option for non default values
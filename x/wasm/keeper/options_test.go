@@ -56,6 +56,13 @@ func TestConstructorOptions(t *testing.T) {
 			},
 			isPostOpt: true,
 		},
+		"wasm engine metrics": {
+			srcOpt: WithWasmEngineMetrics(prometheus.NewRegistry(), 1),
+			verify: func(t *testing.T, k Keeper) {
+				assert.IsType(t, &InstrumentedWasmEngine{}, k.wasmVM)
+			},
+			isPostOpt: true,
+		},
 		"message handler": {
 			srcOpt: WithMessageHandler(&wasmtesting.MockMessageHandler{}),
 			verify: func(t *testing.T, k Keeper) {
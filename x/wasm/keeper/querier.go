@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/hex"
@@ -35,6 +36,8 @@ type GrpcQuerier struct {
 	storeService  corestoretypes.KVStoreService
 	keeper        types.ViewKeeper
 	queryGasLimit storetypes.Gas
+	// queryCache caches the results of the smart gRPC queries. Nil when disabled.
+	queryCache *smartQueryCache
 }
 
 // NewGrpcQuerier constructor
@@ -189,6 +192,61 @@ func (q GrpcQuerier) RawContractState(c context.Context, req *types.QueryRawCont
 	return &types.QueryRawContractStateResponse{Data: rsp}, nil
 }
 
+// RawContractStateRange lists the raw store data of a contract for a range of keys. The range is narrowed to the
+// keys with the prefix when set.
+func (q GrpcQuerier) RawContractStateRange(c context.Context, req *types.QueryRawContractStateRangeRequest) (*types.QueryRawContractStateRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
+			Wrapf("address %s", contractAddr.String())
+	}
+
+	start, end := req.Start, req.End
+	if len(end) == 0 {
+		end = nil
+	}
+	if len(req.Prefix) != 0 {
+		if bytes.Compare(start, req.Prefix) < 0 {
+			start = req.Prefix
+		}
+		if prefixEnd := storetypes.PrefixEndBytes(req.Prefix); prefixEnd != nil && (end == nil || bytes.Compare(end, prefixEnd) > 0) {
+			end = prefixEnd
+		}
+	}
+	// continue after the last page, the next key is the first key of the new page
+	if len(paginationParams.Key) != 0 {
+		if paginationParams.Reverse {
+			end = append(bytes.Clone(paginationParams.Key), 0)
+		} else {
+			start = paginationParams.Key
+		}
+	}
+	r := make([]types.Model, 0)
+	if end != nil && bytes.Compare(start, end) >= 0 {
+		return &types.QueryRawContractStateRangeResponse{Models: r, Pagination: &query.PageResponse{}}, nil
+	}
+	entries, nextKey := q.keeper.QueryRawRange(ctx, contractAddr, start, end, uint16(paginationParams.Limit), paginationParams.Reverse)
+	for _, e := range entries {
+		r = append(r, types.Model{Key: e.Key, Value: e.Value})
+	}
+	return &types.QueryRawContractStateRangeResponse{
+		Models:     r,
+		Pagination: &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
 func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmartContractStateRequest) (rsp *types.QuerySmartContractStateResponse, err error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -208,24 +266,24 @@ func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 	// recover from out-of-gas panic
 	defer func() {
 		if r := recover(); r != nil {
-			switch rType := r.(type) {
-			case storetypes.ErrorOutOfGas:
-				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas,
-					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
-					rType.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
-				)
-			default:
-				err = sdkerrors.ErrPanic
-			}
+			err = smartQueryPanicError(ctx, req.Address, r)
 			rsp = nil
-			moduleLogger(ctx).
-				Debug("smart query contract",
-					"error", "recovering panic",
-					"contract-address", req.Address,
-					"stacktrace", string(debug.Stack()))
 		}
 	}()
 
+	// only the queries on committed state are cached. Queries from contracts or other modules in
+	// block execution, CheckTx or simulations run on uncommitted state.
+	_, fromContract := types.CodeIDFromContext(ctx)
+	cache := q.queryCache
+	if fromContract || !isCommittedStateQuery(ctx) {
+		cache = nil
+	}
+	if cache != nil {
+		if bz, ok := cache.get(ctx.BlockHeight(), contractAddr, req.QueryData); ok {
+			return &types.QuerySmartContractStateResponse{Data: bz}, nil
+		}
+	}
+
 	bz, err := q.keeper.QuerySmart(ctx, contractAddr, req.QueryData)
 	switch {
 	case err != nil:
@@ -234,9 +292,74 @@ func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
 			Wrapf("address %s", contractAddr.String())
 	}
+	if cache != nil {
+		cache.add(ctx.BlockHeight(), contractAddr, req.QueryData, bz)
+	}
 	return &types.QuerySmartContractStateResponse{Data: bz}, nil
 }
 
+// SmartContractStateBatch runs the smart queries in order. They share the gas limit of a single smart query,
+// so that the queries after an out of gas error fail as well. Each result contains the data or the error.
+func (q GrpcQuerier) SmartContractStateBatch(c context.Context, req *types.QuerySmartContractStateBatchRequest) (*types.QuerySmartContractStateBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Queries) > maxResultEntries {
+		return nil, status.Errorf(codes.InvalidArgument, "max %d queries", maxResultEntries)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	gasLimit := min(ctx.GasMeter().GasRemaining(), q.queryGasLimit)
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	results := make([]types.SmartContractStateResult, len(req.Queries))
+	for i, sq := range req.Queries {
+		bz, err := q.batchSmartQuery(ctx, sq)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Data = bz
+	}
+	return &types.QuerySmartContractStateBatchResponse{Results: results}, nil
+}
+
+func (q GrpcQuerier) batchSmartQuery(ctx sdk.Context, sq types.SmartContractStateQuery) (bz []byte, err error) {
+	if err := sq.QueryData.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid query data")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(sq.Address)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			bz, err = nil, smartQueryPanicError(ctx, sq.Address, r)
+		}
+	}()
+	bz, err = q.keeper.QuerySmart(ctx, contractAddr, sq.QueryData)
+	if err == nil && bz == nil {
+		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
+			Wrapf("address %s", contractAddr.String())
+	}
+	return bz, err
+}
+
+// smartQueryPanicError returns the error for a recovered panic of a smart query
+func smartQueryPanicError(ctx sdk.Context, contractAddr string, r any) error {
+	moduleLogger(ctx).
+		Debug("smart query contract",
+			"error", "recovering panic",
+			"contract-address", contractAddr,
+			"stacktrace", string(debug.Stack()))
+	if rType, ok := r.(storetypes.ErrorOutOfGas); ok {
+		return errorsmod.Wrapf(sdkerrors.ErrOutOfGas,
+			"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+			rType.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+		)
+	}
+	return sdkerrors.ErrPanic
+}
+
 func (q GrpcQuerier) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -266,17 +389,44 @@ func (q GrpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 		return nil, err
 	}
 
+	var creatorAddr sdk.AccAddress
+	if req.Creator != "" {
+		if creatorAddr, err = sdk.AccAddressFromBech32(req.Creator); err != nil {
+			return nil, errorsmod.Wrap(err, "creator")
+		}
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	r := make([]types.CodeInfoResponse, 0)
-	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.CodeKeyPrefix)
+	// iterate the creator or checksum index when set, the other filters are applied to the code infos
+	store := runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx))
+	var prefixStore prefix.Store
+	switch {
+	case len(creatorAddr) != 0:
+		prefixStore = prefix.NewStore(store, types.GetCodesByCreatorPrefix(creatorAddr))
+	case len(req.Checksum) != 0:
+		prefixStore = prefix.NewStore(store, types.GetCodesByChecksumPrefix(req.Checksum))
+	default:
+		prefixStore = prefix.NewStore(store, types.CodeKeyPrefix)
+	}
 	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, value []byte, accumulate bool) (bool, error) {
-		if accumulate {
-			var c types.CodeInfo
-			if err := q.cdc.Unmarshal(value, &c); err != nil {
-				return false, err
+		codeID := binary.BigEndian.Uint64(key)
+		var c types.CodeInfo
+		if len(creatorAddr) != 0 || len(req.Checksum) != 0 {
+			info := q.keeper.GetCodeInfo(ctx, codeID)
+			if info == nil {
+				return false, nil
 			}
+			c = *info
+		} else if err := q.cdc.Unmarshal(value, &c); err != nil {
+			return false, err
+		}
+		if !q.matchesCodesFilter(ctx, req, codeID, c) {
+			return false, nil
+		}
+		if accumulate {
 			r = append(r, types.CodeInfoResponse{
-				CodeID:                binary.BigEndian.Uint64(key),
+				CodeID:                codeID,
 				Creator:               c.Creator,
 				DataHash:              c.CodeHash,
 				InstantiatePermission: c.InstantiateConfig,
@@ -290,6 +440,24 @@ func (q GrpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 	return &types.QueryCodesResponse{CodeInfos: r, Pagination: pageRes}, nil
 }
 
+// matchesCodesFilter returns true when the code matches the checksum, access type and pin status filters of the
+// request that are set. The creator filter is applied by iterating the creator index.
+func (q GrpcQuerier) matchesCodesFilter(ctx sdk.Context, req *types.QueryCodesRequest, codeID uint64, c types.CodeInfo) bool {
+	if len(req.Checksum) != 0 && !bytes.Equal(c.CodeHash, req.Checksum) {
+		return false
+	}
+	if req.InstantiateAccessType != types.AccessTypeUnspecified && c.InstantiateConfig.Permission != req.InstantiateAccessType {
+		return false
+	}
+	switch req.PinStatus {
+	case types.PinStatusPinned:
+		return q.keeper.IsPinnedCode(ctx, codeID)
+	case types.PinStatusUnpinned:
+		return !q.keeper.IsPinnedCode(ctx, codeID)
+	}
+	return true
+}
+
 func (q GrpcQuerier) CodeInfo(c context.Context, req *types.QueryCodeInfoRequest) (*types.QueryCodeInfoResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -422,6 +590,203 @@ func (q GrpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContr
 	}, nil
 }
 
+// ContractsByAdmin lists the contracts that can be migrated or updated by the admin in order of creation time asc
+func (q GrpcQuerier) ContractsByAdmin(c context.Context, req *types.QueryContractsByAdminRequest) (*types.QueryContractsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]string, 0)
+
+	adminAddress, err := sdk.AccAddressFromBech32(req.AdminAddress)
+	if err != nil {
+		return nil, err
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetContractsByAdminPrefix(adminAddress))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			accAddress := sdk.AccAddress(key[types.AbsoluteTxPositionLen:])
+			contracts = append(contracts, accAddress.String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByAdminResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// ContractsByLabel lists the contracts with the exact label or with a label that starts with the label prefix,
+// ordered by label
+func (q GrpcQuerier) ContractsByLabel(c context.Context, req *types.QueryContractsByLabelRequest) (*types.QueryContractsByLabelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Label == "" && !req.Prefix {
+		return nil, errorsmod.Wrap(types.ErrEmpty, "label")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]types.LabeledContract, 0)
+	prefixKey := types.GetContractsByExactLabelPrefix(req.Label)
+	if req.Prefix {
+		prefixKey = types.GetContractsByLabelPrefix(req.Label)
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), prefixKey)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			label := req.Label
+			if req.Prefix {
+				// the key is the label suffix with the separator and the contract address
+				label += string(key[:len(key)-len(value)-1])
+			}
+			contracts = append(contracts, types.LabeledContract{
+				Address: sdk.AccAddress(value).String(),
+				Label:   label,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByLabelResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ContractCallbacks lists the scheduled callbacks of a contract
+func (q GrpcQuerier) ContractCallbacks(c context.Context, req *types.QueryContractCallbacksRequest) (*types.QueryContractCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	callbacks := make([]types.ContractCallback, 0)
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetCallbacksByContractPrefix(contractAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			callback := q.keeper.GetCallback(ctx, sdk.BigEndianToUint64(key))
+			if callback == nil {
+				return false, types.ErrNotFound.Wrapf("callback %d", sdk.BigEndianToUint64(key))
+			}
+			callbacks = append(callbacks, *callback)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractCallbacksResponse{
+		Callbacks:  callbacks,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Callback returns a scheduled callback by id
+func (q GrpcQuerier) Callback(c context.Context, req *types.QueryCallbackRequest) (*types.QueryCallbackResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.CallbackId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "callback id")
+	}
+	callback := q.keeper.GetCallback(sdk.UnwrapSDKContext(c), req.CallbackId)
+	if callback == nil {
+		return nil, types.ErrNotFound.Wrapf("callback %d", req.CallbackId)
+	}
+	return &types.QueryCallbackResponse{Callback: *callback}, nil
+}
+
+// PendingAdminTransfer returns the pending two-step admin transfer of a contract
+func (q GrpcQuerier) PendingAdminTransfer(c context.Context, req *types.QueryPendingAdminTransferRequest) (*types.QueryPendingAdminTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	transfer := q.keeper.GetPendingAdminTransfer(sdk.UnwrapSDKContext(c), contractAddr)
+	if transfer == nil {
+		return nil, types.ErrNotFound.Wrapf("pending admin transfer for %s", req.Address)
+	}
+	return &types.QueryPendingAdminTransferResponse{PendingAdminTransfer: *transfer}, nil
+}
+
+// PendingAdminTransfers returns all pending two-step admin transfers
+func (q GrpcQuerier) PendingAdminTransfers(c context.Context, req *types.QueryPendingAdminTransfersRequest) (*types.QueryPendingAdminTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	transfers := make([]types.PendingAdminTransfer, 0)
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.PendingAdminTransferPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(_, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var transfer types.PendingAdminTransfer
+			if err := q.cdc.Unmarshal(value, &transfer); err != nil {
+				return false, err
+			}
+			transfers = append(transfers, transfer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingAdminTransfersResponse{
+		PendingAdminTransfers: transfers,
+		Pagination:            pageRes,
+	}, nil
+}
+
+// PendingMigration returns the queued migration of a contract
+func (q GrpcQuerier) PendingMigration(c context.Context, req *types.QueryPendingMigrationRequest) (*types.QueryPendingMigrationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	migration := q.keeper.GetPendingMigration(sdk.UnwrapSDKContext(c), contractAddr)
+	if migration == nil {
+		return nil, types.ErrNotFound.Wrapf("pending migration for %s", req.Address)
+	}
+	return &types.QueryPendingMigrationResponse{PendingMigration: *migration}, nil
+}
+
 // max limit to pagination queries
 const maxResultEntries = 100
 
@@ -494,3 +859,68 @@ func BuildAddressPredictable(req *types.QueryBuildAddressRequest) (*types.QueryB
 		Address: BuildContractAddressPredictable(codeHash, creator, salt, initMsg).String(),
 	}, nil
 }
+
+// CodesByChecksum returns the ids of all codes that reference the given checksum
+func (q GrpcQuerier) CodesByChecksum(c context.Context, req *types.QueryCodesByChecksumRequest) (*types.QueryCodesByChecksumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateChecksum(req.Checksum); err != nil {
+		return nil, errorsmod.Wrap(err, "checksum")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]uint64, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetCodesByChecksumPrefix(req.Checksum))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			r = append(r, sdk.BigEndianToUint64(key))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCodesByChecksumResponse{
+		CodeIDs:    r,
+		Pagination: pageRes,
+	}, nil
+}
+
+// WasmGasRegister returns the costs of the gas register that is used to charge contract executions
+func (q GrpcQuerier) WasmGasRegister(c context.Context, req *types.QueryWasmGasRegisterRequest) (*types.QueryWasmGasRegisterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	gr, ok := q.keeper.GetEffectiveGasRegister(c).(interface {
+		Config() types.WasmGasRegisterConfig
+	})
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "gas register does not expose its costs")
+	}
+	return &types.QueryWasmGasRegisterResponse{
+		GasRegister: types.NewGasRegisterParams(gr.Config()),
+	}, nil
+}
+
+// ContractStorageUsage returns the size of the contract state and the deposit held for it
+func (q GrpcQuerier) ContractStorageUsage(c context.Context, req *types.QueryContractStorageUsageRequest) (*types.QueryContractStorageUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
+			Wrapf("address %s", contractAddr.String())
+	}
+	return &types.QueryContractStorageUsageResponse{StorageUsage: q.keeper.GetContractStorageUsage(ctx, contractAddr)}, nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -218,6 +219,104 @@ func TestQuerySmartContractPanics(t *testing.T) {
 	}
 }
 
+func TestQuerySmartContractStateBatch(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	contractAddr := BuildContractAddressClassic(1, 1)
+	keepers.WasmKeeper.mustStoreCodeInfo(ctx, 1, types.CodeInfo{})
+	keepers.WasmKeeper.mustStoreContractInfo(ctx, contractAddr, &types.ContractInfo{
+		CodeID:  1,
+		Created: types.NewAbsoluteTxPosition(ctx),
+	})
+	keepers.WasmKeeper.wasmVM = &wasmtesting.MockWasmEngine{QueryFn: func(checksum wasmvm.Checksum, env wasmvmtypes.Env, queryMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+		switch string(queryMsg) {
+		case `{"burn_gas":{}}`:
+			return &wasmvmtypes.QueryResult{Ok: []byte(`{}`)}, gasLimit, nil
+		case `{"fail":{}}`:
+			return &wasmvmtypes.QueryResult{Err: "testing"}, 1, nil
+		}
+		return &wasmvmtypes.QueryResult{Ok: queryMsg}, 1, nil
+	}}
+	randomAddr := RandomBech32AccountAddress(t)
+	outOfGasErr := func(t *testing.T, got string) {
+		assert.Contains(t, got, sdkErrors.ErrOutOfGas.Error())
+	}
+
+	specs := map[string]struct {
+		queries    []types.SmartContractStateQuery
+		expResults []types.SmartContractStateResult
+		expErrs    []func(t *testing.T, got string)
+		expErr     bool
+	}{
+		"all succeed": {
+			queries: []types.SmartContractStateQuery{
+				{Address: contractAddr.String(), QueryData: []byte(`{"first":{}}`)},
+				{Address: contractAddr.String(), QueryData: []byte(`{"second":{}}`)},
+			},
+			expResults: []types.SmartContractStateResult{
+				{Data: []byte(`{"first":{}}`)},
+				{Data: []byte(`{"second":{}}`)},
+			},
+		},
+		"errors per query": {
+			queries: []types.SmartContractStateQuery{
+				{Address: contractAddr.String(), QueryData: []byte(`{"fail":{}}`)},
+				{Address: randomAddr, QueryData: []byte(`{"first":{}}`)},
+				{Address: contractAddr.String(), QueryData: []byte(`not json`)},
+				{Address: contractAddr.String(), QueryData: []byte(`{"second":{}}`)},
+			},
+			expResults: []types.SmartContractStateResult{
+				{Error: errorsmod.Wrap(types.ErrQueryFailed, "testing").Error()},
+				{Error: types.ErrNoSuchContractFn(randomAddr).Wrapf("address %s", randomAddr).Error()},
+				{Error: status.Error(codes.InvalidArgument, "invalid query data").Error()},
+				{Data: []byte(`{"second":{}}`)},
+			},
+		},
+		"shared gas limit": {
+			queries: []types.SmartContractStateQuery{
+				{Address: contractAddr.String(), QueryData: []byte(`{"first":{}}`)},
+				{Address: contractAddr.String(), QueryData: []byte(`{"burn_gas":{}}`)},
+				{Address: contractAddr.String(), QueryData: []byte(`{"second":{}}`)},
+			},
+			expResults: []types.SmartContractStateResult{
+				{Data: []byte(`{"first":{}}`)},
+				{},
+				{},
+			},
+			expErrs: []func(t *testing.T, got string){nil, outOfGasErr, outOfGasErr},
+		},
+		"empty": {
+			expResults: []types.SmartContractStateResult{},
+		},
+		"too many queries": {
+			queries: make([]types.SmartContractStateQuery, maxResultEntries+1),
+			expErr:  true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx = ctx.WithLogger(log.NewTestLogger(t))
+			q := Querier(keepers.WasmKeeper)
+
+			// when
+			got, err := q.SmartContractStateBatch(ctx, &types.QuerySmartContractStateBatchRequest{Queries: spec.queries})
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			for i, expErr := range spec.expErrs {
+				if expErr != nil {
+					expErr(t, got.Results[i].Error)
+					got.Results[i].Error = ""
+				}
+			}
+			assert.Equal(t, spec.expResults, got.Results)
+		})
+	}
+}
+
 func TestQueryRawContractState(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -275,6 +374,101 @@ func TestQueryRawContractState(t *testing.T) {
 	}
 }
 
+func TestQueryRawContractStateRange(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+	contractModel := []types.Model{
+		{Key: []byte("map/a"), Value: []byte(`1`)},
+		{Key: []byte("map/b"), Value: []byte(`2`)},
+		{Key: []byte("map/c"), Value: []byte(`3`)},
+		{Key: []byte("other"), Value: []byte(`4`)},
+	}
+	require.NoError(t, keeper.importContractState(ctx, exampleContract.Contract, contractModel))
+
+	randomAddr := RandomBech32AccountAddress(t)
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery   *types.QueryRawContractStateRangeRequest
+		expKeys    []string
+		expNextKey []byte
+		expErr     error
+	}{
+		"all": {
+			srcQuery: &types.QueryRawContractStateRangeRequest{Address: contractAddr},
+			expKeys:  []string{"config", "map/a", "map/b", "map/c", "other"},
+		},
+		"prefix": {
+			srcQuery: &types.QueryRawContractStateRangeRequest{Address: contractAddr, Prefix: []byte("map/")},
+			expKeys:  []string{"map/a", "map/b", "map/c"},
+		},
+		"prefix with start and end": {
+			srcQuery: &types.QueryRawContractStateRangeRequest{Address: contractAddr, Prefix: []byte("map/"), Start: []byte("map/b"), End: []byte("z")},
+			expKeys:  []string{"map/b", "map/c"},
+		},
+		"start and end": {
+			srcQuery: &types.QueryRawContractStateRangeRequest{Address: contractAddr, Start: []byte("map/b"), End: []byte("other")},
+			expKeys:  []string{"map/b", "map/c"},
+		},
+		"empty range": {
+			srcQuery: &types.QueryRawContractStateRangeRequest{Address: contractAddr, Start: []byte("other"), End: []byte("map/")},
+			expKeys:  []string{},
+		},
+		"reverse": {
+			srcQuery: &types.QueryRawContractStateRangeRequest{Address: contractAddr, Prefix: []byte("map/"), Pagination: &query.PageRequest{Reverse: true}},
+			expKeys:  []string{"map/c", "map/b", "map/a"},
+		},
+		"with pagination limit": {
+			srcQuery:   &types.QueryRawContractStateRangeRequest{Address: contractAddr, Prefix: []byte("map/"), Pagination: &query.PageRequest{Limit: 2}},
+			expKeys:    []string{"map/a", "map/b"},
+			expNextKey: []byte("map/c"),
+		},
+		"with pagination next key": {
+			srcQuery: &types.QueryRawContractStateRangeRequest{Address: contractAddr, Prefix: []byte("map/"), Pagination: &query.PageRequest{Key: []byte("map/c")}},
+			expKeys:  []string{"map/c"},
+		},
+		"reverse with pagination limit": {
+			srcQuery:   &types.QueryRawContractStateRangeRequest{Address: contractAddr, Prefix: []byte("map/"), Pagination: &query.PageRequest{Limit: 2, Reverse: true}},
+			expKeys:    []string{"map/c", "map/b"},
+			expNextKey: []byte("map/a"),
+		},
+		"reverse with pagination next key": {
+			srcQuery: &types.QueryRawContractStateRangeRequest{Address: contractAddr, Prefix: []byte("map/"), Pagination: &query.PageRequest{Key: []byte("map/b"), Reverse: true}},
+			expKeys:  []string{"map/b", "map/a"},
+		},
+		"with pagination offset": {
+			srcQuery: &types.QueryRawContractStateRangeRequest{Address: contractAddr, Pagination: &query.PageRequest{Offset: 1}},
+			expErr:   errLegacyPaginationUnsupported,
+		},
+		"unknown address": {
+			srcQuery: &types.QueryRawContractStateRangeRequest{Address: randomAddr},
+			expErr:   types.ErrNoSuchContractFn(randomAddr).Wrapf("address %s", randomAddr),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			// when
+			got, err := q.RawContractStateRange(ctx, spec.srcQuery)
+
+			// then
+			if spec.expErr != nil {
+				assert.Equal(t, spec.expErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			gotKeys := make([]string, len(got.Models))
+			for i, m := range got.Models {
+				gotKeys[i] = string(m.Key)
+			}
+			assert.Equal(t, spec.expKeys, gotKeys)
+			assert.Equal(t, spec.expNextKey, got.Pagination.NextKey)
+		})
+	}
+}
+
 func TestQueryContractsByCode(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -623,6 +817,87 @@ func TestQueryCodeList(t *testing.T) {
 	}
 }
 
+func TestQueryCodeListFilters(t *testing.T) {
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example1 := StoreHackatomExampleContract(t, ctx, keepers)
+	example2 := StoreBurnerExampleContract(t, ctx, keepers)
+	codeID3, _, err := keepers.ContractKeeper.Create(ctx, example1.CreatorAddr, wasmCode, &types.AllowNobody)
+	require.NoError(t, err)
+	require.NoError(t, keepers.ContractKeeper.PinCode(ctx, example2.CodeID))
+
+	specs := map[string]struct {
+		req        types.QueryCodesRequest
+		expCodeIDs []uint64
+		expErr     bool
+	}{
+		"by creator": {
+			req:        types.QueryCodesRequest{Creator: example1.CreatorAddr.String()},
+			expCodeIDs: []uint64{example1.CodeID, codeID3},
+		},
+		"by other creator": {
+			req:        types.QueryCodesRequest{Creator: example2.CreatorAddr.String()},
+			expCodeIDs: []uint64{example2.CodeID},
+		},
+		"by checksum": {
+			req:        types.QueryCodesRequest{Checksum: example1.Checksum},
+			expCodeIDs: []uint64{example1.CodeID, codeID3},
+		},
+		"by access type": {
+			req:        types.QueryCodesRequest{InstantiateAccessType: types.AccessTypeEverybody},
+			expCodeIDs: []uint64{example1.CodeID, example2.CodeID},
+		},
+		"by creator and access type": {
+			req:        types.QueryCodesRequest{Creator: example1.CreatorAddr.String(), InstantiateAccessType: types.AccessTypeNobody},
+			expCodeIDs: []uint64{codeID3},
+		},
+		"pinned": {
+			req:        types.QueryCodesRequest{PinStatus: types.PinStatusPinned},
+			expCodeIDs: []uint64{example2.CodeID},
+		},
+		"unpinned": {
+			req:        types.QueryCodesRequest{PinStatus: types.PinStatusUnpinned},
+			expCodeIDs: []uint64{example1.CodeID, codeID3},
+		},
+		"by checksum and pinned": {
+			req:        types.QueryCodesRequest{Checksum: example1.Checksum, PinStatus: types.PinStatusPinned},
+			expCodeIDs: []uint64{},
+		},
+		"by creator with pagination limit": {
+			req: types.QueryCodesRequest{
+				Creator:    example1.CreatorAddr.String(),
+				Pagination: &query.PageRequest{Limit: 1},
+			},
+			expCodeIDs: []uint64{example1.CodeID},
+		},
+		"invalid creator": {
+			req:    types.QueryCodesRequest{Creator: "invalid"},
+			expErr: true,
+		},
+	}
+	q := Querier(keepers.WasmKeeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			// when
+			got, gotErr := q.Codes(ctx, &spec.req) //nolint:gosec
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			gotCodeIDs := make([]uint64, len(got.CodeInfos))
+			for i, c := range got.CodeInfos {
+				gotCodeIDs[i] = c.CodeID
+			}
+			assert.Equal(t, spec.expCodeIDs, gotCodeIDs)
+		})
+	}
+}
+
 func TestQueryContractInfo(t *testing.T) {
 	var (
 		contractAddr = RandomAccountAddress(t)
@@ -1098,6 +1373,184 @@ func TestQueryContractsByCreatorList(t *testing.T) {
 	}
 }
 
+func TestQueryContractsByLabel(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+
+	contracts := make(map[string]sdk.AccAddress)
+	for _, label := range []string{"beta", "alpha", "alpha 2", "old label"} {
+		contract, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, example.CreatorAddr, initMsgBz, label, nil)
+		require.NoError(t, err)
+		contracts[label] = contract
+	}
+	require.NoError(t, keepers.WasmKeeper.setContractLabel(ctx, contracts["old label"], example.CreatorAddr, "alpha 3", DefaultAuthorizationPolicy{}))
+	labeled := func(label, contractLabel string) types.LabeledContract {
+		return types.LabeledContract{Address: contracts[contractLabel].String(), Label: label}
+	}
+
+	specs := map[string]struct {
+		srcQuery     *types.QueryContractsByLabelRequest
+		expContracts []types.LabeledContract
+		expErr       error
+	}{
+		"exact label": {
+			srcQuery:     &types.QueryContractsByLabelRequest{Label: "alpha"},
+			expContracts: []types.LabeledContract{labeled("alpha", "alpha")},
+		},
+		"exact updated label": {
+			srcQuery:     &types.QueryContractsByLabelRequest{Label: "alpha 3"},
+			expContracts: []types.LabeledContract{labeled("alpha 3", "old label")},
+		},
+		"exact previous label": {
+			srcQuery:     &types.QueryContractsByLabelRequest{Label: "old label"},
+			expContracts: []types.LabeledContract{},
+		},
+		"exact label no prefix match": {
+			srcQuery:     &types.QueryContractsByLabelRequest{Label: "alp"},
+			expContracts: []types.LabeledContract{},
+		},
+		"label prefix": {
+			srcQuery: &types.QueryContractsByLabelRequest{Label: "alpha", Prefix: true},
+			expContracts: []types.LabeledContract{
+				labeled("alpha", "alpha"),
+				labeled("alpha 2", "alpha 2"),
+				labeled("alpha 3", "old label"),
+			},
+		},
+		"label prefix with pagination limit": {
+			srcQuery: &types.QueryContractsByLabelRequest{
+				Label:      "alpha",
+				Prefix:     true,
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			expContracts: []types.LabeledContract{labeled("alpha", "alpha"), labeled("alpha 2", "alpha 2")},
+		},
+		"empty prefix": {
+			srcQuery: &types.QueryContractsByLabelRequest{Prefix: true},
+			expContracts: []types.LabeledContract{
+				labeled("alpha", "alpha"),
+				labeled("alpha 2", "alpha 2"),
+				labeled("alpha 3", "old label"),
+				labeled("beta", "beta"),
+			},
+		},
+		"empty label": {
+			srcQuery: &types.QueryContractsByLabelRequest{},
+			expErr:   types.ErrEmpty,
+		},
+		"with pagination offset": {
+			srcQuery: &types.QueryContractsByLabelRequest{
+				Label:      "alpha",
+				Pagination: &query.PageRequest{Offset: 1},
+			},
+			expErr: errLegacyPaginationUnsupported,
+		},
+		"nil req": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	q := Querier(keepers.WasmKeeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, gotErr := q.ContractsByLabel(ctx, spec.srcQuery)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.NotNil(t, got)
+			assert.Equal(t, spec.expContracts, got.Contracts)
+		})
+	}
+}
+
+func TestQueryContractsByAdminList(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	alice, bob, carol := RandomAccountAddress(t), RandomAccountAddress(t), RandomAccountAddress(t)
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+
+	var contracts []sdk.AccAddress
+	for i, admin := range []sdk.AccAddress{alice, alice, alice, bob, nil} {
+		ctx = ctx.WithBlockHeight(int64(10 + i))
+		contract, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, admin, initMsgBz, fmt.Sprintf("contract %d", i), nil)
+		require.NoError(t, err)
+		contracts = append(contracts, contract)
+	}
+	// move contract 1 to bob, transfer contract 2 to carol in two steps and clear the admin of contract 3
+	require.NoError(t, keepers.ContractKeeper.UpdateContractAdmin(ctx, contracts[1], alice, bob))
+	require.NoError(t, keepers.ContractKeeper.ProposeContractAdmin(ctx, contracts[2], alice, carol, 0))
+	require.NoError(t, keepers.ContractKeeper.AcceptContractAdmin(ctx, contracts[2], carol))
+	require.NoError(t, keepers.ContractKeeper.ClearContractAdmin(ctx, contracts[3], bob))
+
+	specs := map[string]struct {
+		srcQuery        *types.QueryContractsByAdminRequest
+		expContractAddr []string
+		expErr          error
+	}{
+		"query alice": {
+			srcQuery:        &types.QueryContractsByAdminRequest{AdminAddress: alice.String()},
+			expContractAddr: []string{contracts[0].String()},
+		},
+		"query bob": {
+			srcQuery:        &types.QueryContractsByAdminRequest{AdminAddress: bob.String()},
+			expContractAddr: []string{contracts[1].String()},
+		},
+		"query carol": {
+			srcQuery:        &types.QueryContractsByAdminRequest{AdminAddress: carol.String()},
+			expContractAddr: []string{contracts[2].String()},
+		},
+		"query unknown admin": {
+			srcQuery:        &types.QueryContractsByAdminRequest{AdminAddress: creator.String()},
+			expContractAddr: []string{},
+		},
+		"with pagination offset": {
+			srcQuery: &types.QueryContractsByAdminRequest{
+				AdminAddress: alice.String(),
+				Pagination:   &query.PageRequest{Offset: 1},
+			},
+			expErr: errLegacyPaginationUnsupported,
+		},
+		"nil admin": {
+			srcQuery: &types.QueryContractsByAdminRequest{},
+			expErr:   errors.New("empty address string is not allowed"),
+		},
+		"nil req": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	q := Querier(keepers.WasmKeeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, gotErr := q.ContractsByAdmin(ctx, spec.srcQuery)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.NotNil(t, got)
+			assert.Equal(t, spec.expContractAddr, got.ContractAddresses)
+		})
+	}
+}
+
 func fromBase64(s string) []byte {
 	r, err := base64.StdEncoding.DecodeString(s)
 	if err != nil {
@@ -1249,3 +1702,291 @@ func TestQueryBuildAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryPendingAdminTransfer(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	querier := NewGrpcQuerier(k.cdc, k.storeService, k, k.queryGasLimit)
+
+	contractAddr := RandomAccountAddress(t)
+	stored := types.PendingAdminTransfer{
+		Contract:      contractAddr.String(),
+		ProposedAdmin: RandomBech32AccountAddress(t),
+		ExpiryHeight:  100,
+	}
+	require.NoError(t, k.storePendingAdminTransfer(ctx, contractAddr, stored))
+
+	specs := map[string]struct {
+		src    *types.QueryPendingAdminTransferRequest
+		expRsp *types.QueryPendingAdminTransferResponse
+		expErr bool
+	}{
+		"found": {
+			src:    &types.QueryPendingAdminTransferRequest{Address: contractAddr.String()},
+			expRsp: &types.QueryPendingAdminTransferResponse{PendingAdminTransfer: stored},
+		},
+		"not found": {
+			src:    &types.QueryPendingAdminTransferRequest{Address: RandomBech32AccountAddress(t)},
+			expErr: true,
+		},
+		"invalid address": {
+			src:    &types.QueryPendingAdminTransferRequest{Address: "foo"},
+			expErr: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := querier.PendingAdminTransfer(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestQueryPendingAdminTransfers(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	querier := NewGrpcQuerier(k.cdc, k.storeService, k, k.queryGasLimit)
+
+	var stored []types.PendingAdminTransfer
+	for i := 0; i < 3; i++ {
+		contractAddr := RandomAccountAddress(t)
+		transfer := types.PendingAdminTransfer{
+			Contract:      contractAddr.String(),
+			ProposedAdmin: RandomBech32AccountAddress(t),
+		}
+		require.NoError(t, k.storePendingAdminTransfer(ctx, contractAddr, transfer))
+		stored = append(stored, transfer)
+	}
+
+	// when
+	gotRsp, gotErr := querier.PendingAdminTransfers(ctx, &types.QueryPendingAdminTransfersRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+
+	// then
+	require.NoError(t, gotErr)
+	require.Len(t, gotRsp.PendingAdminTransfers, 2)
+	require.NotNil(t, gotRsp.Pagination.NextKey)
+	got := gotRsp.PendingAdminTransfers
+
+	gotRsp, gotErr = querier.PendingAdminTransfers(ctx, &types.QueryPendingAdminTransfersRequest{
+		Pagination: &query.PageRequest{Key: gotRsp.Pagination.NextKey},
+	})
+	require.NoError(t, gotErr)
+	require.Len(t, gotRsp.PendingAdminTransfers, 1)
+	assert.Nil(t, gotRsp.Pagination.NextKey)
+	got = append(got, gotRsp.PendingAdminTransfers...)
+	assert.ElementsMatch(t, stored, got)
+}
+
+func TestQueryPendingMigration(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	querier := NewGrpcQuerier(k.cdc, k.storeService, k, k.queryGasLimit)
+
+	contractAddr := RandomAccountAddress(t)
+	stored := types.PendingMigrationFixture(func(p *types.PendingMigration) {
+		p.Contract = contractAddr.String()
+	})
+	require.NoError(t, k.storePendingMigration(ctx, stored))
+
+	specs := map[string]struct {
+		src    *types.QueryPendingMigrationRequest
+		expRsp *types.QueryPendingMigrationResponse
+		expErr bool
+	}{
+		"found": {
+			src:    &types.QueryPendingMigrationRequest{Address: contractAddr.String()},
+			expRsp: &types.QueryPendingMigrationResponse{PendingMigration: stored},
+		},
+		"not found": {
+			src:    &types.QueryPendingMigrationRequest{Address: RandomBech32AccountAddress(t)},
+			expErr: true,
+		},
+		"invalid address": {
+			src:    &types.QueryPendingMigrationRequest{Address: "foo"},
+			expErr: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := querier.PendingMigration(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestQueryContractStorageUsage(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	querier := NewGrpcQuerier(k.cdc, k.storeService, k, k.queryGasLimit)
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	stored := types.ContractStorageUsageFixture(func(u *types.ContractStorageUsage) {
+		u.Contract = example.Contract.String()
+	})
+	require.NoError(t, k.storeContractStorageUsage(ctx, stored))
+	untracked := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, k.storeContractStorageUsage(ctx, types.ContractStorageUsage{Contract: untracked.Contract.String()}))
+
+	specs := map[string]struct {
+		src    *types.QueryContractStorageUsageRequest
+		expRsp *types.QueryContractStorageUsageResponse
+		expErr bool
+	}{
+		"found": {
+			src:    &types.QueryContractStorageUsageRequest{Address: example.Contract.String()},
+			expRsp: &types.QueryContractStorageUsageResponse{StorageUsage: stored},
+		},
+		"nothing accounted": {
+			src: &types.QueryContractStorageUsageRequest{Address: untracked.Contract.String()},
+			expRsp: &types.QueryContractStorageUsageResponse{
+				StorageUsage: types.ContractStorageUsage{Contract: untracked.Contract.String()},
+			},
+		},
+		"unknown contract": {
+			src:    &types.QueryContractStorageUsageRequest{Address: RandomBech32AccountAddress(t)},
+			expErr: true,
+		},
+		"invalid address": {
+			src:    &types.QueryContractStorageUsageRequest{Address: "foo"},
+			expErr: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := querier.ContractStorageUsage(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestQueryCodesByChecksum(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	example1 := StoreHackatomExampleContract(t, ctx, keepers)
+	example2 := StoreHackatomExampleContract(t, ctx, keepers)
+	other := StoreBurnerExampleContract(t, ctx, keepers)
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery   *types.QueryCodesByChecksumRequest
+		expCodeIDs []uint64
+		expErr     error
+	}{
+		"query all": {
+			srcQuery:   &types.QueryCodesByChecksumRequest{Checksum: example1.Checksum},
+			expCodeIDs: []uint64{example1.CodeID, example2.CodeID},
+		},
+		"other checksum": {
+			srcQuery:   &types.QueryCodesByChecksumRequest{Checksum: other.Checksum},
+			expCodeIDs: []uint64{other.CodeID},
+		},
+		"unknown checksum": {
+			srcQuery:   &types.QueryCodesByChecksumRequest{Checksum: bytes.Repeat([]byte{1}, 32)},
+			expCodeIDs: []uint64{},
+		},
+		"with pagination limit": {
+			srcQuery: &types.QueryCodesByChecksumRequest{
+				Checksum: example1.Checksum,
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			expCodeIDs: []uint64{example1.CodeID},
+		},
+		"with pagination offset": {
+			srcQuery: &types.QueryCodesByChecksumRequest{
+				Checksum: example1.Checksum,
+				Pagination: &query.PageRequest{
+					Offset: 1,
+				},
+			},
+			expErr: errLegacyPaginationUnsupported,
+		},
+		"empty checksum": {
+			srcQuery: &types.QueryCodesByChecksumRequest{},
+			expErr:   types.ErrEmpty,
+		},
+		"invalid checksum length": {
+			srcQuery: &types.QueryCodesByChecksumRequest{Checksum: []byte{1, 2, 3}},
+			expErr:   types.ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, gotErr := q.CodesByChecksum(ctx, spec.srcQuery)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.NotNil(t, got)
+			assert.Equal(t, spec.expCodeIDs, got.CodeIDs)
+		})
+	}
+}
+
+func TestQueryWasmGasRegister(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+	q := Querier(keeper)
+
+	myGasRegister := types.NewGasRegisterParams(types.DefaultGasRegisterConfig())
+	myGasRegister.InstanceCost = 1
+	myGasRegister.GasMultiplier = 2
+
+	specs := map[string]struct {
+		srcGasRegister *types.GasRegisterParams
+		exp            types.GasRegisterParams
+	}{
+		"not set in params": {
+			exp: types.NewGasRegisterParams(types.DefaultGasRegisterConfig()),
+		},
+		"set in params": {
+			srcGasRegister: &myGasRegister,
+			exp:            myGasRegister,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			params := types.DefaultParams()
+			params.GasRegister = spec.srcGasRegister
+			require.NoError(t, keeper.SetParams(ctx, params))
+
+			// when
+			got, gotErr := q.WasmGasRegister(ctx, &types.QueryWasmGasRegisterRequest{})
+
+			// then
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got.GasRegister)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// smartQueryCacheMaxResultSize is the max size of a smart query result in bytes that is cached
+const smartQueryCacheMaxResultSize = 64 * 1024
+
+// smartQueryCache is a node local LRU cache for the smart query results of the gRPC queries. The state of a height
+// does not change, so that the entries are only dropped when a newer height is queried. The queries of older
+// heights are not cached.
+type smartQueryCache struct {
+	mu      sync.Mutex
+	height  int64
+	entries *lru.Cache[string, []byte]
+	hits    prometheus.Counter
+	misses  prometheus.Counter
+}
+
+// newSmartQueryCache constructor. The size is the max number of entries.
+func newSmartQueryCache(size uint32) *smartQueryCache {
+	entries, err := lru.New[string, []byte](int(size))
+	if err != nil {
+		panic(err)
+	}
+	return &smartQueryCache{
+		entries: entries,
+		hits: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "wasm_smart_query_cache_hits_total",
+			Help: "Total number of smart queries that were answered from the cache",
+		}),
+		misses: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "wasm_smart_query_cache_misses_total",
+			Help: "Total number of smart queries that were not in the cache",
+		}),
+	}
+}
+
+// Register registers the hit and miss counters
+func (c *smartQueryCache) Register(r prometheus.Registerer) {
+	r.MustRegister(c.hits, c.misses)
+}
+
+// get returns the cached result of the query. The entries of older heights are dropped when the height is newer.
+func (c *smartQueryCache) get(height int64, contractAddr sdk.AccAddress, req []byte) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if height > c.height {
+		c.height = height
+		c.entries.Purge()
+	}
+	if height == c.height {
+		if bz, ok := c.entries.Get(smartQueryCacheKey(contractAddr, req)); ok {
+			c.hits.Inc()
+			return bz, true
+		}
+	}
+	c.misses.Inc()
+	return nil, false
+}
+
+// add caches the result of the query when the height is the latest one
+func (c *smartQueryCache) add(height int64, contractAddr sdk.AccAddress, req, result []byte) {
+	if len(result) > smartQueryCacheMaxResultSize {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if height != c.height {
+		return
+	}
+	c.entries.Add(smartQueryCacheKey(contractAddr, req), result)
+}
+
+// isCommittedStateQuery returns true when the context was created by the baseapp for an ABCI or gRPC query.
+// These contexts run on the committed state of the block height. They are in check mode like CheckTx but
+// without tx bytes while block execution, simulations and the proposal handlers use other exec modes.
+func isCommittedStateQuery(ctx sdk.Context) bool {
+	return ctx.IsCheckTx() && ctx.ExecMode() == sdk.ExecModeCheck && len(ctx.TxBytes()) == 0
+}
+
+func smartQueryCacheKey(contractAddr sdk.AccAddress, req []byte) string {
+	return string(address.MustLengthPrefix(contractAddr)) + string(req)
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSmartQueryCache(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := BuildContractAddressClassic(1, 1)
+	k.mustStoreCodeInfo(parentCtx, 1, types.CodeInfo{})
+	k.mustStoreContractInfo(parentCtx, contractAddr, &types.ContractInfo{
+		CodeID:  1,
+		Created: types.NewAbsoluteTxPosition(parentCtx),
+	})
+	var engineCalls int
+	k.wasmVM = &wasmtesting.MockWasmEngine{QueryFn: func(checksum wasmvm.Checksum, env wasmvmtypes.Env, queryMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+		engineCalls++
+		return &wasmvmtypes.QueryResult{Ok: queryMsg}, 1, nil
+	}}
+
+	type smartQuery struct {
+		height       int64
+		msg          string
+		fromContract bool
+		ctxFn        func(sdk.Context) sdk.Context
+	}
+	inFinalizeBlock := func(ctx sdk.Context) sdk.Context { return ctx.WithExecMode(sdk.ExecModeFinalize) }
+	myQuery, otherQuery := `{"balance":{}}`, `{"price":{}}`
+	largeQuery := `"` + strings.Repeat("a", smartQueryCacheMaxResultSize) + `"`
+	specs := map[string]struct {
+		queries        []smartQuery
+		expEngineCalls int
+		expHits        float64
+		expMisses      float64
+	}{
+		"same query at same height": {
+			queries:        []smartQuery{{height: 1, msg: myQuery}, {height: 1, msg: myQuery}},
+			expEngineCalls: 1,
+			expHits:        1,
+			expMisses:      1,
+		},
+		"other query": {
+			queries:        []smartQuery{{height: 1, msg: myQuery}, {height: 1, msg: otherQuery}},
+			expEngineCalls: 2,
+			expMisses:      2,
+		},
+		"newer height": {
+			queries:        []smartQuery{{height: 1, msg: myQuery}, {height: 2, msg: myQuery}, {height: 2, msg: myQuery}},
+			expEngineCalls: 2,
+			expHits:        1,
+			expMisses:      2,
+		},
+		"older height not cached": {
+			queries:        []smartQuery{{height: 2, msg: myQuery}, {height: 1, msg: myQuery}, {height: 1, msg: myQuery}},
+			expEngineCalls: 3,
+			expMisses:      3,
+		},
+		"large result not cached": {
+			queries:        []smartQuery{{height: 1, msg: largeQuery}, {height: 1, msg: largeQuery}},
+			expEngineCalls: 2,
+			expMisses:      2,
+		},
+		"query from contract": {
+			queries:        []smartQuery{{height: 1, msg: myQuery, fromContract: true}, {height: 1, msg: myQuery, fromContract: true}},
+			expEngineCalls: 2,
+		},
+		"query in finalize block": {
+			queries:        []smartQuery{{height: 1, msg: myQuery, ctxFn: inFinalizeBlock}, {height: 1, msg: myQuery, ctxFn: inFinalizeBlock}},
+			expEngineCalls: 2,
+		},
+		"query in check tx": {
+			queries: []smartQuery{
+				{height: 1, msg: myQuery, ctxFn: func(ctx sdk.Context) sdk.Context { return ctx.WithTxBytes([]byte("tx")) }},
+				{height: 1, msg: myQuery, ctxFn: func(ctx sdk.Context) sdk.Context { return ctx.WithIsReCheckTx(true) }},
+			},
+			expEngineCalls: 2,
+		},
+		"query in simulation": {
+			queries: []smartQuery{
+				{height: 1, msg: myQuery, ctxFn: func(ctx sdk.Context) sdk.Context { return ctx.WithExecMode(sdk.ExecModeSimulate) }},
+				{height: 1, msg: myQuery, ctxFn: func(ctx sdk.Context) sdk.Context { return ctx.WithExecMode(sdk.ExecModeSimulate) }},
+			},
+			expEngineCalls: 2,
+		},
+		"uncommitted height does not drop entries": {
+			queries:        []smartQuery{{height: 1, msg: myQuery}, {height: 2, msg: myQuery, ctxFn: inFinalizeBlock}, {height: 1, msg: myQuery}},
+			expEngineCalls: 2,
+			expHits:        1,
+			expMisses:      1,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			engineCalls = 0
+			cache := newSmartQueryCache(10)
+			q := Querier(k)
+			q.queryCache = cache
+
+			// when
+			for _, sq := range spec.queries {
+				ctx := parentCtx.WithIsCheckTx(true).WithBlockHeight(sq.height)
+				if sq.ctxFn != nil {
+					ctx = sq.ctxFn(ctx)
+				}
+				if sq.fromContract {
+					ctx = types.WithCodeID(ctx, 1)
+				}
+				res, err := q.SmartContractState(ctx, &types.QuerySmartContractStateRequest{
+					Address:   contractAddr.String(),
+					QueryData: []byte(sq.msg),
+				})
+				require.NoError(t, err)
+				assert.Equal(t, sq.msg, string(res.Data))
+			}
+
+			// then
+			assert.Equal(t, spec.expEngineCalls, engineCalls)
+			assert.Equal(t, spec.expHits, testutil.ToFloat64(cache.hits))
+			assert.Equal(t, spec.expMisses, testutil.ToFloat64(cache.misses))
+		})
+	}
+}
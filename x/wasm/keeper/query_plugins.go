@@ -31,6 +31,8 @@ type QueryHandler struct {
 	Plugins     WasmVMQueryHandler
 	Caller      sdk.AccAddress
 	gasRegister types.GasRegister
+	// redactErrorFn returns the error that the contract receives. The legacy format is used when not set.
+	redactErrorFn func(ctx sdk.Context, err error) error
 }
 
 func NewQueryHandler(ctx sdk.Context, vmQueryHandler WasmVMQueryHandler, caller sdk.AccAddress, gasRegister types.GasRegister) QueryHandler {
@@ -50,7 +52,16 @@ type GRPCQueryRouter interface {
 
 var _ wasmvmtypes.Querier = QueryHandler{}
 
-func (q QueryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) ([]byte, error) {
+func (q QueryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) (_ []byte, err error) {
+	trace := traceCall(q.Ctx, func() *types.CallTraceNode {
+		return &types.CallTraceNode{
+			Type:     types.CallTraceTypeQuery,
+			Name:     traceName(request),
+			Contract: q.Caller.String(),
+		}
+	})
+	defer func() { err = trace.finish(err) }()
+
 	// set a limit for a subCtx
 	sdkGas := q.gasRegister.FromWasmVMGas(gasLimit)
 	// discard all changes/ events in subCtx by not committing the cached context
@@ -75,7 +86,12 @@ func (q QueryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) (
 
 	// Issue #759 - we don't return error string for worries of non-determinism
 	moduleLogger(q.Ctx).Debug("Redacting submessage error", "cause", err)
-	return nil, redactError(err)
+	redacted := redactError(err, false)
+	if q.redactErrorFn != nil {
+		redacted = q.redactErrorFn(q.Ctx, err)
+	}
+	trace.setError(err, redacted)
+	return nil, redacted
 }
 
 func (q QueryHandler) GasConsumed() uint64 {
@@ -405,6 +421,20 @@ var _ stargateQuerierFn = RejectStargateQuerier // just a type check
 //	}
 type AcceptedQueries map[string]func() proto.Message
 
+// WasmAcceptedQueries returns the x/wasm queries that are not covered by the WasmQuery variants of wasmvm
+// but are deterministic and can be added to the accept list of the Stargate or gRPC querier.
+// For example the storage usage of a contract:
+//
+//	acceptList := WasmAcceptedQueries()
+//	WithQueryPlugins(&QueryPlugins{Grpc: AcceptListGrpcQuerier(acceptList, queryRouter, codec)})
+func WasmAcceptedQueries() AcceptedQueries {
+	return AcceptedQueries{
+		"/cosmwasm.wasm.v1.Query/ContractStorageUsage": func() proto.Message {
+			return &types.QueryContractStorageUsageResponse{}
+		},
+	}
+}
+
 // AcceptListStargateQuerier supports a preconfigured set of stargate queries only.
 // All arguments must be non nil.
 //
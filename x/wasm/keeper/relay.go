@@ -27,24 +27,33 @@ func (k Keeper) OnOpenChannel(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCChannelOpenMsg,
-) (string, error) {
+) (_ string, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-open-channel")
+	ctx, trace := startCallTrace(ctx, "ibc-open-channel", contractAddr)
+	defer func() { err = trace.finish(err) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return "", err
 	}
+	if err := assertNotFrozen(contractAddr, contractInfo); err != nil {
+		return "", err
+	}
 
+	ctx, gasRegister := k.withGasRegister(ctx)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-open-channel")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, contractInfo.CodeID)
 
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return "", err
+	}
 	// check if contract panicked / VM failed
 	if execErr != nil {
 		return "", errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
@@ -76,24 +85,33 @@ func (k Keeper) OnConnectChannel(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCChannelConnectMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-connect-channel")
+	ctx, trace := startCallTrace(ctx, "ibc-connect-channel", contractAddr)
+	defer func() { err = trace.finish(err) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := assertNotFrozen(contractAddr, contractInfo); err != nil {
+		return err
+	}
 
+	ctx, gasRegister := k.withGasRegister(ctx)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-connect-channel")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, contractInfo.CodeID)
 
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return err
+	}
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -118,25 +136,34 @@ func (k Keeper) OnCloseChannel(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCChannelCloseMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-close-channel")
+	ctx, trace := startCallTrace(ctx, "ibc-close-channel", contractAddr)
+	defer func() { err = trace.finish(err) }()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := assertNotFrozen(contractAddr, contractInfo); err != nil {
+		return err
+	}
 
+	ctx, gasRegister := k.withGasRegister(ctx)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-close-channel")
 
 	params := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, contractInfo.CodeID)
 
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return err
+	}
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -161,24 +188,33 @@ func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCPacketReceiveMsg,
-) (ibcexported.Acknowledgement, error) {
+) (_ ibcexported.Acknowledgement, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-recv-packet")
+	ctx, trace := startCallTrace(ctx, "ibc-recv-packet", contractAddr)
+	defer func() { err = trace.finish(err) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return nil, err
 	}
+	if err := assertNotFrozen(contractAddr, contractInfo); err != nil {
+		return nil, err
+	}
 
+	ctx, gasRegister := k.withGasRegister(ctx)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-recv-packet")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, contractInfo.CodeID)
 
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return nil, err
+	}
 	if execErr != nil {
 		panic(execErr) // let the contract fully abort an IBC packet receive.
 		// Throwing a panic here instead of an error ack will revert
@@ -239,24 +275,33 @@ func (k Keeper) OnAckPacket(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCPacketAckMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-ack-packet")
+	ctx, trace := startCallTrace(ctx, "ibc-ack-packet", contractAddr)
+	defer func() { err = trace.finish(err) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := assertNotFrozen(contractAddr, contractInfo); err != nil {
+		return err
+	}
 
+	ctx, gasRegister := k.withGasRegister(ctx)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-ack-packet")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, contractInfo.CodeID)
 
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return err
+	}
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -278,25 +323,34 @@ func (k Keeper) OnTimeoutPacket(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCPacketTimeoutMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-timeout-packet")
+	ctx, trace := startCallTrace(ctx, "ibc-timeout-packet", contractAddr)
+	defer func() { err = trace.finish(err) }()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := assertNotFrozen(contractAddr, contractInfo); err != nil {
+		return err
+	}
 
+	ctx, gasRegister := k.withGasRegister(ctx)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-timeout-packet")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, contractInfo.CodeID)
 
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return err
+	}
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -317,25 +371,34 @@ func (k Keeper) IBCSourceCallback(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCSourceCallbackMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-source-chain-callback")
+	ctx, trace := startCallTrace(ctx, "ibc-source-chain-callback", contractAddr)
+	defer func() { err = trace.finish(err) }()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := assertNotFrozen(contractAddr, contractInfo); err != nil {
+		return err
+	}
 
+	ctx, gasRegister := k.withGasRegister(ctx)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-source-chain-callback")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, contractInfo.CodeID)
 
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCSourceCallback(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return err
+	}
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -356,25 +419,34 @@ func (k Keeper) IBCDestinationCallback(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCDestinationCallbackMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-destination-chain-callback")
+	ctx, trace := startCallTrace(ctx, "ibc-destination-chain-callback", contractAddr)
+	defer func() { err = trace.finish(err) }()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := assertNotFrozen(contractAddr, contractInfo); err != nil {
+		return err
+	}
 
+	ctx, gasRegister := k.withGasRegister(ctx)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-destination-chain-callback")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, contractInfo.CodeID)
 
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCDestinationCallback(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
+	if err := prefixStore.Err(); err != nil {
+		return err
+	}
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
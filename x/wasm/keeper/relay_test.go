@@ -438,7 +438,7 @@ func TestOnRecvPacket(t *testing.T) {
 				return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: []byte("myBetterAck")}}, 0, nil
 			},
 			expAck:        []byte("myBetterAck"),
-			expEventTypes: []string{types.EventTypeReply},
+			expEventTypes: []string{types.EventTypeReply, "cosmwasm.wasm.v1.EventReply"},
 		},
 		"unknown contract address": {
 			contractAddr: RandomAccountAddress(t),
@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+var _ types.AuthorizationPolicy = delayElapsedAuthorizationPolicy{}
+
+// delayElapsedAuthorizationPolicy decorates the given policy to execute a pending migration after the
+// contract's migration delay has passed
+type delayElapsedAuthorizationPolicy struct {
+	types.AuthorizationPolicy
+}
+
+func (p delayElapsedAuthorizationPolicy) CanBypassMigrationDelay(sdk.AccAddress) bool {
+	return true
+}
+
+// setContractMigrationDelay sets the number of blocks a migration by the admin is queued before it is executed.
+// Lowering the delay would allow to skip the waiting period, so that it requires the permission to bypass the delay.
+func (k Keeper) setContractMigrationDelay(ctx context.Context, contractAddress, caller sdk.AccAddress, delay uint64, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if delay < contractInfo.MigrationDelay && !authZ.CanBypassMigrationDelay(caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not lower migration delay")
+	}
+	if delay > types.MaxMigrationDelay {
+		return errorsmod.Wrapf(types.ErrLimit, "migration delay must not exceed %d blocks", types.MaxMigrationDelay)
+	}
+	contractInfo.MigrationDelay = delay
+	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
+		types.EventTypeUpdateMigrationDelay,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyMigrationDelay, strconv.FormatUint(delay, 10)),
+	), &types.EventUpdateMigrationDelay{Contract: contractAddress.String(), MigrationDelay: delay})
+	return nil
+}
+
+// migrateOrQueue migrates the contract immediately or, when the contract has a migration delay that the caller
+// can not bypass, queues the migration. The height at which a queued migration is executed is returned. It is
+// zero when the contract was migrated immediately.
+func (k Keeper) migrateOrQueue(
+	ctx context.Context,
+	contractAddress sdk.AccAddress,
+	caller sdk.AccAddress,
+	newCodeID uint64,
+	msg []byte,
+	authZ types.AuthorizationPolicy,
+) ([]byte, int64, error) {
+	contractInfo, newCodeInfo, err := k.checkMigrationPermissions(ctx, contractAddress, caller, newCodeID, authZ)
+	if err != nil {
+		return nil, 0, err
+	}
+	if contractInfo.MigrationDelay != 0 && !authZ.CanBypassMigrationDelay(caller) {
+		executeHeight, err := k.scheduleMigration(sdk.UnwrapSDKContext(ctx), contractAddress, caller, newCodeID, msg, contractInfo.MigrationDelay)
+		return nil, executeHeight, err
+	}
+	data, err := k.migrateContract(ctx, contractAddress, contractInfo, caller, newCodeID, newCodeInfo, msg, authZ)
+	return data, 0, err
+}
+
+// scheduleMigration queues the migration until the delay has passed. A pending migration of the contract is replaced.
+// The height at which the migration is executed is returned.
+func (k Keeper) scheduleMigration(ctx sdk.Context, contractAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, delay uint64) (int64, error) {
+	if existing := k.GetPendingMigration(ctx, contractAddress); existing != nil {
+		if err := k.deletePendingMigration(ctx, *existing); err != nil {
+			return 0, err
+		}
+	}
+	migration := types.PendingMigration{
+		Contract:      contractAddress.String(),
+		Sender:        caller.String(),
+		CodeID:        newCodeID,
+		Msg:           msg,
+		ExecuteHeight: ctx.BlockHeight() + int64(delay),
+	}
+	if err := k.storePendingMigration(ctx, migration); err != nil {
+		return 0, err
+	}
+	k.emitActionEvent(ctx, sdk.NewEvent(
+		types.EventTypeScheduleMigration,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, migration.Contract),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(newCodeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyExecuteHeight, strconv.FormatInt(migration.ExecuteHeight, 10)),
+	), &types.EventScheduleMigration{Contract: migration.Contract, CodeID: newCodeID, ExecuteHeight: migration.ExecuteHeight})
+	return migration.ExecuteHeight, nil
+}
+
+// cancelMigration removes the pending migration of a contract
+func (k Keeper) cancelMigration(ctx context.Context, contractAddress, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	migration := k.GetPendingMigration(sdkCtx, contractAddress)
+	if migration == nil {
+		return errorsmod.Wrap(types.ErrNotFound, "pending migration")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not cancel migration")
+	}
+	if err := k.deletePendingMigration(sdkCtx, *migration); err != nil {
+		return err
+	}
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
+		types.EventTypeCancelMigration,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, migration.Contract),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(migration.CodeID, 10)),
+	), &types.EventCancelMigration{Contract: migration.Contract, CodeID: migration.CodeID})
+	return nil
+}
+
+// ExecuteDueMigrations runs the pending migrations with an execute height up to the current block height.
+// At most types.MaxScheduledMigrationsPerBlock are executed, the remaining migrations are due in the next block then.
+// A failing migration does not abort the block. It is removed and its state changes are reverted.
+func (k Keeper) ExecuteDueMigrations(ctx context.Context) {
+	// the gas register params are read once for all migrations of the block
+	sdkCtx, _ := k.withGasRegister(sdk.UnwrapSDKContext(ctx))
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingMigrationByHeightIndexPrefix)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(sdkCtx.BlockHeight()+1)))
+	var contracts []sdk.AccAddress
+	for ; iter.Valid() && len(contracts) < types.MaxScheduledMigrationsPerBlock; iter.Next() {
+		contracts = append(contracts, sdk.AccAddress(iter.Key()[8:]))
+	}
+	iter.Close()
+
+	for _, contractAddress := range contracts {
+		migration := k.GetPendingMigration(sdkCtx, contractAddress)
+		if migration == nil {
+			continue
+		}
+		if err := k.deletePendingMigration(sdkCtx, *migration); err != nil {
+			panic(err)
+		}
+		cacheCtx, commit := sdkCtx.CacheContext()
+		err := k.runScheduledMigration(cacheCtx, *migration)
+		if err == nil {
+			commit()
+		} else {
+			k.Logger(sdkCtx).Info("scheduled migration failed", "contract", migration.Contract, "error", err)
+		}
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyContractAddr, migration.Contract),
+			sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(migration.CodeID, 10)),
+			sdk.NewAttribute(types.AttributeKeyMigrationSuccess, strconv.FormatBool(err == nil)),
+		}
+		typedEvt := &types.EventScheduledMigration{Contract: migration.Contract, CodeID: migration.CodeID, Success: err == nil}
+		if err != nil {
+			typedEvt.Error = k.redactError(sdkCtx, err).Error()
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyMigrationError, typedEvt.Error))
+		}
+		k.emitActionEvent(sdkCtx, sdk.NewEvent(types.EventTypeScheduledMigration, attributes...), typedEvt)
+	}
+}
+
+// runScheduledMigration executes the migration with the permissions of the sender at the time of execution.
+// The gas consumption is limited by types.MaxScheduledMigrationGasLimit. Panics are recovered and returned as error
+// so that a failing migration can not halt the chain in the EndBlocker.
+func (k Keeper) runScheduledMigration(ctx sdk.Context, migration types.PendingMigration) (err error) {
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(types.MaxScheduledMigrationGasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrap(sdkerrors.ErrOutOfGas, outOfGas.Descriptor)
+				return
+			}
+			k.Logger(ctx).Error("scheduled migration panicked", "contract", migration.Contract, "panic", r)
+			err = sdkerrors.ErrPanic
+		}
+	}()
+
+	contractAddress := sdk.MustAccAddressFromBech32(migration.Contract)
+	sender := sdk.MustAccAddressFromBech32(migration.Sender)
+	authZ := delayElapsedAuthorizationPolicy{AuthorizationPolicy: DefaultAuthorizationPolicy{}}
+	_, err = k.migrate(ctx, contractAddress, sender, migration.CodeID, migration.Msg, authZ)
+	return err
+}
+
+// GetPendingMigration returns the pending migration of the contract or nil when none exists
+func (k Keeper) GetPendingMigration(ctx context.Context, contractAddress sdk.AccAddress) *types.PendingMigration {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetPendingMigrationKey(contractAddress))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var migration types.PendingMigration
+	k.cdc.MustUnmarshal(bz, &migration)
+	return &migration
+}
+
+// IteratePendingMigrations iterates over all pending migrations.
+func (k Keeper) IteratePendingMigrations(ctx context.Context, cb func(types.PendingMigration) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingMigrationPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var migration types.PendingMigration
+		k.cdc.MustUnmarshal(iter.Value(), &migration)
+		if cb(migration) {
+			return
+		}
+	}
+}
+
+// storePendingMigration persists the pending migration together with the height secondary index
+func (k Keeper) storePendingMigration(ctx context.Context, migration types.PendingMigration) error {
+	contractAddress, err := sdk.AccAddressFromBech32(migration.Contract)
+	if err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetPendingMigrationKey(contractAddress), k.cdc.MustMarshal(&migration)); err != nil {
+		return err
+	}
+	return store.Set(types.GetPendingMigrationByHeightIndexKey(migration.ExecuteHeight, contractAddress), []byte{})
+}
+
+func (k Keeper) deletePendingMigration(ctx context.Context, migration types.PendingMigration) error {
+	contractAddress, err := sdk.AccAddressFromBech32(migration.Contract)
+	if err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetPendingMigrationKey(contractAddress)); err != nil {
+		return err
+	}
+	return store.Delete(types.GetPendingMigrationByHeightIndexKey(migration.ExecuteHeight, contractAddress))
+}
+
+func (k Keeper) importPendingMigration(ctx context.Context, migration types.PendingMigration) error {
+	contractAddress := sdk.MustAccAddressFromBech32(migration.Contract)
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return types.ErrNoSuchContractFn(migration.Contract).Wrapf("address %s", migration.Contract)
+	}
+	if !k.containsCodeInfo(ctx, migration.CodeID) {
+		return types.ErrNoSuchCodeFn(migration.CodeID).Wrapf("code id %d", migration.CodeID)
+	}
+	if k.GetPendingMigration(ctx, contractAddress) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "pending migration: %s", migration.Contract)
+	}
+	return k.storePendingMigration(ctx, migration)
+}
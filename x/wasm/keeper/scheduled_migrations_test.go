@@ -0,0 +1,309 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSetContractMigrationDelay(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	require.NoError(t, k.setContractMigrationDelay(parentCtx, example.Contract, example.CreatorAddr, 10, DefaultAuthorizationPolicy{}))
+	otherAddr := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		sender   sdk.AccAddress
+		contract sdk.AccAddress
+		delay    uint64
+		authZ    types.AuthorizationPolicy
+		expErr   *errorsmod.Error
+	}{
+		"admin increases delay": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			delay:    11,
+			authZ:    DefaultAuthorizationPolicy{},
+		},
+		"admin keeps delay": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			delay:    10,
+			authZ:    DefaultAuthorizationPolicy{},
+		},
+		"admin lowers delay": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			delay:    9,
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"gov lowers delay": {
+			sender:   otherAddr,
+			contract: example.Contract,
+			delay:    0,
+			authZ:    GovAuthorizationPolicy{},
+		},
+		"other sender": {
+			sender:   otherAddr,
+			contract: example.Contract,
+			delay:    11,
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"delay exceeds max": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			delay:    types.MaxMigrationDelay + 1,
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   types.ErrLimit,
+		},
+		"unknown contract": {
+			sender:   example.CreatorAddr,
+			contract: RandomAccountAddress(t),
+			delay:    11,
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotErr := k.setContractMigrationDelay(ctx, spec.contract, spec.sender, spec.delay, spec.authZ)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Equal(t, uint64(10), k.GetContractInfo(ctx, example.Contract).MigrationDelay)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.delay, k.GetContractInfo(ctx, spec.contract).MigrationDelay)
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, types.EventTypeUpdateMigrationDelay, em.Events()[0].Type)
+			assert.Equal(t, proto.MessageName(&types.EventUpdateMigrationDelay{}), em.Events()[1].Type)
+		})
+	}
+}
+
+func TestScheduledMigration(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	newCodeExample := StoreBurnerExampleContract(t, parentCtx, keepers)
+	migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+	const delay = 2
+	require.NoError(t, k.setContractMigrationDelay(parentCtx, example.Contract, example.CreatorAddr, delay, DefaultAuthorizationPolicy{}))
+
+	specs := map[string]struct {
+		setup      func(t *testing.T, ctx sdk.Context)
+		expSuccess bool
+	}{
+		"executed after delay": {
+			setup:      func(*testing.T, sdk.Context) {},
+			expSuccess: true,
+		},
+		"admin cleared in the meantime": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				require.NoError(t, k.setContractAdmin(ctx, example.Contract, example.CreatorAddr, nil, DefaultAuthorizationPolicy{}))
+			},
+		},
+		"contract panics": {
+			setup: func(t *testing.T, _ sdk.Context) {
+				wasmVM := k.wasmVM
+				k.wasmVM = &wasmtesting.MockWasmEngine{MigrateWithInfoFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, migrateMsg []byte, migrateInfo wasmvmtypes.MigrateInfo, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					panic("testing")
+				}}
+				t.Cleanup(func() { k.wasmVM = wasmVM })
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			startHeight := ctx.BlockHeight()
+
+			// when
+			em := sdk.NewEventManager()
+			gotData, gotExecuteHeight, gotErr := k.migrateOrQueue(ctx.WithEventManager(em), example.Contract, example.CreatorAddr, newCodeExample.CodeID, migMsgBz, DefaultAuthorizationPolicy{})
+
+			// then the migration is queued
+			require.NoError(t, gotErr)
+			assert.Nil(t, gotData)
+			assert.Equal(t, startHeight+delay, gotExecuteHeight)
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, types.EventTypeScheduleMigration, em.Events()[0].Type)
+			assert.Equal(t, proto.MessageName(&types.EventScheduleMigration{}), em.Events()[1].Type)
+			assert.Equal(t, example.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+			exp := types.PendingMigration{
+				Contract:      example.Contract.String(),
+				Sender:        example.CreatorAddr.String(),
+				CodeID:        newCodeExample.CodeID,
+				Msg:           migMsgBz,
+				ExecuteHeight: startHeight + delay,
+			}
+			got := k.GetPendingMigration(ctx, example.Contract)
+			require.NotNil(t, got)
+			assert.Equal(t, exp, *got)
+			spec.setup(t, ctx)
+
+			// and not executed before the delay has passed
+			ctx = ctx.WithBlockHeight(startHeight + delay - 1)
+			k.ExecuteDueMigrations(ctx)
+			assert.Equal(t, example.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+			require.NotNil(t, k.GetPendingMigration(ctx, example.Contract))
+
+			// and executed after the delay
+			em = sdk.NewEventManager()
+			ctx = ctx.WithBlockHeight(startHeight + delay).WithEventManager(em)
+			require.NotPanics(t, func() { k.ExecuteDueMigrations(ctx) })
+			assert.Nil(t, k.GetPendingMigration(ctx, example.Contract))
+			var gotEvents []sdk.Event
+			for _, e := range em.Events() {
+				if e.Type == types.EventTypeScheduledMigration {
+					gotEvents = append(gotEvents, e)
+				}
+			}
+			require.Len(t, gotEvents, 1)
+			attrs := attrsToStringMap(gotEvents[0].Attributes)
+			assert.Equal(t, example.Contract.String(), attrs[types.AttributeKeyContractAddr])
+			if !spec.expSuccess {
+				assert.Equal(t, "false", attrs[types.AttributeKeyMigrationSuccess])
+				assert.NotEmpty(t, attrs[types.AttributeKeyMigrationError])
+				assert.Equal(t, example.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+				return
+			}
+			assert.Equal(t, "true", attrs[types.AttributeKeyMigrationSuccess])
+			assert.Equal(t, newCodeExample.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+		})
+	}
+}
+
+func TestMigrationDelayBypassedByGov(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	newCodeExample := StoreBurnerExampleContract(t, ctx, keepers)
+	migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+	require.NoError(t, k.setContractMigrationDelay(ctx, example.Contract, example.CreatorAddr, 10, DefaultAuthorizationPolicy{}))
+
+	// when
+	_, err := k.migrate(ctx, example.Contract, RandomAccountAddress(t), newCodeExample.CodeID, migMsgBz, GovAuthorizationPolicy{})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, newCodeExample.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+	assert.Nil(t, k.GetPendingMigration(ctx, example.Contract))
+}
+
+func TestMigrateRejectsDelayedMigration(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	newCodeExample := StoreBurnerExampleContract(t, ctx, keepers)
+	migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+	require.NoError(t, k.setContractMigrationDelay(ctx, example.Contract, example.CreatorAddr, 10, DefaultAuthorizationPolicy{}))
+
+	// when
+	_, err := k.migrate(ctx, example.Contract, example.CreatorAddr, newCodeExample.CodeID, migMsgBz, DefaultAuthorizationPolicy{})
+
+	// then
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	assert.Equal(t, example.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+	assert.Nil(t, k.GetPendingMigration(ctx, example.Contract))
+}
+
+func TestCancelMigration(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	newCodeExample := StoreBurnerExampleContract(t, parentCtx, keepers)
+	migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+	require.NoError(t, k.setContractMigrationDelay(parentCtx, example.Contract, example.CreatorAddr, 10, DefaultAuthorizationPolicy{}))
+	_, _, err := k.migrateOrQueue(parentCtx, example.Contract, example.CreatorAddr, newCodeExample.CodeID, migMsgBz, DefaultAuthorizationPolicy{})
+	require.NoError(t, err)
+	pending := k.GetPendingMigration(parentCtx, example.Contract)
+	require.NotNil(t, pending)
+	otherAddr := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		sender sdk.AccAddress
+		authZ  types.AuthorizationPolicy
+		expErr *errorsmod.Error
+	}{
+		"admin": {
+			sender: example.CreatorAddr,
+			authZ:  DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			sender: otherAddr,
+			authZ:  GovAuthorizationPolicy{},
+		},
+		"other sender": {
+			sender: otherAddr,
+			authZ:  DefaultAuthorizationPolicy{},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotErr := k.cancelMigration(ctx, example.Contract, spec.sender, spec.authZ)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.NotNil(t, k.GetPendingMigration(ctx, example.Contract))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetPendingMigration(ctx, example.Contract))
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, types.EventTypeCancelMigration, em.Events()[0].Type)
+			assert.Equal(t, proto.MessageName(&types.EventCancelMigration{}), em.Events()[1].Type)
+			// and nothing executed
+			k.ExecuteDueMigrations(ctx.WithBlockHeight(pending.ExecuteHeight))
+			assert.Equal(t, example.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+			// nothing left to cancel
+			require.ErrorIs(t, k.cancelMigration(ctx, example.Contract, spec.sender, spec.authZ), types.ErrNotFound)
+		})
+	}
+}
+
+func TestDeleteCodeWithPendingMigration(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	newCodeExample := StoreBurnerExampleContract(t, ctx, keepers)
+	migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+	require.NoError(t, k.setContractMigrationDelay(ctx, example.Contract, example.CreatorAddr, 10, DefaultAuthorizationPolicy{}))
+	_, _, err := k.migrateOrQueue(ctx, example.Contract, example.CreatorAddr, newCodeExample.CodeID, migMsgBz, DefaultAuthorizationPolicy{})
+	require.NoError(t, err)
+
+	// when
+	gotErr := k.deleteCode(ctx, newCodeExample.CodeID, newCodeExample.CreatorAddr, DefaultAuthorizationPolicy{})
+
+	// then
+	require.ErrorIs(t, gotErr, types.ErrCodeInUse)
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// setContractStateQuota sets the maximum number of bytes the contract can store in its state. Zero for no limit.
+// The admin can only tighten the quota. Raising or removing it requires the permission to raise the state quota.
+// A quota below the current stored bytes is accepted. Writes that grow the state are rejected then.
+func (k Keeper) setContractStateQuota(ctx context.Context, contractAddress, caller sdk.AccAddress, maxStateSize uint64, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	raised := contractInfo.MaxStateSize != 0 && (maxStateSize == 0 || maxStateSize > contractInfo.MaxStateSize)
+	if raised && !authZ.CanRaiseStateQuota(caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not raise state quota")
+	}
+	contractInfo.MaxStateSize = maxStateSize
+	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
+		types.EventTypeUpdateStateQuota,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyMaxStateSize, strconv.FormatUint(maxStateSize, 10)),
+	), &types.EventUpdateStateQuota{Contract: contractAddress.String(), MaxStateSize: maxStateSize})
+	return nil
+}
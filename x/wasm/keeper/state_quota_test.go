@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSetContractStateQuota(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	require.NoError(t, k.setContractStateQuota(parentCtx, example.Contract, example.CreatorAddr, 1000, DefaultAuthorizationPolicy{}))
+	otherAddr := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		sender       sdk.AccAddress
+		contract     sdk.AccAddress
+		maxStateSize uint64
+		authZ        types.AuthorizationPolicy
+		expErr       *errorsmod.Error
+	}{
+		"admin lowers quota": {
+			sender:       example.CreatorAddr,
+			contract:     example.Contract,
+			maxStateSize: 999,
+			authZ:        DefaultAuthorizationPolicy{},
+		},
+		"admin keeps quota": {
+			sender:       example.CreatorAddr,
+			contract:     example.Contract,
+			maxStateSize: 1000,
+			authZ:        DefaultAuthorizationPolicy{},
+		},
+		"admin raises quota": {
+			sender:       example.CreatorAddr,
+			contract:     example.Contract,
+			maxStateSize: 1001,
+			authZ:        DefaultAuthorizationPolicy{},
+			expErr:       sdkerrors.ErrUnauthorized,
+		},
+		"admin removes quota": {
+			sender:       example.CreatorAddr,
+			contract:     example.Contract,
+			maxStateSize: 0,
+			authZ:        DefaultAuthorizationPolicy{},
+			expErr:       sdkerrors.ErrUnauthorized,
+		},
+		"gov raises quota": {
+			sender:       otherAddr,
+			contract:     example.Contract,
+			maxStateSize: 1001,
+			authZ:        GovAuthorizationPolicy{},
+		},
+		"gov removes quota": {
+			sender:       otherAddr,
+			contract:     example.Contract,
+			maxStateSize: 0,
+			authZ:        GovAuthorizationPolicy{},
+		},
+		"other sender": {
+			sender:       otherAddr,
+			contract:     example.Contract,
+			maxStateSize: 999,
+			authZ:        DefaultAuthorizationPolicy{},
+			expErr:       sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			sender:       example.CreatorAddr,
+			contract:     RandomAccountAddress(t),
+			maxStateSize: 999,
+			authZ:        DefaultAuthorizationPolicy{},
+			expErr:       sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotErr := k.setContractStateQuota(ctx, spec.contract, spec.sender, spec.maxStateSize, spec.authZ)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Equal(t, uint64(1000), k.GetContractInfo(ctx, example.Contract).MaxStateSize)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.maxStateSize, k.GetContractInfo(ctx, spec.contract).MaxStateSize)
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, types.EventTypeUpdateStateQuota, em.Events()[0].Type)
+			assert.Equal(t, proto.MessageName(&types.EventUpdateStateQuota{}), em.Events()[1].Type)
+		})
+	}
+}
+
+func TestContractStoreStateQuota(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		maxStateSize uint64
+		do           func(s *contractStateStore)
+		expBytes     uint64
+		expErr       *errorsmod.Error
+	}{
+		"within quota": {
+			maxStateSize: 6,
+			do: func(s *contractStateStore) {
+				s.Set([]byte("foo"), []byte("bar"))
+			},
+			expBytes: 6,
+		},
+		"no quota": {
+			do: func(s *contractStateStore) {
+				s.Set([]byte("foo"), []byte("bar"))
+			},
+			expBytes: 6,
+		},
+		"exceeds quota": {
+			maxStateSize: 5,
+			do: func(s *contractStateStore) {
+				s.Set([]byte("foo"), []byte("bar"))
+			},
+			expErr: types.ErrStateQuotaExceeded,
+		},
+		"overwrite exceeds quota": {
+			maxStateSize: 8,
+			do: func(s *contractStateStore) {
+				s.Set([]byte("foo"), []byte("bar"))
+				s.Set([]byte("foo"), []byte("barbaz"))
+			},
+			expBytes: 6,
+			expErr:   types.ErrStateQuotaExceeded,
+		},
+		"shrink when over quota": {
+			maxStateSize: 6,
+			do: func(s *contractStateStore) {
+				s.Set([]byte("foo"), []byte("bar"))
+				s.Set([]byte("foo"), []byte("b"))
+			},
+			expBytes: 4,
+		},
+		"delete frees quota": {
+			maxStateSize: 6,
+			do: func(s *contractStateStore) {
+				s.Set([]byte("foo"), []byte("bar"))
+				s.Delete([]byte("foo"))
+				s.Set([]byte("baz"), []byte("bar"))
+			},
+			expBytes: 6,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			store := k.contractStore(ctx, contractAddr, spec.maxStateSize)
+
+			// when
+			spec.do(store)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, store.Err(), spec.expErr)
+			} else {
+				require.NoError(t, store.Err())
+			}
+			assert.Equal(t, spec.expBytes, k.GetContractStorageUsage(ctx, contractAddr).StoredBytes)
+		})
+	}
+}
+
+func TestStateQuotaEnforcedOnMigrate(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	storedBytes := k.GetContractStorageUsage(parentCtx, example.Contract).StoredBytes
+	require.NotZero(t, storedBytes)
+	// a longer verifier address grows the contract state
+	migMsgBz, err := json.Marshal(struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: bytes.Repeat([]byte{1}, 32)})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		maxStateSize uint64
+		expErr       *errorsmod.Error
+	}{
+		"no quota": {},
+		"quota with headroom": {
+			maxStateSize: storedBytes + 100,
+		},
+		"quota reached": {
+			maxStateSize: storedBytes,
+			expErr:       types.ErrStateQuotaExceeded,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			require.NoError(t, k.setContractStateQuota(ctx, example.Contract, example.CreatorAddr, spec.maxStateSize, GovAuthorizationPolicy{}))
+
+			// when
+			_, gotErr := k.migrate(ctx, example.Contract, example.CreatorAddr, example.CodeID, migMsgBz, DefaultAuthorizationPolicy{})
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Equal(t, storedBytes, k.GetContractStorageUsage(ctx, example.Contract).StoredBytes)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Greater(t, k.GetContractStorageUsage(ctx, example.Contract).StoredBytes, storedBytes)
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+var _ wasmvm.KVStore = &contractStateStore{}
+
+// contractStateStore bridges the contract state to wasmvm and accounts the bytes that the contract stores.
+// The size of an existing entry is read from an unmetered store so that the gas consumption of the
+// contract is not changed by the accounting.
+// A write that is rejected, for example when it exceeds the state quota, can not abort the contract
+// execution from within the store. The first error is recorded instead and must be checked via Err after
+// the contract call.
+type contractStateStore struct {
+	*types.StoreAdapter
+	unmetered storetypes.KVStore
+	onChange  func(delta int64) error
+	err       error
+}
+
+func (s *contractStateStore) Set(key, value []byte) {
+	delta := int64(len(key) + len(value))
+	if old := s.unmetered.Get(key); old != nil {
+		delta -= int64(len(key) + len(old))
+	}
+	if delta != 0 {
+		if err := s.onChange(delta); err != nil {
+			s.setErr(err)
+			return
+		}
+	}
+	s.StoreAdapter.Set(key, value)
+}
+
+func (s *contractStateStore) Delete(key []byte) {
+	old := s.unmetered.Get(key)
+	s.StoreAdapter.Delete(key)
+	if old != nil {
+		s.setErr(s.onChange(-int64(len(key) + len(old))))
+	}
+}
+
+func (s *contractStateStore) setErr(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// Err returns the first error of a rejected write or nil
+func (s *contractStateStore) Err() error {
+	return s.err
+}
+
+// contractStore returns the prefixed store of the contract state that accounts the stored bytes and rejects
+// writes that would exceed the max state size. Zero for no limit.
+// 0x03 | BuildContractAddressClassic (sdk.AccAddress)
+func (k Keeper) contractStore(ctx sdk.Context, contractAddress sdk.AccAddress, maxStateSize uint64) *contractStateStore {
+	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
+	unmeteredCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	return &contractStateStore{
+		StoreAdapter: types.NewStoreAdapter(prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey)),
+		unmetered:    prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(unmeteredCtx)), prefixStoreKey),
+		onChange: func(delta int64) error {
+			return k.addContractStoredBytes(unmeteredCtx, contractAddress, delta, maxStateSize)
+		},
+	}
+}
+
+// addContractStoredBytes updates the stored bytes of the contract. The stored bytes can not drop below zero.
+// An increase beyond the max state size is rejected with ErrStateQuotaExceeded. Zero for no limit.
+func (k Keeper) addContractStoredBytes(ctx sdk.Context, contractAddress sdk.AccAddress, delta int64, maxStateSize uint64) error {
+	usage := k.GetContractStorageUsage(ctx, contractAddress)
+	switch {
+	case delta >= 0:
+		if maxStateSize != 0 && usage.StoredBytes+uint64(delta) > maxStateSize {
+			return errorsmod.Wrapf(types.ErrStateQuotaExceeded, "state size %d exceeds max of %d bytes", usage.StoredBytes+uint64(delta), maxStateSize)
+		}
+		usage.StoredBytes += uint64(delta)
+	case uint64(-delta) > usage.StoredBytes:
+		usage.StoredBytes = 0
+	default:
+		usage.StoredBytes -= uint64(-delta)
+	}
+	return k.storeContractStorageUsage(ctx, usage)
+}
+
+// setContractStoredBytes sets the stored bytes of the contract. It is used to account state that was written
+// before the accounting started.
+func (k Keeper) setContractStoredBytes(ctx context.Context, contractAddress sdk.AccAddress, storedBytes uint64) error {
+	usage := k.GetContractStorageUsage(ctx, contractAddress)
+	usage.StoredBytes = storedBytes
+	return k.storeContractStorageUsage(ctx, usage)
+}
+
+// settleStorageDeposit adjusts the deposit held for the contract state to the stored bytes and the current
+// deposit per byte. The missing amount is pulled from the sponsor or the contract balance when no sponsor is set.
+// An excess is refunded to the same account.
+func (k Keeper) settleStorageDeposit(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	unmeteredCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	usage := k.GetContractStorageUsage(unmeteredCtx, contractAddress)
+	required := k.GetParams(unmeteredCtx).StorageDeposit(usage.StoredBytes)
+	held := usage.Deposit.Min(required)
+	missing, excess := required.Sub(held...), usage.Deposit.Sub(held...)
+	if missing.IsZero() && excess.IsZero() {
+		return nil
+	}
+	payer := usage.DepositPayer()
+	if !missing.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, missing); err != nil {
+			return errorsmod.Wrap(err, "storage deposit")
+		}
+		k.emitActionEvent(ctx, sdk.NewEvent(
+			types.EventTypeStorageDeposit,
+			sdk.NewAttribute(types.AttributeKeyContractAddr, usage.Contract),
+			sdk.NewAttribute(types.AttributeKeyDepositPayer, payer.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, missing.String()),
+			sdk.NewAttribute(types.AttributeKeyStoredBytes, strconv.FormatUint(usage.StoredBytes, 10)),
+		), &types.EventStorageDeposit{
+			Contract:    usage.Contract,
+			Payer:       payer.String(),
+			Amount:      missing,
+			StoredBytes: usage.StoredBytes,
+		})
+	}
+	if !excess.IsZero() {
+		if err := k.refundStorageDeposit(ctx, usage, excess); err != nil {
+			return err
+		}
+	}
+	usage.Deposit = required
+	return k.storeContractStorageUsage(ctx, usage)
+}
+
+// refundStorageDeposit sends the amount from the deposit back to the account that paid it
+func (k Keeper) refundStorageDeposit(ctx sdk.Context, usage types.ContractStorageUsage, amount sdk.Coins) error {
+	recipient := usage.DepositPayer()
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return errorsmod.Wrap(err, "storage deposit refund")
+	}
+	k.emitActionEvent(ctx, sdk.NewEvent(
+		types.EventTypeStorageRefund,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, usage.Contract),
+		sdk.NewAttribute(types.AttributeKeyRefundRecipient, recipient.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	), &types.EventStorageRefund{Contract: usage.Contract, Recipient: recipient.String(), Amount: amount})
+	return nil
+}
+
+// sponsorContractStorage makes the sponsor pay the storage deposit of the contract. The deposit held so far is
+// refunded to the previous payer and the full deposit is pulled from the new sponsor.
+// The sponsor must be authorized to modify the contract, which is the contract admin with the default policy.
+// An existing sponsor is not displaced but has to be cleared before.
+func (k Keeper) sponsorContractStorage(ctx context.Context, contractAddress, sponsor sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), sponsor) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not sponsor contract storage")
+	}
+	usage := k.GetContractStorageUsage(sdkCtx, contractAddress)
+	if usage.Sponsor != "" && usage.Sponsor != sponsor.String() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "storage sponsor already set")
+	}
+	// the old deposit is only refunded when the sponsor can pay the new one
+	cacheCtx, commit := sdkCtx.CacheContext()
+	if !usage.Deposit.IsZero() {
+		if err := k.refundStorageDeposit(cacheCtx, usage, usage.Deposit); err != nil {
+			return err
+		}
+	}
+	usage.Deposit = nil
+	usage.Sponsor = sponsor.String()
+	if err := k.storeContractStorageUsage(cacheCtx, usage); err != nil {
+		return err
+	}
+	if err := k.settleStorageDeposit(cacheCtx, contractAddress); err != nil {
+		return err
+	}
+	commit()
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
+		types.EventTypeUpdateStorageSponsor,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyStorageSponsor, usage.Sponsor),
+	), &types.EventUpdateStorageSponsor{Contract: contractAddress.String(), Sponsor: usage.Sponsor})
+	return nil
+}
+
+// clearContractStorageSponsor refunds the storage deposit to the sponsor and removes it. The deposit is pulled
+// from the contract balance on the next contract execution then.
+func (k Keeper) clearContractStorageSponsor(ctx context.Context, contractAddress, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	usage := k.GetContractStorageUsage(sdkCtx, contractAddress)
+	if usage.Sponsor == "" {
+		return errorsmod.Wrap(types.ErrNotFound, "storage sponsor")
+	}
+	if usage.Sponsor != caller.String() && !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not clear storage sponsor")
+	}
+	if !usage.Deposit.IsZero() {
+		if err := k.refundStorageDeposit(sdkCtx, usage, usage.Deposit); err != nil {
+			return err
+		}
+	}
+	usage.Deposit = nil
+	usage.Sponsor = ""
+	if err := k.storeContractStorageUsage(sdkCtx, usage); err != nil {
+		return err
+	}
+	k.emitActionEvent(sdkCtx, sdk.NewEvent(
+		types.EventTypeUpdateStorageSponsor,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyStorageSponsor, ""),
+	), &types.EventUpdateStorageSponsor{Contract: contractAddress.String()})
+	return nil
+}
+
+// GetContractStorageUsage returns the storage usage of the contract. An empty usage is returned when
+// nothing was accounted for the contract, yet.
+func (k Keeper) GetContractStorageUsage(ctx context.Context, contractAddress sdk.AccAddress) types.ContractStorageUsage {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetContractStorageUsageKey(contractAddress))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return types.ContractStorageUsage{Contract: contractAddress.String()}
+	}
+	var usage types.ContractStorageUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage
+}
+
+// IterateContractStorageUsages iterates over all storage usages.
+func (k Keeper) IterateContractStorageUsages(ctx context.Context, cb func(types.ContractStorageUsage) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ContractStorageUsagePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var usage types.ContractStorageUsage
+		k.cdc.MustUnmarshal(iter.Value(), &usage)
+		if cb(usage) {
+			return
+		}
+	}
+}
+
+// storeContractStorageUsage persists the storage usage. Empty usages are removed.
+func (k Keeper) storeContractStorageUsage(ctx context.Context, usage types.ContractStorageUsage) error {
+	contractAddress, err := sdk.AccAddressFromBech32(usage.Contract)
+	if err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if usage.StoredBytes == 0 && usage.Deposit.IsZero() && usage.Sponsor == "" {
+		return store.Delete(types.GetContractStorageUsageKey(contractAddress))
+	}
+	return store.Set(types.GetContractStorageUsageKey(contractAddress), k.cdc.MustMarshal(&usage))
+}
+
+func (k Keeper) importContractStorageUsage(ctx context.Context, usage types.ContractStorageUsage) error {
+	contractAddress := sdk.MustAccAddressFromBech32(usage.Contract)
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return types.ErrNoSuchContractFn(usage.Contract).Wrapf("address %s", usage.Contract)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	ok, err := store.Has(types.GetContractStorageUsageKey(contractAddress))
+	if err != nil {
+		return err
+	}
+	if ok {
+		return errorsmod.Wrapf(types.ErrDuplicate, "storage usage: %s", usage.Contract)
+	}
+	return k.storeContractStorageUsage(ctx, usage)
+}
@@ -0,0 +1,445 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestContractStoreTracksStoredBytes(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		do       func(ctx sdk.Context, s wasmvm.KVStore)
+		expBytes uint64
+	}{
+		"new entry": {
+			do: func(_ sdk.Context, s wasmvm.KVStore) {
+				s.Set([]byte("foo"), []byte("bar"))
+			},
+			expBytes: 6,
+		},
+		"overwrite with longer value": {
+			do: func(_ sdk.Context, s wasmvm.KVStore) {
+				s.Set([]byte("foo"), []byte("bar"))
+				s.Set([]byte("foo"), []byte("barbaz"))
+			},
+			expBytes: 9,
+		},
+		"overwrite with shorter value": {
+			do: func(_ sdk.Context, s wasmvm.KVStore) {
+				s.Set([]byte("foo"), []byte("barbaz"))
+				s.Set([]byte("foo"), []byte("b"))
+			},
+			expBytes: 4,
+		},
+		"delete": {
+			do: func(_ sdk.Context, s wasmvm.KVStore) {
+				s.Set([]byte("foo"), []byte("bar"))
+				s.Set([]byte("other"), []byte("bar"))
+				s.Delete([]byte("foo"))
+			},
+			expBytes: 8,
+		},
+		"delete unknown key": {
+			do: func(_ sdk.Context, s wasmvm.KVStore) {
+				s.Delete([]byte("foo"))
+			},
+			expBytes: 0,
+		},
+		"delete state written before tracking": {
+			do: func(ctx sdk.Context, s wasmvm.KVStore) {
+				s.Set([]byte("foo"), []byte("bar"))
+				untracked := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractStorePrefix(contractAddr))
+				untracked.Set([]byte("untracked"), []byte("bar"))
+				s.Delete([]byte("untracked"))
+			},
+			expBytes: 0,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+
+			// when
+			spec.do(ctx, k.contractStore(ctx, contractAddr, 0))
+
+			// then
+			assert.Equal(t, spec.expBytes, k.GetContractStorageUsage(ctx, contractAddr).StoredBytes)
+		})
+	}
+}
+
+func TestContractStoreGasConsumption(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+	write := func(s wasmvm.KVStore) {
+		s.Set([]byte("foo"), []byte("bar"))
+		s.Set([]byte("foo"), []byte("barbaz"))
+		s.Delete([]byte("foo"))
+	}
+
+	ctx, _ := parentCtx.CacheContext()
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	write(types.NewStoreAdapter(prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractStorePrefix(contractAddr))))
+	expGas := ctx.GasMeter().GasConsumed()
+
+	// when
+	ctx, _ = parentCtx.CacheContext()
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	write(k.contractStore(ctx, contractAddr, 0))
+
+	// then
+	assert.Equal(t, expGas, ctx.GasMeter().GasConsumed())
+}
+
+func TestSettleStorageDeposit(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	sponsor := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 1000))
+	// the module account must exist before it is funded
+	moduleAddr := keepers.AccountKeeper.GetModuleAccount(parentCtx, types.ModuleName).GetAddress()
+	keepers.Faucet.Fund(parentCtx, moduleAddr, sdk.NewInt64Coin("denom", 1000), sdk.NewInt64Coin("other", 1000))
+	oneDenomPerByte := sdk.NewInt64Coin("denom", 1)
+
+	specs := map[string]struct {
+		usage      types.ContractStorageUsage
+		price      *sdk.Coin
+		expDeposit sdk.Coins
+		expPayer   sdk.AccAddress
+		expPayment sdk.Coins
+		expRefund  sdk.Coins
+		expErr     *errorsmod.Error
+	}{
+		"deposit pulled from contract": {
+			usage:      types.ContractStorageUsage{StoredBytes: 10},
+			price:      &oneDenomPerByte,
+			expDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			expPayer:   example.Contract,
+			expPayment: sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+		},
+		"deposit pulled from sponsor": {
+			usage:      types.ContractStorageUsage{StoredBytes: 10, Sponsor: sponsor.String()},
+			price:      &oneDenomPerByte,
+			expDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			expPayer:   sponsor,
+			expPayment: sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+		},
+		"missing amount pulled": {
+			usage:      types.ContractStorageUsage{StoredBytes: 10, Deposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 4))},
+			price:      &oneDenomPerByte,
+			expDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			expPayer:   example.Contract,
+			expPayment: sdk.NewCoins(sdk.NewInt64Coin("denom", 6)),
+		},
+		"excess refunded": {
+			usage:      types.ContractStorageUsage{StoredBytes: 10, Deposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 15))},
+			price:      &oneDenomPerByte,
+			expDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			expPayer:   example.Contract,
+			expRefund:  sdk.NewCoins(sdk.NewInt64Coin("denom", 5)),
+		},
+		"other denom refunded": {
+			usage:      types.ContractStorageUsage{StoredBytes: 10, Deposit: sdk.NewCoins(sdk.NewInt64Coin("other", 10))},
+			price:      &oneDenomPerByte,
+			expDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			expPayer:   example.Contract,
+			expPayment: sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			expRefund:  sdk.NewCoins(sdk.NewInt64Coin("other", 10)),
+		},
+		"all refunded when deposit disabled": {
+			usage:     types.ContractStorageUsage{StoredBytes: 10, Deposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 10)), Sponsor: sponsor.String()},
+			expPayer:  sponsor,
+			expRefund: sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+		},
+		"nothing to settle": {
+			usage:      types.ContractStorageUsage{StoredBytes: 10, Deposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 10))},
+			price:      &oneDenomPerByte,
+			expDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			expPayer:   example.Contract,
+		},
+		"insufficient funds": {
+			usage:  types.ContractStorageUsage{StoredBytes: 1_000_000},
+			price:  &oneDenomPerByte,
+			expErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			params := types.DefaultParams()
+			params.StorageDepositPerByte = spec.price
+			require.NoError(t, k.SetParams(ctx, params))
+			spec.usage.Contract = example.Contract.String()
+			require.NoError(t, k.storeContractStorageUsage(ctx, spec.usage))
+			payerBalance := keepers.BankKeeper.GetAllBalances(ctx, spec.usage.DepositPayer())
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.settleStorageDeposit(ctx.WithEventManager(em), example.Contract)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expDeposit, k.GetContractStorageUsage(ctx, example.Contract).Deposit)
+			expBalance := payerBalance.Sub(spec.expPayment...).Add(spec.expRefund...)
+			assert.Equal(t, expBalance, keepers.BankKeeper.GetAllBalances(ctx, spec.expPayer))
+			var gotEventTypes []string
+			for _, e := range em.Events() {
+				gotEventTypes = append(gotEventTypes, e.Type)
+			}
+			if !spec.expPayment.IsZero() {
+				assert.Contains(t, gotEventTypes, types.EventTypeStorageDeposit)
+			}
+			if !spec.expRefund.IsZero() {
+				assert.Contains(t, gotEventTypes, types.EventTypeStorageRefund)
+			}
+		})
+	}
+}
+
+func TestInstantiateWithStorageDeposit(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+	params := types.DefaultParams()
+	oneDenomPerByte := sdk.NewInt64Coin("denom", 1)
+	params.StorageDepositPerByte = &oneDenomPerByte
+	require.NoError(t, k.SetParams(parentCtx, params))
+
+	specs := map[string]struct {
+		funds  sdk.Coins
+		expErr *errorsmod.Error
+	}{
+		"deposit paid from contract funds": {
+			funds: sdk.NewCoins(sdk.NewInt64Coin("denom", 1000)),
+		},
+		"insufficient contract funds": {
+			funds:  sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			expErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+
+			// when
+			contractAddr, _, gotErr := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsgBz, "label", spec.funds)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var expBytes uint64
+			k.IterateContractState(ctx, contractAddr, func(key, value []byte) bool {
+				expBytes += uint64(len(key) + len(value))
+				return false
+			})
+			usage := k.GetContractStorageUsage(ctx, contractAddr)
+			require.NotZero(t, expBytes)
+			assert.Equal(t, expBytes, usage.StoredBytes)
+			expDeposit := sdk.NewCoins(sdk.NewInt64Coin("denom", int64(expBytes)))
+			assert.Equal(t, expDeposit, usage.Deposit)
+			assert.Equal(t, spec.funds.Sub(expDeposit...), keepers.BankKeeper.GetAllBalances(ctx, contractAddr))
+		})
+	}
+}
+
+func TestSponsorContractStorage(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	oldSponsor := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 1000))
+	newSponsor := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 1000))
+	params := types.DefaultParams()
+	oneDenomPerByte := sdk.NewInt64Coin("denom", 1)
+	params.StorageDepositPerByte = &oneDenomPerByte
+	require.NoError(t, k.SetParams(parentCtx, params))
+	require.NoError(t, k.storeContractStorageUsage(parentCtx, types.ContractStorageUsage{
+		Contract:    example.Contract.String(),
+		StoredBytes: 10,
+	}))
+
+	specs := map[string]struct {
+		oldSponsor sdk.AccAddress
+		sponsor    sdk.AccAddress
+		contract   sdk.AccAddress
+		authZ      types.AuthorizationPolicy
+		expErr     *errorsmod.Error
+	}{
+		"contract admin": {
+			sponsor:  example.CreatorAddr,
+			contract: example.Contract,
+			authZ:    DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			sponsor:  newSponsor,
+			contract: example.Contract,
+			authZ:    GovAuthorizationPolicy{},
+		},
+		"existing sponsor renews": {
+			oldSponsor: example.CreatorAddr,
+			sponsor:    example.CreatorAddr,
+			contract:   example.Contract,
+			authZ:      DefaultAuthorizationPolicy{},
+		},
+		"existing sponsor not displaced": {
+			oldSponsor: oldSponsor,
+			sponsor:    newSponsor,
+			contract:   example.Contract,
+			authZ:      GovAuthorizationPolicy{},
+			expErr:     sdkerrors.ErrInvalidRequest,
+		},
+		"other sender": {
+			sponsor:  newSponsor,
+			contract: example.Contract,
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"sponsor without funds": {
+			sponsor:  RandomAccountAddress(t),
+			contract: example.Contract,
+			authZ:    GovAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrInsufficientFunds,
+		},
+		"unknown contract": {
+			sponsor:  newSponsor,
+			contract: RandomAccountAddress(t),
+			authZ:    GovAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if spec.oldSponsor != nil {
+				require.NoError(t, k.sponsorContractStorage(ctx, example.Contract, spec.oldSponsor, GovAuthorizationPolicy{}))
+			}
+			balanceBefore := keepers.BankKeeper.GetBalance(ctx, spec.sponsor, "denom")
+			if spec.oldSponsor.Equals(spec.sponsor) {
+				balanceBefore = balanceBefore.AddAmount(sdkmath.NewInt(10))
+			}
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.sponsorContractStorage(ctx.WithEventManager(em), spec.contract, spec.sponsor, spec.authZ)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Equal(t, spec.oldSponsor.String(), k.GetContractStorageUsage(ctx, example.Contract).Sponsor)
+				return
+			}
+			require.NoError(t, gotErr)
+			exp := types.ContractStorageUsage{
+				Contract:    example.Contract.String(),
+				StoredBytes: 10,
+				Deposit:     sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+				Sponsor:     spec.sponsor.String(),
+			}
+			assert.Equal(t, exp, k.GetContractStorageUsage(ctx, example.Contract))
+			assert.Equal(t, balanceBefore.SubAmount(sdkmath.NewInt(10)), keepers.BankKeeper.GetBalance(ctx, spec.sponsor, "denom"))
+			gotEvents := em.Events()
+			require.True(t, len(gotEvents) >= 2)
+			assert.Equal(t, types.EventTypeUpdateStorageSponsor, gotEvents[len(gotEvents)-2].Type)
+			assert.Equal(t, proto.MessageName(&types.EventUpdateStorageSponsor{}), gotEvents[len(gotEvents)-1].Type)
+		})
+	}
+}
+
+func TestClearContractStorageSponsor(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	sponsor := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 1000))
+	params := types.DefaultParams()
+	oneDenomPerByte := sdk.NewInt64Coin("denom", 1)
+	params.StorageDepositPerByte = &oneDenomPerByte
+	require.NoError(t, k.SetParams(parentCtx, params))
+	require.NoError(t, k.storeContractStorageUsage(parentCtx, types.ContractStorageUsage{
+		Contract:    example.Contract.String(),
+		StoredBytes: 10,
+	}))
+	require.NoError(t, k.sponsorContractStorage(parentCtx, example.Contract, sponsor, GovAuthorizationPolicy{}))
+	otherAddr := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		sender sdk.AccAddress
+		authZ  types.AuthorizationPolicy
+		expErr *errorsmod.Error
+	}{
+		"sponsor": {
+			sender: sponsor,
+			authZ:  DefaultAuthorizationPolicy{},
+		},
+		"contract admin": {
+			sender: example.CreatorAddr,
+			authZ:  DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			sender: otherAddr,
+			authZ:  GovAuthorizationPolicy{},
+		},
+		"other sender": {
+			sender: otherAddr,
+			authZ:  DefaultAuthorizationPolicy{},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.clearContractStorageSponsor(ctx.WithEventManager(em), example.Contract, spec.sender, spec.authZ)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Equal(t, sponsor.String(), k.GetContractStorageUsage(ctx, example.Contract).Sponsor)
+				return
+			}
+			require.NoError(t, gotErr)
+			exp := types.ContractStorageUsage{
+				Contract:    example.Contract.String(),
+				StoredBytes: 10,
+			}
+			assert.Equal(t, exp, k.GetContractStorageUsage(ctx, example.Contract))
+			assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 1000)), keepers.BankKeeper.GetAllBalances(ctx, sponsor))
+			gotEvents := em.Events()
+			require.True(t, len(gotEvents) >= 2)
+			assert.Equal(t, types.EventTypeUpdateStorageSponsor, gotEvents[len(gotEvents)-2].Type)
+			assert.Equal(t, proto.MessageName(&types.EventUpdateStorageSponsor{}), gotEvents[len(gotEvents)-1].Type)
+			// nothing left to clear
+			require.ErrorIs(t, k.clearContractStorageSponsor(ctx, example.Contract, spec.sender, spec.authZ), types.ErrNotFound)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"errors"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// tracerName is the instrumentation scope of the wasm spans
+const tracerName = "github.com/CosmWasm/wasmd/x/wasm"
+
+// span attribute keys
+const (
+	attrKeyContract    = "wasm.contract"
+	attrKeyCodeID      = "wasm.code_id"
+	attrKeyChecksum    = "wasm.checksum"
+	attrKeyGasUsed     = "wasm.gas_used"
+	attrKeyWasmGasUsed = "wasm.vm_gas_used"
+	attrKeyMsgType     = "wasm.msg_type"
+	attrKeyQueryType   = "wasm.query_type"
+)
+
+// tracingSpan is an open span that records the sdk gas consumed until it is ended
+type tracingSpan struct {
+	trace.Span
+	gasMeter  storetypes.GasMeter
+	gasBefore uint64
+}
+
+// startSpan starts a span as child of the span in the context. The returned context carries the new span.
+// A noop span is returned when no tracer is set.
+func startSpan(ctx sdk.Context, tracer trace.Tracer, name string, attrs ...attribute.KeyValue) (sdk.Context, *tracingSpan) {
+	if tracer == nil {
+		return ctx, &tracingSpan{Span: noop.Span{}}
+	}
+	goCtx, span := tracer.Start(ctx.Context(), name, trace.WithAttributes(attrs...))
+	return ctx.WithContext(goCtx), &tracingSpan{Span: span, gasMeter: ctx.GasMeter(), gasBefore: ctx.GasMeter().GasConsumed()}
+}
+
+// startSpan starts a span for a contract call in the keeper
+func (k Keeper) startSpan(ctx sdk.Context, name string, contractAddr sdk.AccAddress, attrs ...attribute.KeyValue) (sdk.Context, *tracingSpan) {
+	return startSpan(ctx, k.tracer, name, append(attrs, attribute.String(attrKeyContract, contractAddr.String()))...)
+}
+
+// setCodeID adds the code id attribute
+func (s *tracingSpan) setCodeID(codeID uint64) {
+	s.SetAttributes(attribute.Int64(attrKeyCodeID, int64(codeID)))
+}
+
+// end records the sdk gas consumed and the error status and ends the span
+func (s *tracingSpan) end(err error) {
+	if !s.IsRecording() {
+		return
+	}
+	if gas := s.gasMeter.GasConsumed(); gas > s.gasBefore {
+		s.SetAttributes(attribute.Int64(attrKeyGasUsed, int64(gas-s.gasBefore)))
+	}
+	endSpan(s.Span, err)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+var _ WasmCallObserver = wasmVMSpans{}
+
+// wasmVMSpans starts a span for each call into the wasm engine
+type wasmVMSpans struct {
+	tracer trace.Tracer
+}
+
+// StartCall starts the span with the context of the querier and returns a querier with the new span as parent for
+// the queries of the contract
+func (s wasmVMSpans) StartCall(entrypoint string, checksum wasmvm.Checksum, querier wasmvm.Querier) (wasmvm.Querier, func(gasUsed uint64, err error, contractErr string)) {
+	q, ok := querier.(QueryHandler)
+	if !ok {
+		return querier, func(uint64, error, string) {}
+	}
+	ctx, span := startSpan(q.Ctx, s.tracer, "wasmvm."+entrypoint,
+		attribute.String(attrKeyContract, q.Caller.String()),
+		attribute.String(attrKeyChecksum, checksum.String()),
+	)
+	if codeID, ok := types.CodeIDFromContext(q.Ctx); ok {
+		span.setCodeID(codeID)
+	}
+	q.Ctx = ctx
+	return q, func(gasUsed uint64, err error, contractErr string) {
+		span.SetAttributes(attribute.Int64(attrKeyWasmGasUsed, int64(gasUsed)))
+		if err == nil && contractErr != "" {
+			err = errors.New(contractErr)
+		}
+		endSpan(span.Span, err)
+	}
+}
+
+var _ Messenger = tracingMessenger{}
+
+// tracingMessenger decorates a messenger to start a span for each message dispatched
+type tracingMessenger struct {
+	Messenger
+	tracer trace.Tracer
+}
+
+func (m tracingMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	ctx, span := startSpan(ctx, m.tracer, "dispatch-msg",
+		attribute.String(attrKeyContract, contractAddr.String()),
+		attribute.String(attrKeyMsgType, traceName(msg)),
+	)
+	defer func() { span.end(err) }()
+	return m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+var _ WasmVMQueryHandler = tracingQueryHandler{}
+
+// tracingQueryHandler decorates a query handler to start a span for each query plugin call
+type tracingQueryHandler struct {
+	WasmVMQueryHandler
+	tracer trace.Tracer
+}
+
+func (h tracingQueryHandler) HandleQuery(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) (_ []byte, err error) {
+	ctx, span := startSpan(ctx, h.tracer, "query-plugin",
+		attribute.String(attrKeyContract, caller.String()),
+		attribute.String(attrKeyQueryType, traceName(request)),
+	)
+	defer func() { span.end(err) }()
+	return h.WasmVMQueryHandler.HandleQuery(ctx, caller, request)
+}
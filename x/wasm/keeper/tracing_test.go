@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTracingSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithTracerProvider(tp))
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+
+	specs := map[string]struct {
+		sender   sdk.AccAddress
+		expSpans []string
+		expErr   bool
+	}{
+		// hackatom queries the contract balance before it sends it to the beneficiary
+		"execute with message dispatched": {
+			sender:   example.VerifierAddr,
+			expSpans: []string{"query-plugin", "wasmvm.execute", "dispatch-msg", "execute"},
+		},
+		"execute fails": {
+			sender:   example.CreatorAddr,
+			expSpans: []string{"wasmvm.execute", "execute"},
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			recorder.Reset()
+			ctx, _ := parentCtx.CacheContext()
+
+			// when
+			_, gotErr := k.execute(ctx, example.Contract, spec.sender, []byte(`{"release":{}}`), nil)
+
+			// then
+			spans := recorder.Ended()
+			gotNames := make([]string, len(spans))
+			for i, s := range spans {
+				gotNames[i] = s.Name()
+			}
+			require.Equal(t, spec.expSpans, gotNames)
+			root := spans[len(spans)-1]
+			spanIDs := make(map[string]trace.SpanID, len(spans))
+			for _, s := range spans {
+				spanIDs[s.Name()] = s.SpanContext().SpanID()
+			}
+			for _, s := range spans[:len(spans)-1] {
+				// queries are made by the contract while it is executed in the vm
+				expParent := root.SpanContext().SpanID()
+				if s.Name() == "query-plugin" {
+					expParent = spanIDs["wasmvm.execute"]
+				}
+				assert.Equal(t, expParent, s.Parent().SpanID())
+			}
+			assert.Contains(t, root.Attributes(), attribute.String(attrKeyContract, example.Contract.String()))
+			assert.Contains(t, root.Attributes(), attribute.Int64(attrKeyCodeID, int64(example.CodeID)))
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Equal(t, codes.Error, root.Status().Code)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, codes.Unset, root.Status().Code)
+			var gasRecorded bool
+			for _, a := range root.Attributes() {
+				gasRecorded = gasRecorded || (a.Key == attrKeyGasUsed && a.Value.AsInt64() > 0)
+			}
+			assert.True(t, gasRecorded)
+			assert.Contains(t, spans[2].Attributes(), attribute.String(attrKeyMsgType, "bank/send"))
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package wasmtesting
 
 import (
+	"context"
+
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 
 	storetypes "cosmossdk.io/store/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
 // MockGasRegister mock that implements keeper.GasRegister
@@ -57,3 +61,15 @@ func (m MockGasRegister) FromWasmVMGas(source uint64) storetypes.Gas {
 	}
 	return m.FromWasmVMGasFn(source)
 }
+
+// MockGasRegisterSource mock that implements keeper.GasRegisterSource
+type MockGasRegisterSource struct {
+	GetEffectiveGasRegisterFn func(ctx context.Context) types.GasRegister
+}
+
+func (m MockGasRegisterSource) GetEffectiveGasRegister(ctx context.Context) types.GasRegister {
+	if m.GetEffectiveGasRegisterFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetEffectiveGasRegisterFn(ctx)
+}
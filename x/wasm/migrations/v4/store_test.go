@@ -0,0 +1,40 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate4To5(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.BuiltInCapabilities())
+	wasmKeeper := keepers.WasmKeeper
+
+	example1 := keeper.StoreHackatomExampleContract(t, ctx, keepers)
+	example2 := keeper.StoreHackatomExampleContract(t, ctx, keepers)
+	example3 := keeper.StoreBurnerExampleContract(t, ctx, keepers)
+
+	// remove keys
+	for _, e := range []keeper.ExampleContract{example1, example2, example3} {
+		ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetCodeByChecksumIndexKey(e.Checksum, e.CodeID))
+	}
+
+	// migrator
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate4to5(ctx)
+	require.NoError(t, err)
+
+	// check new store
+	codesByChecksum := func(checksum []byte) []uint64 {
+		var codeIDs []uint64
+		wasmKeeper.IterateCodesByChecksum(ctx, checksum, func(codeID uint64) bool {
+			codeIDs = append(codeIDs, codeID)
+			return false
+		})
+		return codeIDs
+	}
+	require.Equal(t, []uint64{example1.CodeID, example2.CodeID}, codesByChecksum(example1.Checksum))
+	require.Equal(t, []uint64{example3.CodeID}, codesByChecksum(example3.Checksum))
+}
@@ -0,0 +1,36 @@
+package v5
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// wasmKeeper abstract keeper
+type wasmKeeper interface {
+	GetParams(ctx context.Context) types.Params
+	SetParams(ctx context.Context, ps types.Params) error
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper wasmKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate5to6 migrates from version 5 to 6. The size and address count limits that were set by compile flags
+// before are stored in the params.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MaxWasmSize = uint64(types.MaxWasmSize)
+	params.MaxProposalWasmSize = uint64(types.MaxProposalWasmSize)
+	params.MaxLabelSize = uint64(types.MaxLabelSize)
+	params.MaxAddressCount = uint64(types.MaxAddressCount)
+	return m.keeper.SetParams(ctx, params)
+}
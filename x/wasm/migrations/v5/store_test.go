@@ -0,0 +1,37 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate5To6(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.BuiltInCapabilities())
+	wasmKeeper := keepers.WasmKeeper
+
+	oldParams := types.Params{
+		CodeUploadAccess:             types.AllowNobody,
+		InstantiateDefaultPermission: types.AccessTypeAnyOfAddresses,
+	}
+	require.NoError(t, wasmKeeper.SetParams(ctx, oldParams))
+
+	// migrator
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate5to6(ctx)
+	require.NoError(t, err)
+
+	// check new store
+	exp := types.Params{
+		CodeUploadAccess:             types.AllowNobody,
+		InstantiateDefaultPermission: types.AccessTypeAnyOfAddresses,
+		MaxWasmSize:                  uint64(types.MaxWasmSize),
+		MaxProposalWasmSize:          uint64(types.MaxProposalWasmSize),
+		MaxLabelSize:                 uint64(types.MaxLabelSize),
+		MaxAddressCount:              uint64(types.MaxAddressCount),
+	}
+	assert.Equal(t, exp, wasmKeeper.GetParams(ctx))
+}
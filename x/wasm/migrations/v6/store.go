@@ -0,0 +1,46 @@
+package v6
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// SetStoredBytesFn sets the number of bytes that the contract stores in its state
+type SetStoredBytesFn func(ctx context.Context, contractAddress sdk.AccAddress, storedBytes uint64) error
+
+// wasmKeeper abstract keeper
+type wasmKeeper interface {
+	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, types.ContractInfo) bool)
+	IterateContractState(ctx context.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper           wasmKeeper
+	setStoredBytesFn SetStoredBytesFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, fn SetStoredBytesFn) Migrator {
+	return Migrator{keeper: k, setStoredBytesFn: fn}
+}
+
+// Migrate6to7 migrates from version 6 to 7. The stored bytes of all contracts are computed from the
+// contract state that was written before the accounting started. No storage deposit is pulled here but
+// on the next execution of the contract.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	var err error
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, _ types.ContractInfo) bool {
+		var storedBytes uint64
+		m.keeper.IterateContractState(ctx, contractAddr, func(key, value []byte) bool {
+			storedBytes += uint64(len(key) + len(value))
+			return false
+		})
+		err = m.setStoredBytesFn(ctx, contractAddr, storedBytes)
+		return err != nil
+	})
+	return err
+}
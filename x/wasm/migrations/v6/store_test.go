@@ -0,0 +1,47 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate6To7(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.BuiltInCapabilities())
+	wasmKeeper := keepers.WasmKeeper
+
+	creator := keeper.RandomAccountAddress(t)
+	example := keeper.StoreHackatomExampleContract(t, ctx, keepers)
+	initMsgBz := keeper.HackatomExampleInitMsg{
+		Verifier:    keeper.RandomAccountAddress(t),
+		Beneficiary: keeper.RandomAccountAddress(t),
+	}.GetBytes(t)
+
+	expStoredBytes := make(map[string]uint64)
+	for i := 0; i < 3; i++ {
+		contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, nil, initMsgBz, "my contract", nil)
+		require.NoError(t, err)
+		usage := wasmKeeper.GetContractStorageUsage(ctx, contractAddr)
+		require.NotZero(t, usage.StoredBytes)
+		expStoredBytes[contractAddr.String()] = usage.StoredBytes
+		// remove key
+		ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetContractStorageUsageKey(contractAddr))
+	}
+
+	// migrator
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate6to7(ctx)
+	require.NoError(t, err)
+
+	// check new store
+	for addr, exp := range expStoredBytes {
+		got := wasmKeeper.GetContractStorageUsage(ctx, sdk.MustAccAddressFromBech32(addr))
+		assert.Equal(t, exp, got.StoredBytes)
+		assert.Empty(t, got.Deposit)
+	}
+}
@@ -0,0 +1,41 @@
+package v7
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// AddToSecondIndexFn creates a secondary index entry for the admin of the contract
+type AddToSecondIndexFn func(ctx context.Context, adminAddress sdk.AccAddress, position *types.AbsoluteTxPosition, contractAddress sdk.AccAddress) error
+
+// wasmKeeper abstract keeper
+type wasmKeeper interface {
+	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, types.ContractInfo) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper             wasmKeeper
+	addToSecondIndexFn AddToSecondIndexFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, fn AddToSecondIndexFn) Migrator {
+	return Migrator{keeper: k, addToSecondIndexFn: fn}
+}
+
+// Migrate7to8 migrates from version 7 to 8. The contracts-by-admin index is backfilled for all contracts with an admin.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	var err error
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, contractInfo types.ContractInfo) bool {
+		if contractInfo.Admin == "" {
+			return false
+		}
+		err = m.addToSecondIndexFn(ctx, contractInfo.AdminAddr(), contractInfo.Created, contractAddr)
+		return err != nil
+	})
+	return err
+}
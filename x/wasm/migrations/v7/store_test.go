@@ -0,0 +1,51 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate7To8(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.BuiltInCapabilities())
+	wasmKeeper := keepers.WasmKeeper
+
+	creator := keeper.RandomAccountAddress(t)
+	admin := keeper.RandomAccountAddress(t)
+	example := keeper.StoreHackatomExampleContract(t, ctx, keepers)
+	initMsgBz := keeper.HackatomExampleInitMsg{
+		Verifier:    keeper.RandomAccountAddress(t),
+		Beneficiary: keeper.RandomAccountAddress(t),
+	}.GetBytes(t)
+
+	var withAdmin []string
+	for i, a := range []sdk.AccAddress{admin, nil, admin} {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, a, initMsgBz, "demo contract", nil)
+		require.NoError(t, err, i)
+		if a == nil {
+			continue
+		}
+		withAdmin = append(withAdmin, contractAddr.String())
+		// remove key
+		info := wasmKeeper.GetContractInfo(ctx, contractAddr)
+		ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetContractByAdminSecondaryIndexKey(admin, info.Created.Bytes(), contractAddr))
+	}
+
+	// migrator
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate7to8(ctx)
+	require.NoError(t, err)
+
+	// check new store
+	var allContract []string
+	wasmKeeper.IterateContractsByAdmin(ctx, admin, func(addr sdk.AccAddress) bool {
+		allContract = append(allContract, addr.String())
+		return false
+	})
+	require.Equal(t, withAdmin, allContract)
+}
@@ -0,0 +1,42 @@
+package v8
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// AddToCreatorIndexFn creates a secondary index entry for the creator of the code
+type AddToCreatorIndexFn func(ctx context.Context, creator sdk.AccAddress, codeID uint64) error
+
+// wasmKeeper abstract keeper
+type wasmKeeper interface {
+	IterateCodeInfos(ctx context.Context, cb func(uint64, types.CodeInfo) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper              wasmKeeper
+	addToCreatorIndexFn AddToCreatorIndexFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, fn AddToCreatorIndexFn) Migrator {
+	return Migrator{keeper: k, addToCreatorIndexFn: fn}
+}
+
+// Migrate8to9 migrates from version 8 to 9. The creator secondary index is built for all stored codes.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	var err error
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, codeInfo types.CodeInfo) bool {
+		var creator sdk.AccAddress
+		if creator, err = sdk.AccAddressFromBech32(codeInfo.Creator); err != nil {
+			return true
+		}
+		err = m.addToCreatorIndexFn(ctx, creator, codeID)
+		return err != nil
+	})
+	return err
+}
@@ -0,0 +1,47 @@
+package v8_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate8To9(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.BuiltInCapabilities())
+	wasmKeeper := keepers.WasmKeeper
+
+	myCreator, otherCreator := keeper.RandomAccountAddress(t), keeper.RandomAccountAddress(t)
+	expCodeIDs := make(map[string][]uint64)
+	for _, creator := range []sdk.AccAddress{myCreator, otherCreator, myCreator} {
+		codeID, _, err := keepers.ContractKeeper.Create(ctx, creator, testdata.HackatomContractWasm(), nil)
+		require.NoError(t, err)
+		expCodeIDs[creator.String()] = append(expCodeIDs[creator.String()], codeID)
+		// remove the index entry to simulate a code stored before the index existed
+		ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetCodeByCreatorIndexKey(creator, codeID))
+	}
+	codesByCreator := func(creator sdk.AccAddress) []uint64 {
+		var codeIDs []uint64
+		wasmKeeper.IterateCodesByCreator(ctx, creator, func(codeID uint64) bool {
+			codeIDs = append(codeIDs, codeID)
+			return false
+		})
+		return codeIDs
+	}
+	require.Empty(t, codesByCreator(myCreator))
+	require.Empty(t, codesByCreator(otherCreator))
+
+	// when
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate8to9(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, expCodeIDs[myCreator.String()], codesByCreator(myCreator))
+	assert.Equal(t, expCodeIDs[otherCreator.String()], codesByCreator(otherCreator))
+}
@@ -0,0 +1,38 @@
+package v9
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// AddToLabelIndexFn creates a secondary index entry for the label of the contract
+type AddToLabelIndexFn func(ctx context.Context, label string, contractAddress sdk.AccAddress) error
+
+// wasmKeeper abstract keeper
+type wasmKeeper interface {
+	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, types.ContractInfo) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper            wasmKeeper
+	addToLabelIndexFn AddToLabelIndexFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, fn AddToLabelIndexFn) Migrator {
+	return Migrator{keeper: k, addToLabelIndexFn: fn}
+}
+
+// Migrate9to10 migrates from version 9 to 10. The label index is built for all contracts.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	var err error
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, contractInfo types.ContractInfo) bool {
+		err = m.addToLabelIndexFn(ctx, contractInfo.Label, contractAddr)
+		return err != nil
+	})
+	return err
+}
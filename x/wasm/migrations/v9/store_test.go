@@ -0,0 +1,54 @@
+package v9_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate9To10(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.BuiltInCapabilities())
+	wasmKeeper := keepers.WasmKeeper
+
+	creator := keeper.RandomAccountAddress(t)
+	example := keeper.StoreHackatomExampleContract(t, ctx, keepers)
+	initMsgBz := keeper.HackatomExampleInitMsg{
+		Verifier:    keeper.RandomAccountAddress(t),
+		Beneficiary: keeper.RandomAccountAddress(t),
+	}.GetBytes(t)
+
+	contracts := make(map[string][]string)
+	for _, label := range []string{"my contract", "other contract", "my contract"} {
+		contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, nil, initMsgBz, label, nil)
+		require.NoError(t, err)
+		contracts[label] = append(contracts[label], contractAddr.String())
+		// remove the index entry to simulate a contract instantiated before the index existed
+		ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetContractByLabelIndexKey(label, contractAddr))
+	}
+	contractsByLabel := func(label string) []string {
+		var got []string
+		wasmKeeper.IterateContractsByLabel(ctx, label, func(addr sdk.AccAddress) bool {
+			got = append(got, addr.String())
+			return false
+		})
+		return got
+	}
+	for label := range contracts {
+		require.Empty(t, contractsByLabel(label))
+	}
+
+	// when
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate9to10(ctx)
+
+	// then
+	require.NoError(t, err)
+	for label, exp := range contracts {
+		assert.ElementsMatch(t, exp, contractsByLabel(label))
+	}
+}
@@ -42,8 +42,15 @@ var (
 const (
 	flagWasmMemoryCacheSize        = "wasm.memory_cache_size"
 	flagWasmQueryGasLimit          = "wasm.query_gas_limit"
+	flagWasmQueryCacheSize         = "wasm.query_cache_size"
 	flagWasmSimulationGasLimit     = "wasm.simulation_gas_limit"
 	flagWasmSkipWasmVMVersionCheck = "wasm.skip_wasmvm_version_check"
+	flagWasmMetricsCodeIDs         = "wasm.metrics_code_ids"
+	flagWasmTracingExporter        = "wasm.tracing_exporter"
+	flagWasmTracingEndpoint        = "wasm.tracing_endpoint"
+	flagWasmDisableLegacyEvents    = "wasm.disable_legacy_events"
+	flagWasmIndexerEnabled         = "wasm.indexer_enabled"
+	flagWasmIndexerRetainBlocks    = "wasm.indexer_retain_blocks"
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -99,7 +106,10 @@ func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry)
 }
 
 // ____________________________________________________________________________
-var _ appmodule.AppModule = AppModule{}
+var (
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+)
 
 // AppModule implements an application module for the wasm module.
 type AppModule struct {
@@ -148,7 +158,7 @@ func (am AppModule) IsAppModule() { // marker
 // module. It should be incremented on each consensus-breaking change
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 4 }
+func (AppModule) ConsensusVersion() uint64 { return 10 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
@@ -167,6 +177,30 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 8, m.Migrate8to9)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 9, m.Migrate9to10)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants registers the wasm module invariants.
@@ -196,6 +230,16 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// EndBlock executes the scheduled contract callbacks and pending migrations that are due, removes the wasm blobs
+// of deleted codes from the wasmvm cache and prunes abandoned code upload sessions.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.keeper.ExecuteDueCallbacks(ctx)
+	am.keeper.ExecuteDueMigrations(ctx)
+	am.keeper.RemoveDeletedCodes(ctx)
+	am.keeper.PruneExpiredCodeUploads(ctx)
+	return nil
+}
+
 // ____________________________________________________________________________
 
 // AppModuleSimulation functions
@@ -226,6 +270,7 @@ func AddModuleInitFlags(startCmd *cobra.Command) {
 	defaults := types.DefaultNodeConfig()
 	startCmd.Flags().Uint32(flagWasmMemoryCacheSize, defaults.MemoryCacheSize, "Sets the size in MiB (NOT bytes) of an in-memory cache for Wasm modules. Set to 0 to disable.")
 	startCmd.Flags().Uint64(flagWasmQueryGasLimit, defaults.SmartQueryGasLimit, "Set the max gas that can be spent on executing a query with a Wasm contract")
+	startCmd.Flags().Uint32(flagWasmQueryCacheSize, defaults.SmartQueryCacheSize, "Set the max number of smart query results of the latest height that are cached. Set to 0 to disable.")
 	startCmd.Flags().String(flagWasmSimulationGasLimit, "", "Set the max gas that can be spent when executing a simulation TX")
 	startCmd.Flags().Bool(flagWasmSkipWasmVMVersionCheck, false, "Skip check that ensures that libwasmvm version (the Rust project) and wasmvm version (the Go project) match")
 
@@ -257,6 +302,11 @@ func ReadNodeConfig(opts servertypes.AppOptions) (types.NodeConfig, error) {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagWasmQueryCacheSize); v != nil {
+		if cfg.SmartQueryCacheSize, err = cast.ToUint32E(v); err != nil {
+			return cfg, err
+		}
+	}
 	if v := opts.Get(flagWasmSimulationGasLimit); v != nil {
 		if raw, ok := v.(string); !ok || raw != "" {
 			limit, err := cast.ToUint64E(v) // non empty string set
@@ -266,6 +316,48 @@ func ReadNodeConfig(opts servertypes.AppOptions) (types.NodeConfig, error) {
 			cfg.SimulationGasLimit = &limit
 		}
 	}
+	if v := opts.Get(flagWasmMetricsCodeIDs); v != nil {
+		ids, err := cast.ToIntSliceE(v)
+		if err != nil {
+			return cfg, err
+		}
+		for _, id := range ids {
+			if id < 0 {
+				return cfg, fmt.Errorf("invalid metrics code id: %d", id)
+			}
+			cfg.MetricsCodeIDs = append(cfg.MetricsCodeIDs, uint64(id))
+		}
+	}
+	if v := opts.Get(flagWasmTracingExporter); v != nil {
+		if cfg.TracingExporter, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+		switch cfg.TracingExporter {
+		case "", types.TracingExporterFile, types.TracingExporterOTLP:
+		default:
+			return cfg, fmt.Errorf("unknown tracing exporter: %q", cfg.TracingExporter)
+		}
+	}
+	if v := opts.Get(flagWasmTracingEndpoint); v != nil {
+		if cfg.TracingEndpoint, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmDisableLegacyEvents); v != nil {
+		if cfg.DisableLegacyEvents, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmIndexerEnabled); v != nil {
+		if cfg.IndexerEnabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmIndexerRetainBlocks); v != nil {
+		if cfg.IndexerRetainBlocks, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
 	// attach contract debugging to global "trace" flag
 	if v := opts.Get(server.FlagTrace); v != nil {
 		if cfg.ContractDebugMode, err = cast.ToBoolE(v); err != nil {
@@ -0,0 +1,206 @@
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// recordSpans exports a parent span and a failed child span
+func recordSpans(t *testing.T, exporter sdktrace.SpanExporter) {
+	t.Helper()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	tracer := tp.Tracer("testing")
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, child := tracer.Start(ctx, "child")
+	child.SetAttributes(attribute.String("wasm.contract", "myContract"), attribute.Int64("wasm.code_id", 1))
+	child.SetStatus(codes.Error, "testing")
+	child.End()
+	parent.End()
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
+
+// fileSpan is the part of the stdouttrace span json that is checked in the tests
+type fileSpan struct {
+	Name        string
+	SpanContext fileSpanContext
+	Parent      fileSpanContext
+	Attributes  []struct {
+		Key   string
+		Value struct{ Value any }
+	}
+	Status struct {
+		Code        string
+		Description string
+	}
+}
+
+type fileSpanContext struct {
+	TraceID string
+	SpanID  string
+}
+
+func TestFileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spans.jsonl")
+	exporter, err := NewFileExporter(path)
+	require.NoError(t, err)
+
+	// when
+	recordSpans(t, exporter)
+
+	// then
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var spans []fileSpan
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var s fileSpan
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &s))
+		spans = append(spans, s)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, spans, 2)
+	child, parent := spans[0], spans[1]
+	assert.Equal(t, "child", child.Name)
+	assert.Equal(t, "parent", parent.Name)
+	assert.Equal(t, parent.SpanContext.SpanID, child.Parent.SpanID)
+	assert.Equal(t, parent.SpanContext.TraceID, child.SpanContext.TraceID)
+	assert.Equal(t, "0000000000000000", parent.Parent.SpanID)
+	gotAttrs := make(map[string]any, len(child.Attributes))
+	for _, a := range child.Attributes {
+		gotAttrs[a.Key] = a.Value.Value
+	}
+	assert.Equal(t, map[string]any{"wasm.contract": "myContract", "wasm.code_id": float64(1)}, gotAttrs)
+	assert.Equal(t, "Error", child.Status.Code)
+	assert.Equal(t, "testing", child.Status.Description)
+}
+
+func TestOTLPExporter(t *testing.T) {
+	specs := map[string]struct {
+		status int
+		path   string
+		expErr bool
+	}{
+		"default path": {
+			status: http.StatusOK,
+		},
+		"custom path": {
+			status: http.StatusOK,
+			path:   "/custom/traces",
+		},
+		"endpoint fails": {
+			status: http.StatusBadRequest,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotPath string
+			gotReq := &coltracepb.ExportTraceServiceRequest{}
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+				bz, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.NoError(t, proto.Unmarshal(bz, gotReq))
+				w.WriteHeader(spec.status)
+			}))
+			defer srv.Close()
+			exporter, err := NewOTLPExporter(srv.URL + spec.path)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = exporter.Shutdown(context.Background()) })
+
+			recorder := tracetest.NewSpanRecorder()
+			_, span := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("testing").Start(context.Background(), "myspan")
+			span.SetAttributes(attribute.Int64("wasm.code_id", 1))
+			span.SetStatus(codes.Error, "testing")
+			span.End()
+
+			// when
+			gotErr := exporter.ExportSpans(context.Background(), recorder.Ended())
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			expPath := spec.path
+			if expPath == "" {
+				expPath = otlpTracesPath
+			}
+			assert.Equal(t, expPath, gotPath)
+			require.Len(t, gotReq.ResourceSpans, 1)
+			require.Len(t, gotReq.ResourceSpans[0].ScopeSpans, 1)
+			scopeSpans := gotReq.ResourceSpans[0].ScopeSpans[0]
+			assert.Equal(t, "testing", scopeSpans.Scope.Name)
+			require.Len(t, scopeSpans.Spans, 1)
+			gotSpan := scopeSpans.Spans[0]
+			assert.Equal(t, "myspan", gotSpan.Name)
+			assert.Len(t, gotSpan.TraceId, 16)
+			assert.Len(t, gotSpan.SpanId, 8)
+			assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, gotSpan.Status.Code)
+			assert.Equal(t, "testing", gotSpan.Status.Message)
+			require.Len(t, gotSpan.Attributes, 1)
+			assert.Equal(t, "wasm.code_id", gotSpan.Attributes[0].Key)
+			assert.Equal(t, int64(1), gotSpan.Attributes[0].Value.GetIntValue())
+		})
+	}
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	specs := map[string]struct {
+		cfg    types.NodeConfig
+		expErr bool
+	}{
+		"file": {
+			cfg: types.NodeConfig{TracingExporter: types.TracingExporterFile, TracingEndpoint: filepath.Join(t.TempDir(), "spans.jsonl")},
+		},
+		"otlp": {
+			cfg: types.NodeConfig{TracingExporter: types.TracingExporterOTLP, TracingEndpoint: "http://localhost:4318"},
+		},
+		"otlp invalid scheme": {
+			cfg:    types.NodeConfig{TracingExporter: types.TracingExporterOTLP, TracingEndpoint: "localhost:4318"},
+			expErr: true,
+		},
+		"empty endpoint": {
+			cfg:    types.NodeConfig{TracingExporter: types.TracingExporterFile},
+			expErr: true,
+		},
+		"unknown exporter": {
+			cfg:    types.NodeConfig{TracingExporter: "other", TracingEndpoint: "foo"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			tp, gotErr := NewTracerProvider(spec.cfg)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.NotNil(t, tp)
+			assert.NoError(t, tp.Shutdown(context.Background()))
+		})
+	}
+}
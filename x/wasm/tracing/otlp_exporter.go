@@ -0,0 +1,29 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+)
+
+// otlpTracesPath is the default path of the OTLP/HTTP traces endpoint
+const otlpTracesPath = "/v1/traces"
+
+// NewOTLPExporter returns an exporter that sends the spans to an OTLP/HTTP endpoint using the protobuf encoding.
+// The default traces path "/v1/traces" is used when the endpoint url has no path.
+func NewOTLPExporter(endpoint string) (*otlptrace.Exporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported otlp endpoint scheme: %q", u.Scheme)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = otlpTracesPath
+	}
+	return otlptracehttp.New(context.Background(), otlptracehttp.WithEndpointURL(u.String()))
+}
@@ -0,0 +1,41 @@
+package tracing
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// serviceName is the name of the service in the resource of the exported spans
+const serviceName = "wasmd"
+
+// NewTracerProvider returns a tracer provider that exports the spans with the exporter from the node config.
+// The provider must be shut down on exit to flush the pending spans.
+func NewTracerProvider(cfg types.NodeConfig) (*sdktrace.TracerProvider, error) {
+	if cfg.TracingEndpoint == "" {
+		return nil, fmt.Errorf("tracing endpoint must not be empty for the %q exporter", cfg.TracingExporter)
+	}
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch cfg.TracingExporter {
+	case types.TracingExporterFile:
+		exporter, err = NewFileExporter(cfg.TracingEndpoint)
+	case types.TracingExporterOTLP:
+		exporter, err = NewOTLPExporter(cfg.TracingEndpoint)
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter: %q", cfg.TracingExporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	), nil
+}
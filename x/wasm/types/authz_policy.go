@@ -30,6 +30,14 @@ type AuthorizationPolicy interface {
 	CanInstantiateContract(c AccessConfig, actor types.AccAddress) bool
 	CanModifyContract(admin, actor types.AccAddress) bool
 	CanModifyCodeAccessConfig(creator, actor types.AccAddress, isSubset bool) bool
+	CanDeleteCode(creator, actor types.AccAddress) bool
+	CanMigrateFrozenContract(actor types.AccAddress) bool
+	// CanAcceptAdmin returns true when the actor can complete a pending admin transfer to the proposed admin
+	CanAcceptAdmin(proposedAdmin, actor types.AccAddress) bool
+	// CanBypassMigrationDelay returns true when the actor can migrate a contract without the delay or lower the delay
+	CanBypassMigrationDelay(actor types.AccAddress) bool
+	// CanRaiseStateQuota returns true when the actor can raise or remove the state size quota of a contract
+	CanRaiseStateQuota(actor types.AccAddress) bool
 	// SubMessageAuthorizationPolicy returns authorization policy to be used for submessages. Must never be nil
 	SubMessageAuthorizationPolicy(entrypoint AuthorizationPolicyAction) AuthorizationPolicy
 }
@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// call trace node types
+const (
+	CallTraceTypeEntrypoint = "entrypoint"
+	CallTraceTypeSubmessage = "submessage"
+	CallTraceTypeQuery      = "query"
+)
+
+// CallTraceNode is a contract entrypoint call, submessage or query in the call tree of a simulated tx
+type CallTraceNode struct {
+	Type string `json:"type"`
+	// Name is the entrypoint or the kind of message or query, for example "execute" or "bank/send"
+	Name     string `json:"name"`
+	Contract string `json:"contract,omitempty"`
+	// SubMsgID and ReplyOn are set for submessages only
+	SubMsgID uint64 `json:"submsg_id,omitempty"`
+	ReplyOn  string `json:"reply_on,omitempty"`
+	// GasUsed is the sdk gas consumed by the call including all nested calls
+	GasUsed uint64 `json:"gas_used"`
+	// WasmGasUsed is the gas reported by wasmvm for the contract execution, not including nested calls
+	WasmGasUsed uint64           `json:"wasm_gas_used,omitempty"`
+	Events      sdk.StringEvents `json:"events,omitempty"`
+	Error       string           `json:"error,omitempty"`
+	// RedactedError is the error text as it is returned to the calling contract. It is set for submessages and
+	// queries only.
+	RedactedError string           `json:"redacted_error,omitempty"`
+	Children      []*CallTraceNode `json:"children,omitempty"`
+}
+
+// CallTracer records the call tree of a simulated tx. It is passed with the context to nested calls.
+type CallTracer struct {
+	root  *CallTraceNode
+	stack []*CallTraceNode
+}
+
+// NewCallTracer constructor
+func NewCallTracer() *CallTracer {
+	return &CallTracer{}
+}
+
+// Enter adds the node as child of the current node and makes it the current node
+func (t *CallTracer) Enter(node *CallTraceNode) {
+	if len(t.stack) == 0 {
+		t.root = node
+	} else {
+		parent := t.stack[len(t.stack)-1]
+		parent.Children = append(parent.Children, node)
+	}
+	t.stack = append(t.stack, node)
+}
+
+// Exit makes the parent of the current node the current node again
+func (t *CallTracer) Exit() {
+	if len(t.stack) != 0 {
+		t.stack = t.stack[:len(t.stack)-1]
+	}
+}
+
+// AddWasmGas adds the gas reported by wasmvm to the current node
+func (t *CallTracer) AddWasmGas(gas uint64) {
+	if len(t.stack) != 0 {
+		t.stack[len(t.stack)-1].WasmGasUsed += gas
+	}
+}
+
+// Root returns the first node entered or nil
+func (t *CallTracer) Root() *CallTraceNode {
+	return t.root
+}
@@ -28,6 +28,23 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRemoveCodeUploadParamsAddresses{}, "wasm/MsgRemoveCodeUploadParamsAddresses", nil)
 	cdc.RegisterConcrete(&MsgStoreAndMigrateContract{}, "wasm/MsgStoreAndMigrateContract", nil)
 	cdc.RegisterConcrete(&MsgUpdateContractLabel{}, "wasm/MsgUpdateContractLabel", nil)
+	cdc.RegisterConcrete(&MsgDeleteCode{}, "wasm/MsgDeleteCode", nil)
+	cdc.RegisterConcrete(&MsgFreezeContract{}, "wasm/MsgFreezeContract", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeContract{}, "wasm/MsgUnfreezeContract", nil)
+	cdc.RegisterConcrete(&MsgRegisterCallback{}, "wasm/MsgRegisterCallback", nil)
+	cdc.RegisterConcrete(&MsgCancelCallback{}, "wasm/MsgCancelCallback", nil)
+	cdc.RegisterConcrete(&MsgProposeAdmin{}, "wasm/MsgProposeAdmin", nil)
+	cdc.RegisterConcrete(&MsgAcceptAdmin{}, "wasm/MsgAcceptAdmin", nil)
+	cdc.RegisterConcrete(&MsgCancelAdminProposal{}, "wasm/MsgCancelAdminProposal", nil)
+	cdc.RegisterConcrete(&MsgUpdateMigrationDelay{}, "wasm/MsgUpdateMigrationDelay", nil)
+	cdc.RegisterConcrete(&MsgCancelMigration{}, "wasm/MsgCancelMigration", nil)
+	cdc.RegisterConcrete(&MsgMigrateContractsByCode{}, "wasm/MsgMigrateContractsByCode", nil)
+	cdc.RegisterConcrete(&MsgInstantiateContractByChecksum{}, "wasm/MsgInstantiateContractByChecksum", nil)
+	cdc.RegisterConcrete(&MsgStoreCodeChunk{}, "wasm/MsgStoreCodeChunk", nil)
+	cdc.RegisterConcrete(&MsgFinalizeCodeUpload{}, "wasm/MsgFinalizeCodeUpload", nil)
+	cdc.RegisterConcrete(&MsgSponsorContractStorage{}, "wasm/MsgSponsorContractStorage", nil)
+	cdc.RegisterConcrete(&MsgClearContractStorageSponsor{}, "wasm/MsgClearContractStorageSponsor", nil)
+	cdc.RegisterConcrete(&MsgUpdateStateQuota{}, "wasm/MsgUpdateStateQuota", nil)
 
 	cdc.RegisterInterface((*ContractInfoExtension)(nil), nil)
 
@@ -83,6 +100,23 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgRemoveCodeUploadParamsAddresses{},
 		&MsgStoreAndMigrateContract{},
 		&MsgUpdateContractLabel{},
+		&MsgDeleteCode{},
+		&MsgFreezeContract{},
+		&MsgUnfreezeContract{},
+		&MsgRegisterCallback{},
+		&MsgCancelCallback{},
+		&MsgProposeAdmin{},
+		&MsgAcceptAdmin{},
+		&MsgCancelAdminProposal{},
+		&MsgUpdateMigrationDelay{},
+		&MsgCancelMigration{},
+		&MsgMigrateContractsByCode{},
+		&MsgInstantiateContractByChecksum{},
+		&MsgStoreCodeChunk{},
+		&MsgFinalizeCodeUpload{},
+		&MsgSponsorContractStorage{},
+		&MsgClearContractStorageSponsor{},
+		&MsgUpdateStateQuota{},
 	)
 	registry.RegisterInterface("cosmwasm.wasm.v1.ContractInfoExtension", (*ContractInfoExtension)(nil))
 
@@ -26,6 +26,15 @@ const (
 
 	// contextKeyExecModeSimulation contextKey = iota
 	_
+
+	// call tracer of a simulated tx
+	contextKeyCallTracer contextKey = iota
+
+	// code id of the contract called by the wasm engine
+	contextKeyCodeID contextKey = iota
+
+	// events of the submessage are passed to the calling contract
+	contextKeyContractReplyEvents contextKey = iota
 )
 
 // WithTXCounter stores a transaction counter value in the context
@@ -101,3 +110,40 @@ func TxContractsFromContext(ctx context.Context) (TxContracts, bool) {
 	val, ok := ctx.Value(contextKeyTxContracts).(TxContracts)
 	return val, ok
 }
+
+// WithCallTracer stores the call tracer into the context returned
+func WithCallTracer(ctx sdk.Context, t *CallTracer) sdk.Context {
+	if t == nil {
+		panic("call tracer must not be nil")
+	}
+	return ctx.WithValue(contextKeyCallTracer, t)
+}
+
+// CallTracerFromContext reads the call tracer from the context
+func CallTracerFromContext(ctx context.Context) (*CallTracer, bool) {
+	val, ok := ctx.Value(contextKeyCallTracer).(*CallTracer)
+	return val, ok
+}
+
+// WithCodeID stores the code id of the called contract into the context returned
+func WithCodeID(ctx sdk.Context, codeID uint64) sdk.Context {
+	return ctx.WithValue(contextKeyCodeID, codeID)
+}
+
+// CodeIDFromContext reads the code id of the called contract from the context
+func CodeIDFromContext(ctx context.Context) (uint64, bool) {
+	val, ok := ctx.Value(contextKeyCodeID).(uint64)
+	return val, ok
+}
+
+// WithContractReplyEvents marks the context of a submessage which events are passed to the calling contract in the
+// reply. The legacy events are always emitted then.
+func WithContractReplyEvents(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(contextKeyContractReplyEvents, true)
+}
+
+// ContractReplyEvents returns true when the events are passed to the calling contract
+func ContractReplyEvents(ctx context.Context) bool {
+	val, _ := ctx.Value(contextKeyContractReplyEvents).(bool)
+	return val
+}
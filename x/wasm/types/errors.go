@@ -1,9 +1,13 @@
 package types
 
 import (
+	"encoding/json"
+
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 
 	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Codes for wasm contract errors
@@ -92,6 +96,18 @@ var (
 
 	// ErrExceedMaxCallDepth error if max message stack size is exceeded
 	ErrExceedMaxCallDepth = errorsmod.Register(DefaultCodespace, 30, "max call depth exceeded")
+
+	// ErrCodeInUse error if a code is still referenced by a contract
+	ErrCodeInUse = errorsmod.Register(DefaultCodespace, 31, "code in use")
+
+	// ErrContractFrozen error if a frozen contract is called
+	ErrContractFrozen = errorsmod.Register(DefaultCodespace, 32, "contract frozen")
+
+	// ErrStateQuotaExceeded error if a contract writes more state than its quota allows
+	ErrStateQuotaExceeded = errorsmod.Register(DefaultCodespace, 33, "state quota exceeded")
+
+	// ErrDuplicateLabel error if a contract label is used already while unique contract labels are enforced
+	ErrDuplicateLabel = errorsmod.Register(DefaultCodespace, 34, "duplicate contract label")
 )
 
 // WasmVMErrorable mapped error type in wasmvm and are not redacted
@@ -186,3 +202,65 @@ func (e DeterministicError) Unwrap() error {
 func (e DeterministicError) Cause() error {
 	return e.Unwrap()
 }
+
+// RedactedError is the deterministic error that a contract receives instead of the error text when a submessage
+// or a query fails. It is json encoded in the error string so that contracts can decode it.
+type RedactedError struct {
+	Codespace string `json:"codespace"`
+	Code      uint32 `json:"code"`
+	// Reason is a short description that is set for the allowlisted errors only
+	Reason string `json:"reason,omitempty"`
+}
+
+var _ error = RedactedError{}
+
+// NewRedactedError constructor. The reason text is taken from the allowlist and not from the error, so that it
+// does not change with the wording of the error in a dependency update.
+func NewRedactedError(codespace string, code uint32) RedactedError {
+	r := RedactedError{Codespace: codespace, Code: code}
+	for _, a := range redactedErrorReasons {
+		if a.err.Codespace() == codespace && a.err.ABCICode() == code {
+			r.Reason = a.reason
+			break
+		}
+	}
+	return r
+}
+
+// Error returns the json encoded error
+func (e RedactedError) Error() string {
+	bz, err := json.Marshal(e)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// redactedErrorReasons is the allowlist of errors that get a reason in the redacted error. Entries must not be
+// changed as the reason is part of the contract execution.
+var redactedErrorReasons = []struct {
+	err    *errorsmod.Error
+	reason string
+}{
+	{err: sdkerrors.ErrUnauthorized, reason: "unauthorized"},
+	{err: sdkerrors.ErrInsufficientFunds, reason: "insufficient funds"},
+	{err: sdkerrors.ErrUnknownRequest, reason: "unknown request"},
+	{err: sdkerrors.ErrInvalidAddress, reason: "invalid address"},
+	{err: sdkerrors.ErrUnknownAddress, reason: "unknown address"},
+	{err: sdkerrors.ErrInvalidCoins, reason: "invalid coins"},
+	{err: sdkerrors.ErrOutOfGas, reason: "out of gas"},
+	{err: sdkerrors.ErrInsufficientFee, reason: "insufficient fee"},
+	{err: sdkerrors.ErrInvalidRequest, reason: "invalid request"},
+	{err: sdkerrors.ErrInvalidType, reason: "invalid type"},
+	{err: sdkerrors.ErrConflict, reason: "conflict"},
+	{err: sdkerrors.ErrNotSupported, reason: "not supported"},
+	{err: sdkerrors.ErrNotFound, reason: "not found"},
+	{err: ErrNotFound, reason: "not found"},
+	{err: ErrInvalidMsg, reason: "invalid message"},
+	{err: ErrUnknownMsg, reason: "unknown message"},
+	{err: ErrExceedMaxQueryStackSize, reason: "max query stack size exceeded"},
+	{err: ErrExceedMaxCallDepth, reason: "max call depth exceeded"},
+	{err: ErrContractFrozen, reason: "contract frozen"},
+	{err: ErrStateQuotaExceeded, reason: "state quota exceeded"},
+	{err: ErrDuplicateLabel, reason: "duplicate contract label"},
+}
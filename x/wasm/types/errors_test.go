@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestWasmVMFlavouredError(t *testing.T) {
@@ -110,3 +113,44 @@ func TestDeterministicError(t *testing.T) {
 	assert.Equal(t, innerCodeSpace, codespace)
 	assert.Equal(t, innerCode, code)
 }
+
+func TestRedactedError(t *testing.T) {
+	specs := map[string]struct {
+		codespace string
+		code      uint32
+		exp       string
+	}{
+		"allowlisted sdk error": {
+			codespace: sdkerrors.ErrInsufficientFunds.Codespace(),
+			code:      sdkerrors.ErrInsufficientFunds.ABCICode(),
+			exp:       `{"codespace":"sdk","code":5,"reason":"insufficient funds"}`,
+		},
+		"allowlisted wasm error": {
+			codespace: ErrExceedMaxCallDepth.Codespace(),
+			code:      ErrExceedMaxCallDepth.ABCICode(),
+			exp:       `{"codespace":"wasm","code":30,"reason":"max call depth exceeded"}`,
+		},
+		"not allowlisted": {
+			codespace: ErrInstantiateFailed.Codespace(),
+			code:      ErrInstantiateFailed.ABCICode(),
+			exp:       `{"codespace":"wasm","code":4}`,
+		},
+		"unknown codespace": {
+			codespace: "other",
+			code:      5,
+			exp:       `{"codespace":"other","code":5}`,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotErr := NewRedactedError(spec.codespace, spec.code)
+
+			// then
+			assert.Equal(t, spec.exp, gotErr.Error())
+			var decoded RedactedError
+			require.NoError(t, json.Unmarshal([]byte(gotErr.Error()), &decoded))
+			assert.Equal(t, gotErr, decoded)
+		})
+	}
+}
@@ -21,12 +21,32 @@ const (
 	EventTypeMigrate                = "migrate"
 	EventTypePinCode                = "pin_code"
 	EventTypeUnpinCode              = "unpin_code"
+	EventTypeDeleteCode             = "delete_code"
 	EventTypeSudo                   = "sudo"
 	EventTypeReply                  = "reply"
 	EventTypeGovContractResult      = "gov_contract_result"
 	EventTypeUpdateContractAdmin    = "update_contract_admin"
 	EventTypeUpdateContractLabel    = "update_contract_label"
 	EventTypeUpdateCodeAccessConfig = "update_code_access_config"
+	EventTypeFreezeContract         = "freeze_contract"
+	EventTypeUnfreezeContract       = "unfreeze_contract"
+	EventTypeRegisterCallback       = "register_callback"
+	EventTypeCancelCallback         = "cancel_callback"
+	EventTypeCallback               = "callback"
+	EventTypeProposeContractAdmin   = "propose_contract_admin"
+	EventTypeCancelAdminProposal    = "cancel_contract_admin_proposal"
+	EventTypeUpdateMigrationDelay   = "update_contract_migration_delay"
+	EventTypeScheduleMigration      = "schedule_migration"
+	EventTypeCancelMigration        = "cancel_migration"
+	EventTypeScheduledMigration     = "scheduled_migration"
+	EventTypeStoreCodeChunk         = "store_code_chunk"
+	EventTypePruneCodeUpload        = "prune_code_upload"
+	EventTypeStorageDeposit         = "storage_deposit"
+	EventTypeStorageRefund          = "storage_refund"
+	EventTypeUpdateStorageSponsor   = "update_contract_storage_sponsor"
+	EventTypeUpdateStateQuota       = "update_contract_state_quota"
+	EventTypeCallTrace              = "call_trace"
+	EventTypeSubMsgError            = "submsg_error"
 	EventTypePacketRecv             = "ibc_packet_received"
 	// add new types to IsAcceptedEventOnRecvPacketErrorAck
 )
@@ -77,4 +97,24 @@ const (
 	AttributeKeyAuthorizedAddresses = "authorized_addresses"
 	AttributeKeyAckSuccess          = "success"
 	AttributeKeyAckError            = "error"
+	AttributeKeyCallbackID          = "callback_id"
+	AttributeKeyCallbackSuccess     = "success"
+	AttributeKeyCallbackError       = "error"
+	AttributeKeyProposedAdmin       = "proposed_admin_address"
+	AttributeKeyExpiryHeight        = "expiry_height"
+	AttributeKeyMigrationDelay      = "migration_delay"
+	AttributeKeyExecuteHeight       = "execute_height"
+	AttributeKeyMigrationSuccess    = "success"
+	AttributeKeyMigrationError      = "error"
+	AttributeKeyUploadID            = "upload_id"
+	AttributeKeyChunkIndex          = "chunk_index"
+	AttributeKeyStoredBytes         = "stored_bytes"
+	AttributeKeyStorageSponsor      = "sponsor"
+	AttributeKeyDepositPayer        = "payer"
+	AttributeKeyRefundRecipient     = "recipient"
+	AttributeKeyMaxStateSize        = "max_state_size"
+	AttributeKeyCallTrace           = "trace"
+	AttributeKeySubMsgID            = "submsg_id"
+	AttributeKeyRedactedError       = "redacted_error"
+	AttributeKeySubMsgError         = "error"
 )
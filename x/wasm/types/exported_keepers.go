@@ -16,10 +16,13 @@ type ViewKeeper interface {
 	GetContractHistory(ctx context.Context, contractAddr sdk.AccAddress) []ContractCodeHistoryEntry
 	QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
 	QueryRaw(ctx context.Context, contractAddress sdk.AccAddress, key []byte) []byte
+	QueryRawRange(ctx context.Context, contractAddress sdk.AccAddress, start, end []byte, limit uint16, reverse bool) (results []wasmvmtypes.RawRangeEntry, nextKey []byte)
 	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
 	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *ContractInfo
 	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, ContractInfo) bool)
 	IterateContractsByCreator(ctx context.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool)
+	IterateContractsByAdmin(ctx context.Context, admin sdk.AccAddress, cb func(address sdk.AccAddress) bool)
+	IterateContractsByLabel(ctx context.Context, label string, cb func(address sdk.AccAddress) bool)
 	IterateContractsByCode(ctx context.Context, codeID uint64, cb func(address sdk.AccAddress) bool)
 	IterateContractState(ctx context.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
 	GetCodeInfo(ctx context.Context, codeID uint64) *CodeInfo
@@ -28,6 +31,13 @@ type ViewKeeper interface {
 	IsPinnedCode(ctx context.Context, codeID uint64) bool
 	GetParams(ctx context.Context) Params
 	GetWasmLimits() wasmvmtypes.WasmLimits
+	GetEffectiveGasRegister(ctx context.Context) GasRegister
+	GetCallback(ctx context.Context, callbackID uint64) *ContractCallback
+	IterateCallbacksByContract(ctx context.Context, contractAddress sdk.AccAddress, cb func(ContractCallback) bool)
+	GetPendingAdminTransfer(ctx context.Context, contractAddress sdk.AccAddress) *PendingAdminTransfer
+	IteratePendingAdminTransfers(ctx context.Context, cb func(PendingAdminTransfer) bool)
+	GetPendingMigration(ctx context.Context, contractAddress sdk.AccAddress) *PendingMigration
+	GetContractStorageUsage(ctx context.Context, contractAddress sdk.AccAddress) ContractStorageUsage
 }
 
 // ContractOpsKeeper contains mutable operations on a contract.
@@ -83,6 +93,82 @@ type ContractOpsKeeper interface {
 
 	// SetAccessConfig updates the access config of a code id.
 	SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig AccessConfig) error
+
+	// DeleteCode removes a code that is not used by any contract anymore.
+	DeleteCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress) error
+
+	// FreezeContract rejects any execution of the contract until it is unfrozen. Queries are still possible.
+	FreezeContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
+
+	// UnfreezeContract allows the execution of a frozen contract again.
+	UnfreezeContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
+
+	// RegisterCallback schedules a sudo call into the contract at the end of the start height block and then every
+	// interval blocks. The escrow is transferred from the sender to pay the fee of each execution.
+	RegisterCallback(
+		ctx sdk.Context,
+		sender, contractAddress sdk.AccAddress,
+		msg []byte,
+		startHeight int64,
+		interval, gasLimit uint64,
+		fee, escrow sdk.Coins,
+	) (uint64, error)
+
+	// CancelCallback removes a scheduled callback and refunds the remaining escrow to the registrant.
+	CancelCallback(ctx sdk.Context, callbackID uint64, caller sdk.AccAddress) (sdk.Coins, error)
+
+	// ProposeContractAdmin starts a two-step admin transfer. The new admin takes over when the proposal is accepted
+	// before the optional expiry height.
+	ProposeContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, expiryHeight uint64) error
+
+	// AcceptContractAdmin completes a pending admin transfer and sets the proposed admin.
+	AcceptContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error
+
+	// CancelAdminProposal removes a pending admin transfer.
+	CancelAdminProposal(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error
+
+	// SetContractMigrationDelay sets the number of blocks a migration by the admin is queued before it is executed.
+	// Zero for an immediate execution.
+	SetContractMigrationDelay(ctx sdk.Context, contractAddress, caller sdk.AccAddress, delay uint64) error
+
+	// CancelMigration removes a pending migration.
+	CancelMigration(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error
+
+	// MigrateContractsByCode migrates up to limit contracts of the from code to the new code, starting after the
+	// given contract. A failing contract migration is reported in the results without aborting the batch.
+	MigrateContractsByCode(
+		ctx sdk.Context,
+		fromCodeID, toCodeID uint64,
+		msg []byte,
+		limit uint64,
+		startAfter, caller sdk.AccAddress,
+	) ([]MigrateContractResult, error)
+
+	// StoreCodeChunk stages a chunk of wasm code that is too large to be uploaded within a single tx.
+	// An upload id of 0 starts a new session. Returns the upload id.
+	StoreCodeChunk(ctx sdk.Context, sender sdk.AccAddress, uploadID, index uint64, chunk []byte) (uint64, error)
+
+	// FinalizeCodeUpload verifies the checksum of the staged chunks and stores them as new wasm code
+	FinalizeCodeUpload(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		uploadID uint64,
+		checksum []byte,
+		instantiateAccess *AccessConfig,
+	) (codeID uint64, codeChecksum []byte, err error)
+
+	// SponsorContractStorage makes the sponsor pay the storage deposit of the contract instead of the contract
+	// balance. The sponsor must be allowed to modify the contract and can not displace another sponsor.
+	// The deposit held so far is refunded to the previous payer.
+	SponsorContractStorage(ctx sdk.Context, contractAddress, sponsor sdk.AccAddress) error
+
+	// ClearContractStorageSponsor refunds the storage deposit to the sponsor and removes it. The caller must be
+	// the sponsor or be allowed to modify the contract.
+	ClearContractStorageSponsor(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error
+
+	// SetContractStateQuota sets the maximum number of bytes the contract can store in its state. Zero for no limit.
+	// The admin can only tighten the quota.
+	SetContractStateQuota(ctx sdk.Context, contractAddress, caller sdk.AccAddress, maxStateSize uint64) error
 }
 
 // IBCContractKeeper IBC lifecycle event handler
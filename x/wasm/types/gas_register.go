@@ -25,6 +25,7 @@ const (
 	//
 	// The multiplier deserves more reproducible benchmarking and a strategy that allows easy adjustments.
 	// This is tracked in https://github.com/CosmWasm/wasmd/issues/566 and https://github.com/CosmWasm/wasmd/issues/631.
+	// Chains can adjust the costs on chain by governance via Params.GasRegister.
 	// Gas adjustments are consensus breaking but may happen in any release marked as consensus breaking.
 	// Do not make assumptions on how much gas an operation will consume in places that are hard to adjust,
 	// such as hardcoding them in contracts.
@@ -164,6 +165,58 @@ func NewWasmGasRegister(c WasmGasRegisterConfig) WasmGasRegister {
 	}
 }
 
+// Config returns the costs the gas register was created with
+func (g WasmGasRegister) Config() WasmGasRegisterConfig {
+	return g.c
+}
+
+// NewGasRegisterParams converts the gas register config into params
+func NewGasRegisterParams(c WasmGasRegisterConfig) GasRegisterParams {
+	return GasRegisterParams{
+		InstanceCost:               c.InstanceCost,
+		InstanceCostDiscount:       c.InstanceCostDiscount,
+		CompileCost:                c.CompileCost,
+		UncompressCostNumerator:    c.UncompressCost.Numerator,
+		UncompressCostDenominator:  c.UncompressCost.Denominator,
+		GasMultiplier:              c.GasMultiplier,
+		EventPerAttributeCost:      c.EventPerAttributeCost,
+		EventAttributeDataCost:     c.EventAttributeDataCost,
+		EventAttributeDataFreeTier: c.EventAttributeDataFreeTier,
+		ContractMessageDataCost:    c.ContractMessageDataCost,
+		CustomEventCost:            c.CustomEventCost,
+	}
+}
+
+// Config converts the params into a gas register config
+func (p GasRegisterParams) Config() WasmGasRegisterConfig {
+	return WasmGasRegisterConfig{
+		InstanceCost:         p.InstanceCost,
+		InstanceCostDiscount: p.InstanceCostDiscount,
+		CompileCost:          p.CompileCost,
+		UncompressCost: wasmvmtypes.UFraction{
+			Numerator:   p.UncompressCostNumerator,
+			Denominator: p.UncompressCostDenominator,
+		},
+		GasMultiplier:              p.GasMultiplier,
+		EventPerAttributeCost:      p.EventPerAttributeCost,
+		EventAttributeDataCost:     p.EventAttributeDataCost,
+		EventAttributeDataFreeTier: p.EventAttributeDataFreeTier,
+		ContractMessageDataCost:    p.ContractMessageDataCost,
+		CustomEventCost:            p.CustomEventCost,
+	}
+}
+
+// ValidateBasic performs basic validation on gas register params
+func (p GasRegisterParams) ValidateBasic() error {
+	if p.GasMultiplier == 0 {
+		return errorsmod.Wrap(ErrInvalid, "gas multiplier can not be 0")
+	}
+	if p.UncompressCostDenominator == 0 {
+		return errorsmod.Wrap(ErrInvalid, "uncompress cost denominator can not be 0")
+	}
+	return nil
+}
+
 // UncompressCosts costs to unpack a new wasm contract
 func (g WasmGasRegister) UncompressCosts(byteLength int) storetypes.Gas {
 	if byteLength < 0 {
@@ -367,3 +367,34 @@ func TestUncompressCosts(t *testing.T) {
 		})
 	}
 }
+
+func TestGasRegisterParamsConfig(t *testing.T) {
+	specs := map[string]struct {
+		src WasmGasRegisterConfig
+	}{
+		"default": {
+			src: DefaultGasRegisterConfig(),
+		},
+		"custom": {
+			src: WasmGasRegisterConfig{
+				InstanceCost:               1,
+				InstanceCostDiscount:       2,
+				CompileCost:                3,
+				UncompressCost:             wasmvmtypes.UFraction{Numerator: 4, Denominator: 5},
+				GasMultiplier:              6,
+				EventPerAttributeCost:      7,
+				EventAttributeDataCost:     8,
+				EventAttributeDataFreeTier: 9,
+				ContractMessageDataCost:    10,
+				CustomEventCost:            11,
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := NewGasRegisterParams(spec.src).Config()
+			assert.Equal(t, spec.src, got)
+			assert.Equal(t, spec.src, NewWasmGasRegister(got).Config())
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	errorsmod "cosmossdk.io/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -22,17 +24,53 @@ func (s GenesisState) ValidateBasic() error {
 		if err := s.Codes[i].ValidateBasic(); err != nil {
 			return errorsmod.Wrapf(err, "code: %d", i)
 		}
+		if err := s.Params.ValidateProposalWasmCode(s.Codes[i].CodeBytes); err != nil {
+			return errorsmod.Wrapf(err, "code: %d: code bytes", i)
+		}
 	}
 	for i := range s.Contracts {
 		if err := s.Contracts[i].ValidateBasic(); err != nil {
 			return errorsmod.Wrapf(err, "contract: %d", i)
 		}
+		if err := s.Params.ValidateLabelSize(s.Contracts[i].ContractInfo.Label); err != nil {
+			return errorsmod.Wrapf(err, "contract: %d: label", i)
+		}
 	}
 	for i := range s.Sequences {
 		if err := s.Sequences[i].ValidateBasic(); err != nil {
 			return errorsmod.Wrapf(err, "sequence: %d", i)
 		}
 	}
+	uniqueChecksums := make(map[string]struct{}, len(s.CodeRemovalQueue))
+	for i, checksum := range s.CodeRemovalQueue {
+		if len(checksum) != sha256.Size {
+			return errorsmod.Wrapf(ErrInvalid, "code removal queue: %d: checksum must be %d bytes", i, sha256.Size)
+		}
+		if _, exists := uniqueChecksums[string(checksum)]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "code removal queue: %d", i)
+		}
+		uniqueChecksums[string(checksum)] = struct{}{}
+	}
+	for i := range s.Callbacks {
+		if err := s.Callbacks[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "callback: %d", i)
+		}
+	}
+	for i := range s.PendingAdminTransfers {
+		if err := s.PendingAdminTransfers[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "pending admin transfer: %d", i)
+		}
+	}
+	for i := range s.PendingMigrations {
+		if err := s.PendingMigrations[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "pending migration: %d", i)
+		}
+	}
+	for i := range s.StorageUsages {
+		if err := s.StorageUsages[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "storage usage: %d", i)
+		}
+	}
 
 	return nil
 }
@@ -44,7 +82,7 @@ func (c Code) ValidateBasic() error {
 	if err := c.CodeInfo.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "code info")
 	}
-	if err := validateWasmCode(c.CodeBytes, MaxProposalWasmSize); err != nil {
+	if err := validateWasmCode(c.CodeBytes); err != nil {
 		return errorsmod.Wrap(err, "code bytes")
 	}
 	return nil
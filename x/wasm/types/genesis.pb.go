@@ -34,6 +34,13 @@ type GenesisState struct {
 	Codes     []Code     `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
 	Contracts []Contract `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	Sequences []Sequence `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	// CodeRemovalQueue contains the checksums of deleted codes that are not
+	// removed from the wasmvm cache yet
+	CodeRemovalQueue      [][]byte               `protobuf:"bytes,5,rep,name=code_removal_queue,json=codeRemovalQueue,proto3" json:"code_removal_queue,omitempty"`
+	Callbacks             []ContractCallback     `protobuf:"bytes,6,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+	PendingAdminTransfers []PendingAdminTransfer `protobuf:"bytes,7,rep,name=pending_admin_transfers,json=pendingAdminTransfers,proto3" json:"pending_admin_transfers,omitempty"`
+	PendingMigrations     []PendingMigration     `protobuf:"bytes,8,rep,name=pending_migrations,json=pendingMigrations,proto3" json:"pending_migrations,omitempty"`
+	StorageUsages         []ContractStorageUsage `protobuf:"bytes,9,rep,name=storage_usages,json=storageUsages,proto3" json:"storage_usages,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -102,6 +109,41 @@ func (m *GenesisState) GetSequences() []Sequence {
 	return nil
 }
 
+func (m *GenesisState) GetCodeRemovalQueue() [][]byte {
+	if m != nil {
+		return m.CodeRemovalQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCallbacks() []ContractCallback {
+	if m != nil {
+		return m.Callbacks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAdminTransfers() []PendingAdminTransfer {
+	if m != nil {
+		return m.PendingAdminTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingMigrations() []PendingMigration {
+	if m != nil {
+		return m.PendingMigrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageUsages() []ContractStorageUsage {
+	if m != nil {
+		return m.StorageUsages
+	}
+	return nil
+}
+
 // Code struct encompasses CodeInfo and CodeBytes
 type Code struct {
 	CodeID    uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
@@ -319,44 +361,56 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xae, 0x0d, 0xad, 0x57, 0xd8, 0x30, 0x63, 0x84, 0x6a, 0xa4, 0x55, 0x91, 0x50,
-	0x35, 0x41, 0xa3, 0x8d, 0x23, 0x17, 0xc8, 0x86, 0xa0, 0x4c, 0x20, 0x94, 0x1e, 0x90, 0x76, 0xa9,
-	0xd2, 0xd8, 0xeb, 0x2c, 0x96, 0xb8, 0xc4, 0x6e, 0x21, 0xdf, 0x82, 0x4f, 0x81, 0x38, 0x72, 0xe0,
-	0x43, 0xec, 0xc6, 0x84, 0x84, 0xc4, 0xa9, 0x42, 0xed, 0x01, 0x89, 0x4f, 0x81, 0xfc, 0x27, 0x59,
-	0xd4, 0xae, 0x17, 0xab, 0xf6, 0xf3, 0xbe, 0xbf, 0xbe, 0xef, 0xe3, 0x37, 0x06, 0x76, 0x40, 0x59,
-	0xf8, 0xd1, 0x67, 0xa1, 0x23, 0x97, 0xc9, 0x9e, 0x33, 0xc4, 0x11, 0x66, 0x84, 0x75, 0x46, 0x31,
-	0xe5, 0x14, 0x6e, 0xa6, 0x7a, 0x47, 0x2e, 0x93, 0xbd, 0xfa, 0xd6, 0x90, 0x0e, 0xa9, 0x14, 0x1d,
-	0xf1, 0x4b, 0xc5, 0xd5, 0x77, 0x96, 0x38, 0x3c, 0x19, 0x61, 0x4d, 0xa9, 0xdf, 0xf4, 0x43, 0x12,
-	0x51, 0x47, 0xae, 0xfa, 0xe8, 0xae, 0x48, 0xa0, 0xac, 0xaf, 0x48, 0x6a, 0xa3, 0xa4, 0xd6, 0x8f,
-	0x22, 0xa8, 0xbd, 0x50, 0x55, 0xf4, 0xb8, 0xcf, 0x31, 0x7c, 0x02, 0xcc, 0x91, 0x1f, 0xfb, 0x21,
-	0xb3, 0x8c, 0xa6, 0xd1, 0x5e, 0xdf, 0xb7, 0x3a, 0x8b, 0x55, 0x75, 0xde, 0x4a, 0xdd, 0xad, 0x9e,
-	0x4f, 0x1b, 0x85, 0xaf, 0x7f, 0xbf, 0xed, 0x1a, 0x9e, 0x4e, 0x81, 0xaf, 0x40, 0x39, 0xa0, 0x08,
-	0x33, 0xab, 0xd8, 0x5c, 0x6b, 0xaf, 0xef, 0x6f, 0x2f, 0xe7, 0x1e, 0x50, 0x84, 0xdd, 0x1d, 0x91,
-	0xf9, 0x6f, 0xda, 0xd8, 0x90, 0xc1, 0x0f, 0x69, 0x48, 0x38, 0x0e, 0x47, 0x3c, 0x51, 0x30, 0x85,
-	0x80, 0xc7, 0xa0, 0x1a, 0xd0, 0x88, 0xc7, 0x7e, 0xc0, 0x99, 0xb5, 0x26, 0x79, 0xf5, 0xab, 0x78,
-	0x2a, 0xc4, 0x6d, 0x6a, 0xe6, 0xad, 0x2c, 0x69, 0x91, 0x7b, 0x89, 0x13, 0x6c, 0x86, 0x3f, 0x8c,
-	0x71, 0x14, 0x60, 0x66, 0x95, 0x56, 0xb1, 0x7b, 0x3a, 0xe4, 0x92, 0x9d, 0x25, 0x2d, 0xb1, 0x33,
-	0xa5, 0xf5, 0xc5, 0x00, 0x25, 0xd1, 0x25, 0xbc, 0x0f, 0xae, 0x89, 0x4e, 0xfa, 0x04, 0x49, 0x2b,
-	0x4b, 0x2e, 0x98, 0x4d, 0x1b, 0xa6, 0x90, 0xba, 0x87, 0x9e, 0x29, 0xa4, 0x2e, 0x82, 0xae, 0xe8,
-	0x52, 0x04, 0x45, 0x27, 0xd4, 0x2a, 0x4a, 0xc7, 0xeb, 0x57, 0xbb, 0xd6, 0x8d, 0x4e, 0x68, 0xde,
-	0xf3, 0x4a, 0xa0, 0x0f, 0xe1, 0x3d, 0x00, 0x24, 0x63, 0x90, 0x70, 0x2c, 0xac, 0x32, 0xda, 0x35,
-	0x4f, 0x52, 0x5d, 0x71, 0x00, 0xb7, 0x81, 0x39, 0x22, 0x51, 0x84, 0x91, 0x55, 0x6a, 0x1a, 0xed,
-	0x8a, 0xa7, 0x77, 0xad, 0x5f, 0x45, 0x50, 0x49, 0xed, 0x83, 0x07, 0x60, 0x33, 0xb5, 0xa7, 0xef,
-	0x23, 0x14, 0x63, 0xa6, 0x06, 0xa0, 0xea, 0x5a, 0x3f, 0xbf, 0x3f, 0xda, 0xd2, 0x33, 0xf3, 0x4c,
-	0x29, 0x3d, 0x1e, 0x93, 0x68, 0xe8, 0x6d, 0xa4, 0x19, 0xfa, 0x18, 0xbe, 0x01, 0xd7, 0x33, 0x48,
-	0xae, 0x21, 0x7b, 0xf5, 0xb5, 0x2d, 0x36, 0x55, 0x0b, 0x72, 0x02, 0xec, 0x82, 0x1b, 0x19, 0x8f,
-	0x89, 0xe9, 0xd4, 0x73, 0x70, 0x67, 0x19, 0xf8, 0x9a, 0x22, 0x7c, 0x96, 0x27, 0x65, 0x95, 0xa8,
-	0xb1, 0x26, 0xe0, 0x76, 0x86, 0x92, 0x66, 0x9d, 0x12, 0xc6, 0x69, 0x9c, 0xe8, 0xdb, 0xdf, 0x5d,
-	0x5d, 0xa2, 0xf0, 0xfe, 0xa5, 0x0a, 0x7e, 0x1e, 0xf1, 0x38, 0xc9, 0xff, 0x49, 0x36, 0x6c, 0xb9,
-	0xa0, 0x96, 0x0b, 0x2a, 0xe9, 0xe4, 0xc0, 0x26, 0x30, 0x09, 0xea, 0xbf, 0xc7, 0x89, 0x34, 0xb3,
-	0xe6, 0x56, 0x67, 0xd3, 0x46, 0xb9, 0x7b, 0x78, 0x84, 0x13, 0xaf, 0x4c, 0xd0, 0x11, 0x4e, 0xe0,
-	0x16, 0x28, 0x4f, 0xfc, 0xb3, 0x31, 0x96, 0x5e, 0x95, 0x3c, 0xb5, 0x71, 0x9f, 0x9e, 0xcf, 0x6c,
-	0xe3, 0x62, 0x66, 0x1b, 0x7f, 0x66, 0xb6, 0xf1, 0x79, 0x6e, 0x17, 0x2e, 0xe6, 0x76, 0xe1, 0xf7,
-	0xdc, 0x2e, 0x1c, 0x3f, 0x18, 0x12, 0x7e, 0x3a, 0x1e, 0x74, 0x02, 0x1a, 0x3a, 0x07, 0x94, 0x85,
-	0xef, 0xd2, 0x77, 0x00, 0x39, 0x9f, 0xd4, 0x7b, 0x20, 0x1f, 0x83, 0x81, 0x29, 0xbf, 0xef, 0xc7,
-	0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x86, 0xe3, 0xf2, 0x58, 0x75, 0x04, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xcd, 0x6e, 0xeb, 0x44,
+	0x1c, 0xc5, 0xe3, 0x36, 0x49, 0x93, 0x69, 0xfa, 0x35, 0xfd, 0x32, 0x51, 0x70, 0x4c, 0x10, 0x55,
+	0xa8, 0x20, 0x51, 0xcb, 0x92, 0x0d, 0x75, 0x8a, 0x20, 0x54, 0xad, 0xc0, 0x01, 0x21, 0x75, 0x63,
+	0x4d, 0xec, 0xa9, 0x6b, 0x35, 0xf6, 0xb8, 0x9e, 0x49, 0xc0, 0x12, 0x0f, 0xc0, 0x0a, 0xf1, 0x14,
+	0x88, 0x25, 0x0b, 0x1e, 0xa2, 0xcb, 0x0a, 0x09, 0xe9, 0xae, 0xa2, 0xab, 0x74, 0x71, 0xa5, 0x3e,
+	0xc5, 0x95, 0x67, 0xc6, 0x69, 0x6e, 0x9c, 0xdc, 0x8d, 0x5b, 0xcf, 0x39, 0xe7, 0x37, 0xff, 0xf9,
+	0xc7, 0x33, 0x03, 0x34, 0x9b, 0x50, 0xff, 0x17, 0x44, 0xfd, 0x36, 0x7f, 0x8c, 0x4e, 0xda, 0x2e,
+	0x0e, 0x30, 0xf5, 0x68, 0x2b, 0x8c, 0x08, 0x23, 0x70, 0x3b, 0xd5, 0x5b, 0xfc, 0x31, 0x3a, 0xa9,
+	0xee, 0xb9, 0xc4, 0x25, 0x5c, 0x6c, 0x27, 0xff, 0x09, 0x5f, 0xb5, 0x96, 0xe1, 0xb0, 0x38, 0xc4,
+	0x92, 0x52, 0xdd, 0x41, 0xbe, 0x17, 0x90, 0x36, 0x7f, 0xca, 0xa1, 0x0f, 0x92, 0x00, 0xa1, 0x96,
+	0x20, 0x89, 0x17, 0x21, 0x35, 0x7e, 0x5f, 0x03, 0x95, 0x6f, 0x44, 0x15, 0x3d, 0x86, 0x18, 0x86,
+	0x5f, 0x82, 0x62, 0x88, 0x22, 0xe4, 0x53, 0x55, 0xd1, 0x95, 0xe6, 0xfa, 0xa9, 0xda, 0x9a, 0xaf,
+	0xaa, 0xf5, 0x3d, 0xd7, 0x8d, 0xf2, 0xc3, 0xb8, 0x9e, 0xfb, 0xfb, 0xcd, 0x3f, 0xc7, 0x8a, 0x29,
+	0x23, 0xf0, 0x3b, 0x50, 0xb0, 0x89, 0x83, 0xa9, 0xba, 0xa2, 0xaf, 0x36, 0xd7, 0x4f, 0x0f, 0xb2,
+	0xd9, 0x0e, 0x71, 0xb0, 0x51, 0x4b, 0x92, 0xcf, 0xe3, 0xfa, 0x16, 0x37, 0x7f, 0x46, 0x7c, 0x8f,
+	0x61, 0x3f, 0x64, 0xb1, 0x80, 0x09, 0x04, 0xbc, 0x06, 0x65, 0x9b, 0x04, 0x2c, 0x42, 0x36, 0xa3,
+	0xea, 0x2a, 0xe7, 0x55, 0x17, 0xf1, 0x84, 0xc5, 0xd0, 0x25, 0x73, 0x77, 0x1a, 0x9a, 0xe7, 0xbe,
+	0xe0, 0x12, 0x36, 0xc5, 0xf7, 0x43, 0x1c, 0xd8, 0x98, 0xaa, 0xf9, 0x65, 0xec, 0x9e, 0xb4, 0xbc,
+	0xb0, 0xa7, 0xa1, 0x0c, 0x7b, 0xaa, 0xc0, 0x2b, 0x00, 0x93, 0x05, 0x58, 0x11, 0xf6, 0xc9, 0x08,
+	0x0d, 0xac, 0xfb, 0x21, 0x1e, 0x62, 0xb5, 0xa0, 0xaf, 0x36, 0x2b, 0x86, 0xfe, 0x3c, 0xae, 0xd7,
+	0xb2, 0xea, 0x0b, 0xcd, 0xdc, 0x4e, 0x54, 0x53, 0x88, 0x3f, 0x24, 0x1a, 0xec, 0x83, 0xb2, 0x8d,
+	0x06, 0x83, 0x3e, 0xb2, 0xef, 0xa8, 0x5a, 0xe4, 0xb5, 0x36, 0x96, 0xf7, 0xa1, 0x23, 0xad, 0x33,
+	0xfd, 0x48, 0xc3, 0xd9, 0x7e, 0xa4, 0x0a, 0xfc, 0x43, 0x01, 0x87, 0x21, 0x0e, 0x1c, 0x2f, 0x70,
+	0x2d, 0xe4, 0xf8, 0x5e, 0x60, 0xb1, 0x08, 0x05, 0xf4, 0x06, 0x47, 0x54, 0x5d, 0xe3, 0x53, 0x1e,
+	0x2d, 0xf8, 0x0c, 0x44, 0xe0, 0x2c, 0xf1, 0xff, 0x28, 0xed, 0x46, 0x4b, 0x4e, 0xfb, 0xd1, 0x12,
+	0xdc, 0x7c, 0x11, 0xfb, 0xe1, 0x02, 0x0a, 0x85, 0xbf, 0x01, 0x98, 0x02, 0x7c, 0xcf, 0x8d, 0x10,
+	0xf3, 0x48, 0x40, 0xd5, 0xd2, 0xb2, 0xd5, 0xcb, 0x52, 0x2e, 0x53, 0xab, 0xf1, 0xa9, 0x2c, 0xa3,
+	0x96, 0xa5, 0xcc, 0x57, 0xb0, 0x13, 0xce, 0x85, 0x29, 0x64, 0x60, 0x93, 0x32, 0x12, 0x21, 0x17,
+	0x5b, 0x43, 0x8a, 0x5c, 0x4c, 0xd5, 0xf2, 0xb2, 0x26, 0xa4, 0x7d, 0xef, 0x09, 0xff, 0x4f, 0x89,
+	0xdd, 0xf8, 0x44, 0xce, 0xae, 0xbe, 0x4b, 0x99, 0x9f, 0x79, 0x83, 0xce, 0x84, 0x68, 0xe3, 0x2f,
+	0x05, 0xe4, 0x93, 0xed, 0x01, 0x3f, 0x06, 0x6b, 0xfc, 0x1b, 0xf1, 0x1c, 0xbe, 0x07, 0xf3, 0x06,
+	0x98, 0x8c, 0xeb, 0xc5, 0x44, 0xea, 0x9e, 0x9b, 0xc5, 0x44, 0xea, 0x3a, 0xd0, 0x00, 0x65, 0x61,
+	0x0a, 0x6e, 0x88, 0xba, 0xa2, 0x2b, 0x8b, 0x3f, 0x61, 0x1e, 0x0a, 0x6e, 0xc8, 0xec, 0x66, 0x2d,
+	0xd9, 0x72, 0x10, 0x7e, 0x08, 0x00, 0x67, 0xf4, 0x63, 0x86, 0x93, 0x3d, 0xa6, 0x34, 0x2b, 0x26,
+	0xa7, 0x1a, 0xc9, 0x00, 0x3c, 0x00, 0xc5, 0xd0, 0x0b, 0x02, 0xec, 0xa8, 0x79, 0x5d, 0x69, 0x96,
+	0x4c, 0xf9, 0xd6, 0xf8, 0x7f, 0x05, 0x94, 0xd2, 0x75, 0xc3, 0x0e, 0xd8, 0x4e, 0xf7, 0x95, 0x85,
+	0x1c, 0x27, 0xc2, 0x54, 0x9c, 0x1c, 0x65, 0x43, 0xfd, 0xef, 0xdf, 0xcf, 0xf7, 0xe4, 0x61, 0x73,
+	0x26, 0x94, 0x1e, 0x8b, 0xbc, 0xc0, 0x35, 0xb7, 0xd2, 0x84, 0x1c, 0x86, 0x57, 0x60, 0x63, 0x0a,
+	0x99, 0x59, 0x90, 0xb6, 0xbc, 0xdf, 0xf3, 0x8b, 0xaa, 0xd8, 0x33, 0x02, 0xec, 0x82, 0xcd, 0x29,
+	0x8f, 0x32, 0xc4, 0xb0, 0x3c, 0x40, 0x0e, 0xb3, 0xc0, 0x4b, 0xe2, 0xe0, 0xc1, 0x2c, 0x69, 0x5a,
+	0x89, 0x38, 0x0f, 0x3d, 0xb0, 0x3f, 0x45, 0xf1, 0x66, 0xdd, 0x7a, 0xc9, 0xcf, 0x16, 0xcb, 0x63,
+	0xe3, 0xf8, 0x3d, 0x5b, 0x91, 0x38, 0xf8, 0x5b, 0x61, 0xfe, 0x3a, 0x60, 0x51, 0x3c, 0x3b, 0xc9,
+	0xae, 0x9d, 0x35, 0x35, 0x0c, 0x50, 0x4a, 0x8f, 0x1c, 0xa8, 0x83, 0xa2, 0xe7, 0x58, 0x77, 0x38,
+	0xe6, 0xcd, 0xac, 0x18, 0xe5, 0xc9, 0xb8, 0x5e, 0xe8, 0x9e, 0x5f, 0xe0, 0xd8, 0x2c, 0x78, 0xce,
+	0x05, 0x8e, 0xe1, 0x1e, 0x28, 0x8c, 0xd0, 0x60, 0x88, 0x79, 0xaf, 0xf2, 0xa6, 0x78, 0x31, 0xbe,
+	0x7a, 0x98, 0x68, 0xca, 0xe3, 0x44, 0x53, 0x5e, 0x4f, 0x34, 0xe5, 0xcf, 0x27, 0x2d, 0xf7, 0xf8,
+	0xa4, 0xe5, 0x5e, 0x3d, 0x69, 0xb9, 0xeb, 0x23, 0xd7, 0x63, 0xb7, 0xc3, 0x7e, 0xcb, 0x26, 0x7e,
+	0xbb, 0x43, 0xa8, 0xff, 0x73, 0x7a, 0x81, 0x38, 0xed, 0x5f, 0xf9, 0x5f, 0x71, 0x8b, 0xf4, 0x8b,
+	0xfc, 0x62, 0xf8, 0xe2, 0xed, 0x00, 0x3e, 0xb6, 0xac, 0x8e, 0xae, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -379,6 +433,71 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorageUsages) > 0 {
+		for iNdEx := len(m.StorageUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PendingMigrations) > 0 {
+		for iNdEx := len(m.PendingMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PendingAdminTransfers) > 0 {
+		for iNdEx := len(m.PendingAdminTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAdminTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CodeRemovalQueue) > 0 {
+		for iNdEx := len(m.CodeRemovalQueue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CodeRemovalQueue[iNdEx])
+			copy(dAtA[i:], m.CodeRemovalQueue[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CodeRemovalQueue[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Sequences) > 0 {
 		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -630,6 +749,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CodeRemovalQueue) > 0 {
+		for _, b := range m.CodeRemovalQueue {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingAdminTransfers) > 0 {
+		for _, e := range m.PendingAdminTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingMigrations) > 0 {
+		for _, e := range m.PendingMigrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StorageUsages) > 0 {
+		for _, e := range m.StorageUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -869,6 +1018,174 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeRemovalQueue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeRemovalQueue = append(m.CodeRemovalQueue, make([]byte, postIndex-iNdEx))
+			copy(m.CodeRemovalQueue[len(m.CodeRemovalQueue)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, ContractCallback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdminTransfers = append(m.PendingAdminTransfers, PendingAdminTransfer{})
+			if err := m.PendingAdminTransfers[len(m.PendingAdminTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMigrations = append(m.PendingMigrations, PendingMigration{})
+			if err := m.PendingMigrations[len(m.PendingMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageUsages = append(m.StorageUsages, ContractStorageUsage{})
+			if err := m.StorageUsages[len(m.StorageUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
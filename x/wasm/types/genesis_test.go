@@ -1,7 +1,7 @@
 package types
 
 import (
-	"bytes"
+	"crypto/sha256"
 	"testing"
 	"time"
 
@@ -43,12 +43,60 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"code bytes exceed params limit": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.MaxProposalWasmSize = uint64(len(s.Codes[0].CodeBytes) - 1)
+			},
+			expError: true,
+		},
+		"contract label exceeds params limit": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.MaxLabelSize = uint64(len(s.Contracts[0].ContractInfo.Label) - 1)
+			},
+			expError: true,
+		},
 		"sequence invalid": {
 			srcMutator: func(s *GenesisState) {
 				s.Sequences[0].IDKey = nil
 			},
 			expError: true,
 		},
+		"code removal queue checksum invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeRemovalQueue[0] = randBytes(sha256.Size - 1)
+			},
+			expError: true,
+		},
+		"code removal queue duplicate checksum": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeRemovalQueue = append(s.CodeRemovalQueue, s.CodeRemovalQueue[0])
+			},
+			expError: true,
+		},
+		"callback invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.Callbacks[0].GasLimit = 0
+			},
+			expError: true,
+		},
+		"pending admin transfer invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.PendingAdminTransfers[0].ProposedAdmin = invalidAddress
+			},
+			expError: true,
+		},
+		"pending migration invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.PendingMigrations[0].CodeID = 0
+			},
+			expError: true,
+		},
+		"storage usage invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.StorageUsages[0].Sponsor = invalidAddress
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -93,12 +141,6 @@ func TestCodeValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
-		"codeBytes greater limit": {
-			srcMutator: func(c *Code) {
-				c.CodeBytes = bytes.Repeat([]byte{0x1}, MaxProposalWasmSize+1)
-			},
-			expError: true,
-		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
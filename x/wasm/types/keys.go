@@ -36,9 +36,26 @@ var (
 	ContractsByCreatorPrefix                       = []byte{0x09}
 	ParamsKey                                      = []byte{0x10}
 	AsyncAckKeyPrefix                              = []byte{0x11}
+	CodeRemovalQueuePrefix                         = []byte{0x12}
+	CallbackPrefix                                 = []byte{0x13}
+	CallbackByHeightIndexPrefix                    = []byte{0x14}
+	CallbackByContractIndexPrefix                  = []byte{0x15}
+	PendingAdminTransferPrefix                     = []byte{0x16}
+	PendingMigrationPrefix                         = []byte{0x17}
+	PendingMigrationByHeightIndexPrefix            = []byte{0x18}
+	CodeByChecksumIndexPrefix                      = []byte{0x19}
+	CodeUploadSessionPrefix                        = []byte{0x1a}
+	CodeUploadChunkPrefix                          = []byte{0x1b}
+	CodeUploadByExpiryIndexPrefix                  = []byte{0x1c}
+	ContractStorageUsagePrefix                     = []byte{0x1d}
+	ContractsByAdminPrefix                         = []byte{0x1e}
+	CodesByCreatorPrefix                           = []byte{0x1f}
+	ContractsByLabelPrefix                         = []byte{0x20}
 
 	KeySequenceCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeySequenceInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	KeySequenceCallbackID = append(SequenceKeyPrefix, []byte("lastCallbackId")...)
+	KeySequenceUploadID   = append(SequenceKeyPrefix, []byte("lastUploadId")...)
 )
 
 // GetCodeKey constructs the key for retrieving the ID for the WASM code
@@ -47,17 +64,144 @@ func GetCodeKey(codeID uint64) []byte {
 	return append(CodeKeyPrefix, contractIDBz...)
 }
 
+// GetCodesByChecksumPrefix returns the prefix for the checksum secondary index: `<prefix><checksum>`
+func GetCodesByChecksumPrefix(checksum []byte) []byte {
+	return append(CodeByChecksumIndexPrefix, checksum...)
+}
+
+// GetCodeByChecksumIndexKey returns the key for the checksum secondary index: `<prefix><checksum><codeID>`
+func GetCodeByChecksumIndexKey(checksum []byte, codeID uint64) []byte {
+	prefixBytes := GetCodesByChecksumPrefix(checksum)
+	return append(prefixBytes, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodesByCreatorPrefix returns the prefix for the creator secondary index: `<prefix><creatorAddress length><creatorAddress>`
+func GetCodesByCreatorPrefix(creatorAddr sdk.AccAddress) []byte {
+	bz := address.MustLengthPrefix(creatorAddr)
+	return append(CodesByCreatorPrefix, bz...)
+}
+
+// GetCodeByCreatorIndexKey returns the key for the creator secondary index: `<prefix><creatorAddress length><creatorAddress><codeID>`
+func GetCodeByCreatorIndexKey(creatorAddr sdk.AccAddress, codeID uint64) []byte {
+	return append(GetCodesByCreatorPrefix(creatorAddr), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodeRemovalQueueKey returns the key for a checksum that is scheduled to be removed from the wasmvm cache
+func GetCodeRemovalQueueKey(checksum []byte) []byte {
+	return append(CodeRemovalQueuePrefix, checksum...)
+}
+
+// GetCallbackKey returns the key for a scheduled contract callback
+func GetCallbackKey(callbackID uint64) []byte {
+	return append(CallbackPrefix, sdk.Uint64ToBigEndian(callbackID)...)
+}
+
+// GetCallbackByHeightIndexKey returns the key for the index of callbacks ordered by the height of their next execution.
+func GetCallbackByHeightIndexKey(height int64, callbackID uint64) []byte {
+	prefixLen := len(CallbackByHeightIndexPrefix)
+	r := make([]byte, prefixLen+8+8)
+	copy(r[0:], CallbackByHeightIndexPrefix)
+	binary.BigEndian.PutUint64(r[prefixLen:], uint64(height))
+	binary.BigEndian.PutUint64(r[prefixLen+8:], callbackID)
+	return r
+}
+
+// GetCallbacksByContractPrefix returns the callbacks by contract prefix
+func GetCallbacksByContractPrefix(contractAddr sdk.AccAddress) []byte {
+	bz := address.MustLengthPrefix(contractAddr)
+	return append(CallbackByContractIndexPrefix, bz...)
+}
+
+// GetCallbackByContractIndexKey returns the key for the index of callbacks by contract
+func GetCallbackByContractIndexKey(contractAddr sdk.AccAddress, callbackID uint64) []byte {
+	return append(GetCallbacksByContractPrefix(contractAddr), sdk.Uint64ToBigEndian(callbackID)...)
+}
+
 // GetContractAddressKey returns the key for the WASM contract instance
 func GetContractAddressKey(addr sdk.AccAddress) []byte {
 	return append(ContractKeyPrefix, addr...)
 }
 
+// GetPendingAdminTransferKey returns the key for the pending admin transfer of a contract
+func GetPendingAdminTransferKey(contractAddr sdk.AccAddress) []byte {
+	return append(PendingAdminTransferPrefix, contractAddr...)
+}
+
+// GetPendingMigrationKey returns the key for the pending migration of a contract
+func GetPendingMigrationKey(contractAddr sdk.AccAddress) []byte {
+	return append(PendingMigrationPrefix, contractAddr...)
+}
+
+// GetPendingMigrationByHeightIndexKey returns the key for the pending migration height index:
+// `<prefix><height><contractAddr>`
+func GetPendingMigrationByHeightIndexKey(height int64, contractAddr sdk.AccAddress) []byte {
+	prefixLen := len(PendingMigrationByHeightIndexPrefix)
+	r := make([]byte, prefixLen+8+len(contractAddr))
+	copy(r[0:], PendingMigrationByHeightIndexPrefix)
+	binary.BigEndian.PutUint64(r[prefixLen:], uint64(height))
+	copy(r[prefixLen+8:], contractAddr)
+	return r
+}
+
+// GetCodeUploadSessionKey returns the key for a chunked code upload session
+func GetCodeUploadSessionKey(uploadID uint64) []byte {
+	return append(CodeUploadSessionPrefix, sdk.Uint64ToBigEndian(uploadID)...)
+}
+
+// GetCodeUploadChunksPrefix returns the prefix for the staged chunks of an upload session: `<prefix><uploadID>`
+func GetCodeUploadChunksPrefix(uploadID uint64) []byte {
+	return append(CodeUploadChunkPrefix, sdk.Uint64ToBigEndian(uploadID)...)
+}
+
+// GetCodeUploadChunkKey returns the key for a staged chunk of an upload session: `<prefix><uploadID><index>`
+func GetCodeUploadChunkKey(uploadID, index uint64) []byte {
+	return append(GetCodeUploadChunksPrefix(uploadID), sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetCodeUploadByExpiryIndexKey returns the key for the upload session expiry index: `<prefix><height><uploadID>`
+func GetCodeUploadByExpiryIndexKey(height int64, uploadID uint64) []byte {
+	prefixLen := len(CodeUploadByExpiryIndexPrefix)
+	r := make([]byte, prefixLen+8+8)
+	copy(r[0:], CodeUploadByExpiryIndexPrefix)
+	binary.BigEndian.PutUint64(r[prefixLen:], uint64(height))
+	binary.BigEndian.PutUint64(r[prefixLen+8:], uploadID)
+	return r
+}
+
+// GetContractStorageUsageKey returns the key for the storage usage of a contract
+func GetContractStorageUsageKey(contractAddr sdk.AccAddress) []byte {
+	return append(ContractStorageUsagePrefix, contractAddr...)
+}
+
 // GetContractsByCreatorPrefix returns the contracts by creator prefix for the WASM contract instance
 func GetContractsByCreatorPrefix(addr sdk.AccAddress) []byte {
 	bz := address.MustLengthPrefix(addr)
 	return append(ContractsByCreatorPrefix, bz...)
 }
 
+// GetContractsByAdminPrefix returns the contracts by admin prefix for the WASM contract instance
+func GetContractsByAdminPrefix(addr sdk.AccAddress) []byte {
+	bz := address.MustLengthPrefix(addr)
+	return append(ContractsByAdminPrefix, bz...)
+}
+
+// GetContractsByLabelPrefix returns the prefix for the label index of all contracts with a label that starts with the
+// given label prefix: `<prefix><label>`
+func GetContractsByLabelPrefix(labelPrefix string) []byte {
+	return append(ContractsByLabelPrefix, labelPrefix...)
+}
+
+// GetContractsByExactLabelPrefix returns the prefix for the label index of all contracts with exactly the given label:
+// `<prefix><label><0x00>`. Labels have printable characters only so that the separator is unique.
+func GetContractsByExactLabelPrefix(label string) []byte {
+	return append(GetContractsByLabelPrefix(label), 0)
+}
+
+// GetContractByLabelIndexKey returns the key for the label index: `<prefix><label><0x00><contractAddr>`
+func GetContractByLabelIndexKey(label string, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractsByExactLabelPrefix(label), contractAddr...)
+}
+
 // GetContractStorePrefix returns the store prefix for the WASM contract instance
 func GetContractStorePrefix(addr sdk.AccAddress) []byte {
 	return append(ContractStorePrefix, addr...)
@@ -116,6 +260,19 @@ func GetContractByCreatorSecondaryIndexKey(bz, position []byte, contractAddr sdk
 	return r
 }
 
+// GetContractByAdminSecondaryIndexKey returns the key for the admin index: `<prefix><adminAddress length><adminAddress><created time><contractAddr>`
+func GetContractByAdminSecondaryIndexKey(adminAddr sdk.AccAddress, position []byte, contractAddr sdk.AccAddress) []byte {
+	prefixBytes := GetContractsByAdminPrefix(adminAddr)
+	lenPrefixBytes := len(prefixBytes)
+	r := make([]byte, lenPrefixBytes+AbsoluteTxPositionLen+len(contractAddr))
+
+	copy(r[:lenPrefixBytes], prefixBytes)
+	copy(r[lenPrefixBytes:lenPrefixBytes+AbsoluteTxPositionLen], position)
+	copy(r[lenPrefixBytes+AbsoluteTxPositionLen:], contractAddr)
+
+	return r
+}
+
 // GetContractCodeHistoryElementKey returns the key a contract code history entry: `<prefix><contractAddr><position>`
 func GetContractCodeHistoryElementKey(contractAddr sdk.AccAddress, pos uint64) []byte {
 	prefix := GetContractCodeHistoryElementPrefix(contractAddr)
@@ -146,3 +146,105 @@ func TestGetContractByCreatorSecondaryIndexKey(t *testing.T) {
 	}
 	assert.Equal(t, exp, got)
 }
+
+func TestGetContractByAdminSecondaryIndexKey(t *testing.T) {
+	adminAddr := bytes.Repeat([]byte{8}, 32)
+	contractAddr := bytes.Repeat([]byte{4}, 20)
+	pos := AbsoluteTxPosition{2 + 1<<(8*7), 3 + 1<<(8*7)}
+
+	got := GetContractByAdminSecondaryIndexKey(adminAddr, pos.Bytes(), contractAddr)
+	exp := []byte{
+		0x1e,                         // prefix
+		32,                           // admin address length
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, // admin address
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8,
+		1, 0, 0, 0, 0, 0, 0, 2, // height
+		1, 0, 0, 0, 0, 0, 0, 3, // index
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // address 20 bytes
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetContractByLabelIndexKey(t *testing.T) {
+	contractAddr := bytes.Repeat([]byte{4}, 20)
+
+	got := GetContractByLabelIndexKey("my label", contractAddr)
+	exp := []byte{
+		0x20,                                   // prefix
+		'm', 'y', ' ', 'l', 'a', 'b', 'e', 'l', // label
+		0,                            // separator
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // address 20 bytes
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetCallbackByHeightIndexKey(t *testing.T) {
+	got := GetCallbackByHeightIndexKey(2+1<<(8*7), 3+1<<(8*7))
+	exp := []byte{
+		0x14,                   // prefix
+		1, 0, 0, 0, 0, 0, 0, 2, // height
+		1, 0, 0, 0, 0, 0, 0, 3, // callback id
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetCallbackByContractIndexKey(t *testing.T) {
+	contractAddr := bytes.Repeat([]byte{4}, 20)
+	got := GetCallbackByContractIndexKey(contractAddr, 3+1<<(8*7))
+	exp := []byte{
+		0x15,                         // prefix
+		20,                           // contract address length
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // contract address with fixed length prefix
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		1, 0, 0, 0, 0, 0, 0, 3, // callback id
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetPendingMigrationByHeightIndexKey(t *testing.T) {
+	contractAddr := bytes.Repeat([]byte{4}, 20)
+	got := GetPendingMigrationByHeightIndexKey(2+1<<(8*7), contractAddr)
+	exp := []byte{
+		0x18,                   // prefix
+		1, 0, 0, 0, 0, 0, 0, 2, // height
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // contract address
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetCodeByChecksumIndexKey(t *testing.T) {
+	checksum := bytes.Repeat([]byte{4}, 32)
+	got := GetCodeByChecksumIndexKey(checksum, 3+1<<(8*7))
+	exp := []byte{
+		0x19,                                           // prefix
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // checksum
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		1, 0, 0, 0, 0, 0, 0, 3, // code id
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetCodeUploadChunkKey(t *testing.T) {
+	got := GetCodeUploadChunkKey(2+1<<(8*7), 3)
+	exp := []byte{
+		0x1b,                   // prefix
+		1, 0, 0, 0, 0, 0, 0, 2, // upload id
+		0, 0, 0, 0, 0, 0, 0, 3, // chunk index
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetCodeUploadByExpiryIndexKey(t *testing.T) {
+	got := GetCodeUploadByExpiryIndexKey(2+1<<(8*7), 3)
+	exp := []byte{
+		0x1c,                   // prefix
+		1, 0, 0, 0, 0, 0, 0, 2, // height
+		0, 0, 0, 0, 0, 0, 0, 3, // upload id
+	}
+	assert.Equal(t, exp, got)
+}
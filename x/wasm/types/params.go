@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"math"
 	"slices"
 
 	"github.com/cosmos/gogoproto/jsonpb"
@@ -9,8 +10,10 @@ import (
 	"gopkg.in/yaml.v2"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var AllAccessTypes = []AccessType{
@@ -88,6 +91,10 @@ func DefaultParams() Params {
 	return Params{
 		CodeUploadAccess:             AllowEverybody,
 		InstantiateDefaultPermission: AccessTypeEverybody,
+		MaxWasmSize:                  uint64(MaxWasmSize),
+		MaxProposalWasmSize:          uint64(MaxProposalWasmSize),
+		MaxLabelSize:                 uint64(MaxLabelSize),
+		MaxAddressCount:              uint64(MaxAddressCount),
 	}
 }
 
@@ -107,9 +114,110 @@ func (p Params) ValidateBasic() error {
 	if err := p.CodeUploadAccess.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "upload access")
 	}
+	if p.CallbackGasPrice != nil {
+		if err := p.CallbackGasPrice.Validate(); err != nil {
+			return errorsmod.Wrap(err, "callback gas price")
+		}
+	}
+	for _, v := range []struct {
+		name  string
+		value uint64
+	}{
+		{"max wasm size", p.MaxWasmSize},
+		{"max proposal wasm size", p.MaxProposalWasmSize},
+		{"max label size", p.MaxLabelSize},
+		{"max address count", p.MaxAddressCount},
+	} {
+		if v.value > math.MaxInt32 {
+			return errorsmod.Wrapf(ErrLimit, "%s must not exceed %d", v.name, math.MaxInt32)
+		}
+	}
+	if err := p.ValidateAddressCount(len(p.CodeUploadAccess.Addresses)); err != nil {
+		return errorsmod.Wrap(err, "upload access")
+	}
+	if p.GasRegister != nil {
+		if err := p.GasRegister.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "gas register")
+		}
+	}
+	if p.StorageDepositPerByte != nil {
+		if err := p.StorageDepositPerByte.Validate(); err != nil {
+			return errorsmod.Wrap(err, "storage deposit per byte")
+		}
+	}
+	return nil
+}
+
+// StorageDeposit returns the deposit that is required for the given number of stored bytes
+func (p Params) StorageDeposit(storedBytes uint64) sdk.Coins {
+	if p.StorageDepositPerByte == nil {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(sdk.NewCoin(p.StorageDepositPerByte.Denom, p.StorageDepositPerByte.Amount.Mul(sdkmath.NewIntFromUint64(storedBytes))))
+}
+
+// WasmSizeLimit returns the max size of a contract code in bytes
+func (p Params) WasmSizeLimit() int {
+	return limitOrDefault(p.MaxWasmSize, MaxWasmSize)
+}
+
+// ProposalWasmSizeLimit returns the max size of a contract code in bytes that is stored by a gov proposal
+func (p Params) ProposalWasmSizeLimit() int {
+	return limitOrDefault(p.MaxProposalWasmSize, MaxProposalWasmSize)
+}
+
+// LabelSizeLimit returns the max length of a contract label
+func (p Params) LabelSizeLimit() int {
+	return limitOrDefault(p.MaxLabelSize, MaxLabelSize)
+}
+
+// AddressCountLimit returns the max number of addresses within an access config
+func (p Params) AddressCountLimit() int {
+	return limitOrDefault(p.MaxAddressCount, MaxAddressCount)
+}
+
+func limitOrDefault(v uint64, defaultLimit int) int {
+	if v == 0 {
+		return defaultLimit
+	}
+	return int(v)
+}
+
+// ValidateWasmCode ensures the contract code does not exceed the max wasm size
+func (p Params) ValidateWasmCode(code []byte) error {
+	return validateWasmCodeSize(code, p.WasmSizeLimit())
+}
+
+// ValidateProposalWasmCode ensures the contract code does not exceed the max wasm size for gov proposals
+func (p Params) ValidateProposalWasmCode(code []byte) error {
+	return validateWasmCodeSize(code, p.ProposalWasmSizeLimit())
+}
+
+// ValidateLabelSize ensures the label does not exceed the max label size
+func (p Params) ValidateLabelSize(label string) error {
+	if len(label) > p.LabelSizeLimit() {
+		return ErrLimit.Wrapf("cannot be longer than %d characters", p.LabelSizeLimit())
+	}
 	return nil
 }
 
+// ValidateAddressCount ensures the number of addresses does not exceed the max address count
+func (p Params) ValidateAddressCount(n int) error {
+	if n > p.AddressCountLimit() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "total number of addresses is greater than %d", p.AddressCountLimit())
+	}
+	return nil
+}
+
+// MinCallbackFee returns the minimum fee that a callback with the given gas limit must pay for each execution
+func (p Params) MinCallbackFee(gasLimit uint64) sdk.Coins {
+	if p.CallbackGasPrice == nil {
+		return sdk.NewCoins()
+	}
+	fee := p.CallbackGasPrice.Amount.Mul(sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasLimit))).Ceil().TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(p.CallbackGasPrice.Denom, fee))
+}
+
 func validateAccessType(a AccessType) error {
 	if a == AccessTypeUnspecified {
 		return errorsmod.Wrap(ErrEmpty, "type")
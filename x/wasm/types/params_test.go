@@ -3,11 +3,15 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,9 +19,10 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	var (
-		anyAddress     sdk.AccAddress = make([]byte, ContractAddrLen)
-		otherAddress   sdk.AccAddress = bytes.Repeat([]byte{1}, ContractAddrLen)
-		invalidAddress                = "invalid address"
+		anyAddress         sdk.AccAddress = make([]byte, ContractAddrLen)
+		otherAddress       sdk.AccAddress = bytes.Repeat([]byte{1}, ContractAddrLen)
+		invalidAddress                    = "invalid address"
+		defaultGasRegister                = NewGasRegisterParams(DefaultGasRegisterConfig())
 	)
 
 	specs := map[string]struct {
@@ -105,6 +110,121 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with callback gas price": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				CallbackGasPrice:             &sdk.DecCoin{Denom: "stake", Amount: sdkmath.LegacyNewDecWithPrec(25, 3)},
+			},
+		},
+		"reject callback gas price with negative amount": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				CallbackGasPrice:             &sdk.DecCoin{Denom: "stake", Amount: sdkmath.LegacyNewDec(-1)},
+			},
+			expErr: true,
+		},
+		"all good with limits unset": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+			},
+		},
+		"reject wasm size exceeds int32": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				MaxWasmSize:                  math.MaxInt32 + 1,
+			},
+			expErr: true,
+		},
+		"reject proposal wasm size exceeds int32": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				MaxProposalWasmSize:          math.MaxInt32 + 1,
+			},
+			expErr: true,
+		},
+		"reject label size exceeds int32": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				MaxLabelSize:                 math.MaxInt32 + 1,
+			},
+			expErr: true,
+		},
+		"reject address count exceeds int32": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				MaxAddressCount:              math.MaxInt32 + 1,
+			},
+			expErr: true,
+		},
+		"all good with gas register": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  &defaultGasRegister,
+			},
+		},
+		"reject gas register with zero gas multiplier": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister: func() *GasRegisterParams {
+					gr := NewGasRegisterParams(DefaultGasRegisterConfig())
+					gr.GasMultiplier = 0
+					return &gr
+				}(),
+			},
+			expErr: true,
+		},
+		"reject gas register with zero uncompress cost denominator": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister: func() *GasRegisterParams {
+					gr := NewGasRegisterParams(DefaultGasRegisterConfig())
+					gr.UncompressCostDenominator = 0
+					return &gr
+				}(),
+			},
+			expErr: true,
+		},
+		"all good with storage deposit": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositPerByte:        &sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(1)},
+			},
+		},
+		"reject storage deposit with invalid denom": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositPerByte:        &sdk.Coin{Denom: "1", Amount: sdkmath.NewInt(1)},
+			},
+			expErr: true,
+		},
+		"reject storage deposit with negative amount": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositPerByte:        &sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(-1)},
+			},
+			expErr: true,
+		},
+		"reject more addresses in any of addresses than max address count": {
+			src: Params{
+				CodeUploadAccess:             AccessTypeAnyOfAddresses.With(anyAddress, otherAddress),
+				InstantiateDefaultPermission: AccessTypeAnyOfAddresses,
+				MaxAddressCount:              1,
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -166,9 +286,21 @@ func TestParamsUnmarshalJson(t *testing.T) {
 	}{
 		"defaults": {
 			src: `{"code_upload_access": {"permission": "Everybody"},
-				"instantiate_default_permission": "Everybody"}`,
+				"instantiate_default_permission": "Everybody",
+				"max_wasm_size": "819200",
+				"max_proposal_wasm_size": "3145728",
+				"max_label_size": "128",
+				"max_address_count": "50"}`,
 			exp: DefaultParams(),
 		},
+		"without limits": {
+			src: `{"code_upload_access": {"permission": "Everybody"},
+				"instantiate_default_permission": "Everybody"}`,
+			exp: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+			},
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -248,3 +380,57 @@ func TestAccessTypeWith(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsLimits(t *testing.T) {
+	specs := map[string]struct {
+		src             Params
+		expWasmSize     int
+		expProposalSize int
+		expLabelSize    int
+		expAddressCount int
+	}{
+		"defaults": {
+			src:             DefaultParams(),
+			expWasmSize:     MaxWasmSize,
+			expProposalSize: MaxProposalWasmSize,
+			expLabelSize:    MaxLabelSize,
+			expAddressCount: MaxAddressCount,
+		},
+		"unset": {
+			src:             Params{},
+			expWasmSize:     MaxWasmSize,
+			expProposalSize: MaxProposalWasmSize,
+			expLabelSize:    MaxLabelSize,
+			expAddressCount: MaxAddressCount,
+		},
+		"custom": {
+			src: Params{
+				MaxWasmSize:         1,
+				MaxProposalWasmSize: 2,
+				MaxLabelSize:        3,
+				MaxAddressCount:     4,
+			},
+			expWasmSize:     1,
+			expProposalSize: 2,
+			expLabelSize:    3,
+			expAddressCount: 4,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.expWasmSize, spec.src.WasmSizeLimit())
+			assert.Equal(t, spec.expProposalSize, spec.src.ProposalWasmSizeLimit())
+			assert.Equal(t, spec.expLabelSize, spec.src.LabelSizeLimit())
+			assert.Equal(t, spec.expAddressCount, spec.src.AddressCountLimit())
+
+			require.NoError(t, spec.src.ValidateWasmCode(make([]byte, spec.expWasmSize)))
+			require.ErrorIs(t, spec.src.ValidateWasmCode(make([]byte, spec.expWasmSize+1)), ErrLimit)
+			require.NoError(t, spec.src.ValidateProposalWasmCode(make([]byte, spec.expProposalSize)))
+			require.ErrorIs(t, spec.src.ValidateProposalWasmCode(make([]byte, spec.expProposalSize+1)), ErrLimit)
+			require.NoError(t, spec.src.ValidateLabelSize(strings.Repeat("a", spec.expLabelSize)))
+			require.ErrorIs(t, spec.src.ValidateLabelSize(strings.Repeat("a", spec.expLabelSize+1)), ErrLimit)
+			require.NoError(t, spec.src.ValidateAddressCount(spec.expAddressCount))
+			require.Error(t, spec.src.ValidateAddressCount(spec.expAddressCount+1))
+		})
+	}
+}
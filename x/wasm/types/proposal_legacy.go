@@ -89,7 +89,7 @@ func (p StoreCodeProposal) ValidateBasic() error {
 		return errorsmod.Wrap(err, "run as")
 	}
 
-	if err := validateWasmCode(p.WASMByteCode, MaxProposalWasmSize); err != nil {
+	if err := validateWasmCode(p.WASMByteCode); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes %s", err.Error())
 	}
 
@@ -340,7 +340,7 @@ func (p StoreAndInstantiateContractProposal) ValidateBasic() error {
 		return errorsmod.Wrap(err, "run as")
 	}
 
-	if err := validateWasmCode(p.WASMByteCode, MaxProposalWasmSize); err != nil {
+	if err := validateWasmCode(p.WASMByteCode); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes %s", err.Error())
 	}
 
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	_ "embed"
 	"math/rand"
 
@@ -23,10 +24,19 @@ func GenesisFixture(mutators ...func(*GenesisState)) GenesisState {
 	)
 
 	fixture := GenesisState{
-		Params:    DefaultParams(),
-		Codes:     make([]Code, numCodes),
-		Contracts: make([]Contract, numContracts),
-		Sequences: make([]Sequence, numSequences),
+		Params:           DefaultParams(),
+		Codes:            make([]Code, numCodes),
+		Contracts:        make([]Contract, numContracts),
+		Sequences:        make([]Sequence, numSequences),
+		CodeRemovalQueue: [][]byte{randBytes(sha256.Size)},
+		Callbacks:        []ContractCallback{ContractCallbackFixture()},
+		PendingAdminTransfers: []PendingAdminTransfer{{
+			Contract:      "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4",
+			ProposedAdmin: "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4",
+			ExpiryHeight:  100,
+		}},
+		PendingMigrations: []PendingMigration{PendingMigrationFixture()},
+		StorageUsages:     []ContractStorageUsage{ContractStorageUsageFixture()},
 	}
 	for i := 0; i < numCodes; i++ {
 		fixture.Codes[i] = CodeFixture()
@@ -125,6 +135,63 @@ func ContractInfoFixture(mutators ...func(*ContractInfo)) ContractInfo {
 	return fixture
 }
 
+// ContractCallbackFixture test fixture
+func ContractCallbackFixture(mutators ...func(*ContractCallback)) ContractCallback {
+	const anyAddress = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4"
+
+	fixture := ContractCallback{
+		ID:         1,
+		Contract:   anyAddress,
+		Sender:     anyAddress,
+		Msg:        []byte(`{"do":"something"}`),
+		NextHeight: 1,
+		Interval:   1,
+		GasLimit:   100_000,
+		Fee:        sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+		Escrow:     sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}
+
+	for _, m := range mutators {
+		m(&fixture)
+	}
+	return fixture
+}
+
+// PendingMigrationFixture test fixture
+func PendingMigrationFixture(mutators ...func(*PendingMigration)) PendingMigration {
+	const anyAddress = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4"
+
+	fixture := PendingMigration{
+		Contract:      anyAddress,
+		Sender:        anyAddress,
+		CodeID:        1,
+		Msg:           []byte(`{"foo":"bar"}`),
+		ExecuteHeight: 100,
+	}
+
+	for _, m := range mutators {
+		m(&fixture)
+	}
+	return fixture
+}
+
+// ContractStorageUsageFixture test fixture
+func ContractStorageUsageFixture(mutators ...func(*ContractStorageUsage)) ContractStorageUsage {
+	const anyAddress = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4"
+
+	fixture := ContractStorageUsage{
+		Contract:    anyAddress,
+		StoredBytes: 100,
+		Deposit:     sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Sponsor:     anyAddress,
+	}
+
+	for _, m := range mutators {
+		m(&fixture)
+	}
+	return fixture
+}
+
 // ContractCodeHistoryEntryFixture test fixture
 func ContractCodeHistoryEntryFixture(mutators ...func(*ContractCodeHistoryEntry)) ContractCodeHistoryEntry {
 	fixture := ContractCodeHistoryEntry{
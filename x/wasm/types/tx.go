@@ -64,7 +64,7 @@ func (msg MsgStoreCode) ValidateBasic() error {
 		return err
 	}
 
-	if err := validateWasmCode(msg.WASMByteCode, MaxWasmSize); err != nil {
+	if err := validateWasmCode(msg.WASMByteCode); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes %s", err.Error())
 	}
 
@@ -444,7 +444,7 @@ func (msg MsgStoreAndInstantiateContract) ValidateBasic() error {
 		return errorsmod.Wrap(err, "payload msg")
 	}
 
-	if err := validateWasmCode(msg.WASMByteCode, MaxWasmSize); err != nil {
+	if err := validateWasmCode(msg.WASMByteCode); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes %s", err.Error())
 	}
 
@@ -509,7 +509,7 @@ func (msg MsgStoreAndMigrateContract) ValidateBasic() error {
 		return errorsmod.Wrap(err, "payload msg")
 	}
 
-	if err := validateWasmCode(msg.WASMByteCode, MaxWasmSize); err != nil {
+	if err := validateWasmCode(msg.WASMByteCode); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes %s", err.Error())
 	}
 
@@ -553,3 +553,382 @@ func (msg MsgUpdateContractLabel) ValidateBasic() error {
 	}
 	return nil
 }
+
+func (msg MsgDeleteCode) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDeleteCode) Type() string {
+	return "delete-code"
+}
+
+func (msg MsgDeleteCode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+	return nil
+}
+
+func (msg MsgFreezeContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgFreezeContract) Type() string {
+	return "freeze-contract"
+}
+
+func (msg MsgFreezeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgUnfreezeContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUnfreezeContract) Type() string {
+	return "unfreeze-contract"
+}
+
+func (msg MsgUnfreezeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgRegisterCallback) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRegisterCallback) Type() string {
+	return "register-callback"
+}
+
+func (msg MsgRegisterCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := msg.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	if msg.StartHeight <= 0 {
+		return errorsmod.Wrap(ErrInvalid, "start height must be positive")
+	}
+	if err := validateCallbackGasLimit(msg.GasLimit); err != nil {
+		return errorsmod.Wrap(err, "gas limit")
+	}
+	if err := msg.Fee.Validate(); err != nil {
+		return errorsmod.Wrap(err, "fee")
+	}
+	if err := msg.Escrow.Validate(); err != nil {
+		return errorsmod.Wrap(err, "escrow")
+	}
+	if !msg.Escrow.IsAllGTE(msg.Fee) {
+		return errorsmod.Wrap(ErrInvalid, "escrow must cover the fee of at least one execution")
+	}
+	return nil
+}
+
+func (msg MsgCancelCallback) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelCallback) Type() string {
+	return "cancel-callback"
+}
+
+func (msg MsgCancelCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if msg.CallbackID == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "callback id is required")
+	}
+	return nil
+}
+
+func (msg MsgProposeAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgProposeAdmin) Type() string {
+	return "propose-admin"
+}
+
+func (msg MsgProposeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+		return errorsmod.Wrap(err, "new admin")
+	}
+	if strings.EqualFold(msg.Sender, msg.NewAdmin) {
+		return errorsmod.Wrap(ErrInvalid, "new admin is the same as the old")
+	}
+	return nil
+}
+
+func (msg MsgAcceptAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAcceptAdmin) Type() string {
+	return "accept-admin"
+}
+
+func (msg MsgAcceptAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgCancelAdminProposal) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelAdminProposal) Type() string {
+	return "cancel-admin-proposal"
+}
+
+func (msg MsgCancelAdminProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgUpdateMigrationDelay) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateMigrationDelay) Type() string {
+	return "update-migration-delay"
+}
+
+func (msg MsgUpdateMigrationDelay) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if msg.MigrationDelay > MaxMigrationDelay {
+		return errorsmod.Wrapf(ErrLimit, "migration delay must not exceed %d blocks", MaxMigrationDelay)
+	}
+	return nil
+}
+
+func (msg MsgCancelMigration) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelMigration) Type() string {
+	return "cancel-migration"
+}
+
+func (msg MsgCancelMigration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgMigrateContractsByCode) Route() string {
+	return RouterKey
+}
+
+func (msg MsgMigrateContractsByCode) Type() string {
+	return "migrate-contracts-by-code"
+}
+
+func (msg MsgMigrateContractsByCode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if msg.FromCodeID == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "from code id is required")
+	}
+	if msg.ToCodeID == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "to code id is required")
+	}
+	if msg.Limit > MaxContractsMigrateBatch {
+		return errorsmod.Wrapf(ErrLimit, "limit must not exceed %d", MaxContractsMigrateBatch)
+	}
+	if msg.StartAfter != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.StartAfter); err != nil {
+			return errorsmod.Wrap(err, "start after")
+		}
+	}
+	if err := msg.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	return nil
+}
+
+func (msg MsgInstantiateContractByChecksum) Route() string {
+	return RouterKey
+}
+
+func (msg MsgInstantiateContractByChecksum) Type() string {
+	return "instantiate-by-checksum"
+}
+
+func (msg MsgInstantiateContractByChecksum) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+
+	if err := ValidateChecksum(msg.Checksum); err != nil {
+		return errorsmod.Wrap(err, "checksum")
+	}
+
+	if err := ValidateLabel(msg.Label); err != nil {
+		return errorsmod.Wrap(err, "label")
+	}
+
+	if err := msg.Funds.Validate(); err != nil {
+		return errorsmod.Wrap(err, "funds")
+	}
+
+	if len(msg.Admin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return errorsmod.Wrap(err, "admin")
+		}
+	}
+	if err := msg.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	return nil
+}
+
+func (msg MsgStoreCodeChunk) Route() string {
+	return RouterKey
+}
+
+func (msg MsgStoreCodeChunk) Type() string {
+	return "store-code-chunk"
+}
+
+func (msg MsgStoreCodeChunk) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if msg.UploadID == 0 && msg.Index != 0 {
+		return errorsmod.Wrap(ErrInvalid, "index of first chunk must be zero")
+	}
+	if msg.Index >= MaxCodeUploadChunks {
+		return errorsmod.Wrapf(ErrLimit, "index must be lower than %d", MaxCodeUploadChunks)
+	}
+	if err := validateWasmCode(msg.Chunk); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chunk %s", err.Error())
+	}
+	return nil
+}
+
+func (msg MsgFinalizeCodeUpload) Route() string {
+	return RouterKey
+}
+
+func (msg MsgFinalizeCodeUpload) Type() string {
+	return "finalize-code-upload"
+}
+
+func (msg MsgFinalizeCodeUpload) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if msg.UploadID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "upload id")
+	}
+	if err := ValidateChecksum(msg.Checksum); err != nil {
+		return errorsmod.Wrap(err, "checksum")
+	}
+	if msg.InstantiatePermission != nil {
+		if err := msg.InstantiatePermission.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "instantiate permission")
+		}
+	}
+	return nil
+}
+
+func (msg MsgSponsorContractStorage) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSponsorContractStorage) Type() string {
+	return "sponsor-contract-storage"
+}
+
+func (msg MsgSponsorContractStorage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgClearContractStorageSponsor) Route() string {
+	return RouterKey
+}
+
+func (msg MsgClearContractStorageSponsor) Type() string {
+	return "clear-contract-storage-sponsor"
+}
+
+func (msg MsgClearContractStorageSponsor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgUpdateStateQuota) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateStateQuota) Type() string {
+	return "update-state-quota"
+}
+
+func (msg MsgUpdateStateQuota) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
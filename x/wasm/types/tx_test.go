@@ -152,13 +152,6 @@ func TestInstantiateContractValidation(t *testing.T) {
 			},
 			valid: false,
 		},
-		"label too long": {
-			msg: MsgInstantiateContract{
-				Sender: goodAddress,
-				Label:  strings.Repeat("food", 33),
-			},
-			valid: false,
-		},
 		"bad sender minimal": {
 			msg: MsgInstantiateContract{
 				Sender: badAddress,
@@ -260,14 +253,6 @@ func TestInstantiateContract2Validation(t *testing.T) {
 			},
 			valid: false,
 		},
-		"label too long": {
-			msg: MsgInstantiateContract2{
-				Sender: goodAddress,
-				Label:  strings.Repeat("food", 33),
-				Salt:   []byte{0},
-			},
-			valid: false,
-		},
 		"bad sender minimal": {
 			msg: MsgInstantiateContract2{
 				Sender: badAddress,
@@ -766,11 +751,6 @@ func TestMsgAddCodeUploadParamsAddressesValidation(t *testing.T) {
 	goodAddress2 := strings.ToUpper(goodAddress)
 	require.NotEqual(t, goodAddress, goodAddress2) // sanity check
 
-	tooManyAddresses := make([]string, MaxAddressCount+1)
-	for i := range tooManyAddresses {
-		tooManyAddresses[i] = sdk.AccAddress(bytes.Repeat([]byte{byte(i)}, 20)).String()
-	}
-
 	specs := map[string]struct {
 		src    MsgAddCodeUploadParamsAddresses
 		expErr bool
@@ -827,13 +807,6 @@ func TestMsgAddCodeUploadParamsAddressesValidation(t *testing.T) {
 			},
 			expErr: true,
 		},
-		"too many addresses": {
-			src: MsgAddCodeUploadParamsAddresses{
-				Authority: goodAddress,
-				Addresses: tooManyAddresses,
-			},
-			expErr: true,
-		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -853,11 +826,6 @@ func TestMsgRemoveCodeUploadParamsAddressesValidation(t *testing.T) {
 	goodAddress2 := strings.ToUpper(goodAddress)
 	require.NotEqual(t, goodAddress, goodAddress2) // sanity check
 
-	tooManyAddresses := make([]string, MaxAddressCount+1)
-	for i := range tooManyAddresses {
-		tooManyAddresses[i] = sdk.AccAddress(bytes.Repeat([]byte{byte(i)}, 20)).String()
-	}
-
 	specs := map[string]struct {
 		src    MsgRemoveCodeUploadParamsAddresses
 		expErr bool
@@ -914,13 +882,6 @@ func TestMsgRemoveCodeUploadParamsAddressesValidation(t *testing.T) {
 			},
 			expErr: true,
 		},
-		"too many addresses": {
-			src: MsgRemoveCodeUploadParamsAddresses{
-				Authority: goodAddress,
-				Addresses: tooManyAddresses,
-			},
-			expErr: true,
-		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -1174,15 +1135,6 @@ func TestMsgStoreAndInstantiateContractValidation(t *testing.T) {
 			},
 			valid: false,
 		},
-		"label too long": {
-			msg: MsgStoreAndInstantiateContract{
-				Authority:    goodAddress,
-				Label:        strings.Repeat("food", 33),
-				Msg:          []byte("{}"),
-				WASMByteCode: []byte("foo"),
-			},
-			valid: false,
-		},
 		"bad sender minimal": {
 			msg: MsgStoreAndInstantiateContract{
 				Authority:    badAddress,
@@ -1484,3 +1436,1010 @@ func TestMsgUpdateContractLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgDeleteCode(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgDeleteCode
+		expErr bool
+	}{
+		"all good": {
+			src: MsgDeleteCode{
+				Sender: goodAddress,
+				CodeID: 1,
+			},
+		},
+		"code id required": {
+			src: MsgDeleteCode{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+		"bad sender": {
+			src: MsgDeleteCode{
+				Sender: badAddress,
+				CodeID: 1,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgFreezeContractValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgFreezeContract
+		expErr bool
+	}{
+		"all good": {
+			src: MsgFreezeContract{
+				Authority: goodAddress,
+				Contract:  goodAddress,
+			},
+		},
+		"bad authority": {
+			src: MsgFreezeContract{
+				Authority: badAddress,
+				Contract:  goodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgFreezeContract{
+				Authority: goodAddress,
+				Contract:  badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnfreezeContractValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUnfreezeContract
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUnfreezeContract{
+				Authority: goodAddress,
+				Contract:  goodAddress,
+			},
+		},
+		"bad authority": {
+			src: MsgUnfreezeContract{
+				Authority: badAddress,
+				Contract:  goodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgUnfreezeContract{
+				Authority: goodAddress,
+				Contract:  badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRegisterCallbackValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	validMsg := func(mutators ...func(*MsgRegisterCallback)) MsgRegisterCallback {
+		r := MsgRegisterCallback{
+			Sender:      goodAddress,
+			Contract:    goodAddress,
+			Msg:         []byte(`{"do":"something"}`),
+			StartHeight: 1,
+			Interval:    1,
+			GasLimit:    100_000,
+			Fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+			Escrow:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		}
+		for _, m := range mutators {
+			m(&r)
+		}
+		return r
+	}
+
+	specs := map[string]struct {
+		src    MsgRegisterCallback
+		expErr bool
+	}{
+		"all good": {
+			src: validMsg(),
+		},
+		"without fee": {
+			src: validMsg(func(m *MsgRegisterCallback) {
+				m.Fee = nil
+				m.Escrow = nil
+			}),
+		},
+		"bad sender": {
+			src: validMsg(func(m *MsgRegisterCallback) {
+				m.Sender = badAddress
+			}),
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: validMsg(func(m *MsgRegisterCallback) {
+				m.Contract = badAddress
+			}),
+			expErr: true,
+		},
+		"non json msg": {
+			src: validMsg(func(m *MsgRegisterCallback) {
+				m.Msg = []byte("invalid json")
+			}),
+			expErr: true,
+		},
+		"empty msg": {
+			src: validMsg(func(m *MsgRegisterCallback) {
+				m.Msg = nil
+			}),
+			expErr: true,
+		},
+		"non positive start height": {
+			src: validMsg(func(m *MsgRegisterCallback) {
+				m.StartHeight = 0
+			}),
+			expErr: true,
+		},
+		"empty gas limit": {
+			src: validMsg(func(m *MsgRegisterCallback) {
+				m.GasLimit = 0
+			}),
+			expErr: true,
+		},
+		"gas limit exceeds max": {
+			src: validMsg(func(m *MsgRegisterCallback) {
+				m.GasLimit = MaxCallbackGasLimit + 1
+			}),
+			expErr: true,
+		},
+		"invalid fee": {
+			src: validMsg(func(m *MsgRegisterCallback) {
+				m.Fee = sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(-1)}}
+			}),
+			expErr: true,
+		},
+		"invalid escrow": {
+			src: validMsg(func(m *MsgRegisterCallback) {
+				m.Escrow = sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(-1)}}
+			}),
+			expErr: true,
+		},
+		"escrow does not cover fee": {
+			src: validMsg(func(m *MsgRegisterCallback) {
+				m.Escrow = sdk.NewCoins(sdk.NewInt64Coin("other", 10))
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelCallbackValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgCancelCallback
+		expErr bool
+	}{
+		"all good": {
+			src: MsgCancelCallback{
+				Sender:     goodAddress,
+				CallbackID: 1,
+			},
+		},
+		"bad sender": {
+			src: MsgCancelCallback{
+				Sender:     badAddress,
+				CallbackID: 1,
+			},
+			expErr: true,
+		},
+		"empty callback id": {
+			src: MsgCancelCallback{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgProposeAdminValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgProposeAdmin
+		expErr bool
+	}{
+		"all good": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				NewAdmin: otherGoodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"with expiry height": {
+			src: MsgProposeAdmin{
+				Sender:       goodAddress,
+				NewAdmin:     otherGoodAddress,
+				Contract:     anotherGoodAddress,
+				ExpiryHeight: 100,
+			},
+		},
+		"new admin required": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad sender": {
+			src: MsgProposeAdmin{
+				Sender:   badAddress,
+				NewAdmin: otherGoodAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad new admin": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				NewAdmin: badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				NewAdmin: otherGoodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+		"new admin same as old admin": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				NewAdmin: goodAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAcceptAdminValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgAcceptAdmin
+		expErr bool
+	}{
+		"all good": {
+			src: MsgAcceptAdmin{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgAcceptAdmin{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgAcceptAdmin{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelAdminProposalValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgCancelAdminProposal
+		expErr bool
+	}{
+		"all good": {
+			src: MsgCancelAdminProposal{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgCancelAdminProposal{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgCancelAdminProposal{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateMigrationDelayValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateMigrationDelay
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateMigrationDelay{
+				Sender:         goodAddress,
+				Contract:       anotherGoodAddress,
+				MigrationDelay: 100,
+			},
+		},
+		"zero delay": {
+			src: MsgUpdateMigrationDelay{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"max delay": {
+			src: MsgUpdateMigrationDelay{
+				Sender:         goodAddress,
+				Contract:       anotherGoodAddress,
+				MigrationDelay: MaxMigrationDelay,
+			},
+		},
+		"delay exceeds max": {
+			src: MsgUpdateMigrationDelay{
+				Sender:         goodAddress,
+				Contract:       anotherGoodAddress,
+				MigrationDelay: MaxMigrationDelay + 1,
+			},
+			expErr: true,
+		},
+		"bad sender": {
+			src: MsgUpdateMigrationDelay{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgUpdateMigrationDelay{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelMigrationValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgCancelMigration
+		expErr bool
+	}{
+		"all good": {
+			src: MsgCancelMigration{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgCancelMigration{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgCancelMigration{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgMigrateContractsByCodeValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgMigrateContractsByCode
+		expErr bool
+	}{
+		"all good": {
+			src: MsgMigrateContractsByCode{
+				Authority:  goodAddress,
+				FromCodeID: 1,
+				ToCodeID:   2,
+				Msg:        []byte("{}"),
+			},
+		},
+		"all good with limit and start after": {
+			src: MsgMigrateContractsByCode{
+				Authority:  goodAddress,
+				FromCodeID: 1,
+				ToCodeID:   2,
+				Msg:        []byte("{}"),
+				Limit:      MaxContractsMigrateBatch,
+				StartAfter: anotherGoodAddress,
+			},
+		},
+		"bad authority": {
+			src: MsgMigrateContractsByCode{
+				Authority:  badAddress,
+				FromCodeID: 1,
+				ToCodeID:   2,
+				Msg:        []byte("{}"),
+			},
+			expErr: true,
+		},
+		"from code id empty": {
+			src: MsgMigrateContractsByCode{
+				Authority: goodAddress,
+				ToCodeID:  2,
+				Msg:       []byte("{}"),
+			},
+			expErr: true,
+		},
+		"to code id empty": {
+			src: MsgMigrateContractsByCode{
+				Authority:  goodAddress,
+				FromCodeID: 1,
+				Msg:        []byte("{}"),
+			},
+			expErr: true,
+		},
+		"limit exceeds max": {
+			src: MsgMigrateContractsByCode{
+				Authority:  goodAddress,
+				FromCodeID: 1,
+				ToCodeID:   2,
+				Msg:        []byte("{}"),
+				Limit:      MaxContractsMigrateBatch + 1,
+			},
+			expErr: true,
+		},
+		"bad start after": {
+			src: MsgMigrateContractsByCode{
+				Authority:  goodAddress,
+				FromCodeID: 1,
+				ToCodeID:   2,
+				Msg:        []byte("{}"),
+				StartAfter: badAddress,
+			},
+			expErr: true,
+		},
+		"non json msg": {
+			src: MsgMigrateContractsByCode{
+				Authority:  goodAddress,
+				FromCodeID: 1,
+				ToCodeID:   2,
+				Msg:        []byte("invalid json"),
+			},
+			expErr: true,
+		},
+		"empty msg": {
+			src: MsgMigrateContractsByCode{
+				Authority:  goodAddress,
+				FromCodeID: 1,
+				ToCodeID:   2,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgInstantiateContractByChecksumValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	checksum := bytes.Repeat([]byte{0x1}, 32)
+
+	specs := map[string]struct {
+		src    MsgInstantiateContractByChecksum
+		expErr bool
+	}{
+		"all good": {
+			src: MsgInstantiateContractByChecksum{
+				Sender:   goodAddress,
+				Checksum: checksum,
+				Label:    "foo",
+				Msg:      []byte("{}"),
+			},
+		},
+		"with admin and funds": {
+			src: MsgInstantiateContractByChecksum{
+				Sender:   goodAddress,
+				Admin:    goodAddress,
+				Checksum: checksum,
+				Label:    "foo",
+				Msg:      []byte("{}"),
+				Funds:    sdk.Coins{sdk.NewInt64Coin("denom", 1)},
+			},
+		},
+		"bad sender": {
+			src: MsgInstantiateContractByChecksum{
+				Sender:   badAddress,
+				Checksum: checksum,
+				Label:    "foo",
+				Msg:      []byte("{}"),
+			},
+			expErr: true,
+		},
+		"bad admin": {
+			src: MsgInstantiateContractByChecksum{
+				Sender:   goodAddress,
+				Admin:    badAddress,
+				Checksum: checksum,
+				Label:    "foo",
+				Msg:      []byte("{}"),
+			},
+			expErr: true,
+		},
+		"empty checksum": {
+			src: MsgInstantiateContractByChecksum{
+				Sender: goodAddress,
+				Label:  "foo",
+				Msg:    []byte("{}"),
+			},
+			expErr: true,
+		},
+		"invalid checksum length": {
+			src: MsgInstantiateContractByChecksum{
+				Sender:   goodAddress,
+				Checksum: checksum[1:],
+				Label:    "foo",
+				Msg:      []byte("{}"),
+			},
+			expErr: true,
+		},
+		"missing label": {
+			src: MsgInstantiateContractByChecksum{
+				Sender:   goodAddress,
+				Checksum: checksum,
+				Msg:      []byte("{}"),
+			},
+			expErr: true,
+		},
+		"negative funds": {
+			src: MsgInstantiateContractByChecksum{
+				Sender:   goodAddress,
+				Checksum: checksum,
+				Label:    "foo",
+				Msg:      []byte("{}"),
+				Funds:    sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdkmath.NewInt(-1)}},
+			},
+			expErr: true,
+		},
+		"non json msg": {
+			src: MsgInstantiateContractByChecksum{
+				Sender:   goodAddress,
+				Checksum: checksum,
+				Label:    "foo",
+				Msg:      []byte("invalid json"),
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgStoreCodeChunkValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgStoreCodeChunk
+		expErr bool
+	}{
+		"first chunk": {
+			src: MsgStoreCodeChunk{
+				Sender: goodAddress,
+				Chunk:  []byte("foo"),
+			},
+		},
+		"next chunk": {
+			src: MsgStoreCodeChunk{
+				Sender:   goodAddress,
+				UploadID: 1,
+				Index:    1,
+				Chunk:    []byte("foo"),
+			},
+		},
+		"bad sender": {
+			src: MsgStoreCodeChunk{
+				Sender: badAddress,
+				Chunk:  []byte("foo"),
+			},
+			expErr: true,
+		},
+		"non zero index without upload id": {
+			src: MsgStoreCodeChunk{
+				Sender: goodAddress,
+				Index:  1,
+				Chunk:  []byte("foo"),
+			},
+			expErr: true,
+		},
+		"index exceeds max chunks": {
+			src: MsgStoreCodeChunk{
+				Sender:   goodAddress,
+				UploadID: 1,
+				Index:    MaxCodeUploadChunks,
+				Chunk:    []byte("foo"),
+			},
+			expErr: true,
+		},
+		"empty chunk": {
+			src: MsgStoreCodeChunk{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgFinalizeCodeUploadValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	checksum := bytes.Repeat([]byte{0x1}, 32)
+
+	specs := map[string]struct {
+		src    MsgFinalizeCodeUpload
+		expErr bool
+	}{
+		"all good": {
+			src: MsgFinalizeCodeUpload{
+				Sender:   goodAddress,
+				UploadID: 1,
+				Checksum: checksum,
+			},
+		},
+		"with instantiate permission": {
+			src: MsgFinalizeCodeUpload{
+				Sender:                goodAddress,
+				UploadID:              1,
+				Checksum:              checksum,
+				InstantiatePermission: &AllowEverybody,
+			},
+		},
+		"bad sender": {
+			src: MsgFinalizeCodeUpload{
+				Sender:   badAddress,
+				UploadID: 1,
+				Checksum: checksum,
+			},
+			expErr: true,
+		},
+		"empty upload id": {
+			src: MsgFinalizeCodeUpload{
+				Sender:   goodAddress,
+				Checksum: checksum,
+			},
+			expErr: true,
+		},
+		"invalid checksum length": {
+			src: MsgFinalizeCodeUpload{
+				Sender:   goodAddress,
+				UploadID: 1,
+				Checksum: checksum[1:],
+			},
+			expErr: true,
+		},
+		"invalid instantiate permission": {
+			src: MsgFinalizeCodeUpload{
+				Sender:                goodAddress,
+				UploadID:              1,
+				Checksum:              checksum,
+				InstantiatePermission: &AccessConfig{Permission: AccessTypeUnspecified},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSponsorContractStorageValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgSponsorContractStorage
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSponsorContractStorage{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgSponsorContractStorage{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgSponsorContractStorage{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgClearContractStorageSponsorValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgClearContractStorageSponsor
+		expErr bool
+	}{
+		"all good": {
+			src: MsgClearContractStorageSponsor{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgClearContractStorageSponsor{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgClearContractStorageSponsor{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateStateQuotaValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateStateQuota
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateStateQuota{
+				Sender:       goodAddress,
+				Contract:     anotherGoodAddress,
+				MaxStateSize: 1024,
+			},
+		},
+		"no limit": {
+			src: MsgUpdateStateQuota{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgUpdateStateQuota{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgUpdateStateQuota{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"reflect"
 	"slices"
+	"strconv"
+	"strings"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -97,6 +99,9 @@ func (c *ContractInfo) ValidateBasic() error {
 	if err := ValidateLabel(c.Label); err != nil {
 		return errorsmod.Wrap(err, "label")
 	}
+	if c.MigrationDelay > MaxMigrationDelay {
+		return errorsmod.Wrapf(ErrLimit, "migration delay must not exceed %d blocks", MaxMigrationDelay)
+	}
 	if c.Extension == nil {
 		return nil
 	}
@@ -111,6 +116,91 @@ func (c *ContractInfo) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic syntax checks
+func (c ContractCallback) ValidateBasic() error {
+	if c.ID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "id")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if err := c.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	if c.NextHeight <= 0 {
+		return errorsmod.Wrap(ErrInvalid, "next height must be positive")
+	}
+	if err := validateCallbackGasLimit(c.GasLimit); err != nil {
+		return errorsmod.Wrap(err, "gas limit")
+	}
+	if err := c.Fee.Validate(); err != nil {
+		return errorsmod.Wrap(err, "fee")
+	}
+	if err := c.Escrow.Validate(); err != nil {
+		return errorsmod.Wrap(err, "escrow")
+	}
+	return nil
+}
+
+// ValidateBasic syntax checks
+func (p PendingAdminTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.ProposedAdmin); err != nil {
+		return errorsmod.Wrap(err, "proposed admin")
+	}
+	return nil
+}
+
+// ValidateBasic syntax checks
+func (p PendingMigration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if p.CodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code id")
+	}
+	if err := p.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	if p.ExecuteHeight <= 0 {
+		return errorsmod.Wrap(ErrInvalid, "execute height must be positive")
+	}
+	return nil
+}
+
+// ValidateBasic syntax checks
+func (u ContractStorageUsage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(u.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := u.Deposit.Validate(); err != nil {
+		return errorsmod.Wrap(err, "deposit")
+	}
+	if u.Sponsor != "" {
+		if _, err := sdk.AccAddressFromBech32(u.Sponsor); err != nil {
+			return errorsmod.Wrap(err, "sponsor")
+		}
+	}
+	return nil
+}
+
+// DepositPayer returns the address that pays the storage deposit. This is the sponsor or the contract itself
+// when no sponsor is set.
+func (u ContractStorageUsage) DepositPayer() sdk.AccAddress {
+	if u.Sponsor != "" {
+		return sdk.MustAccAddressFromBech32(u.Sponsor)
+	}
+	return sdk.MustAccAddressFromBech32(u.Contract)
+}
+
 // SetExtension set new extension data. Calls `ValidateBasic() error` on non nil values when method is implemented by
 // the extension.
 func (c *ContractInfo) SetExtension(ext ContractInfoExtension) error {
@@ -327,11 +417,38 @@ type NodeConfig struct {
 	SimulationGasLimit *uint64 `mapstructure:"simulation_gas_limit"`
 	// SmartQueryGasLimit is the max gas to be used in a smart query contract call
 	SmartQueryGasLimit uint64 `mapstructure:"query_gas_limit"`
+	// SmartQueryCacheSize is the max number of smart query results of the latest committed height that are cached
+	// for the gRPC queries. The cache is disabled when 0.
+	SmartQueryCacheSize uint32 `mapstructure:"query_cache_size"`
 	// MemoryCacheSize in MiB not bytes
 	MemoryCacheSize uint32 `mapstructure:"memory_cache_size"`
-	// ContractDebugMode log what contract print
+	// ContractDebugMode log what contract print. The output is printed to stderr by wasmvm and can not be
+	// collected per contract call, as wasmvm has no hook to register a debug handler.
 	ContractDebugMode bool
-}
+	// MetricsCodeIDs is the allowlist of codes that get their own labels in the contract execution metrics
+	MetricsCodeIDs []uint64 `mapstructure:"metrics_code_ids"`
+	// TracingExporter enables OpenTelemetry tracing of contract calls with the "file" or "otlp" exporter.
+	// Tracing is disabled when not set.
+	TracingExporter string `mapstructure:"tracing_exporter"`
+	// TracingEndpoint is the file path for the "file" exporter or the OTLP/HTTP endpoint url for the "otlp" exporter
+	TracingEndpoint string `mapstructure:"tracing_endpoint"`
+	// DisableLegacyEvents skips the untyped events of the module actions, like "instantiate" or "pin_code".
+	// The typed events are always emitted. Submessages that pass their events to the calling contract
+	// still emit the legacy events.
+	DisableLegacyEvents bool `mapstructure:"disable_legacy_events"`
+	// IndexerEnabled indexes the wasm events, contract operations and execute calls into a local database
+	// and serves them with the node local Indexer gRPC service
+	IndexerEnabled bool `mapstructure:"indexer_enabled"`
+	// IndexerRetainBlocks is the number of recent blocks that are kept in the indexer database.
+	// Older entries are pruned with each new block. The database grows without bounds when 0.
+	IndexerRetainBlocks uint64 `mapstructure:"indexer_retain_blocks"`
+}
+
+// OpenTelemetry tracing exporters
+const (
+	TracingExporterFile = "file"
+	TracingExporterOTLP = "otlp"
+)
 
 // DefaultNodeConfig returns the default settings for NodeConfig
 func DefaultNodeConfig() NodeConfig {
@@ -353,12 +470,20 @@ func ConfigTemplate(c NodeConfig) string {
 	if c.SimulationGasLimit != nil {
 		simGasLimit = fmt.Sprintf(`simulation_gas_limit = %d`, *c.SimulationGasLimit)
 	}
+	metricsCodeIDs := make([]string, len(c.MetricsCodeIDs))
+	for i, id := range c.MetricsCodeIDs {
+		metricsCodeIDs[i] = strconv.FormatUint(id, 10)
+	}
 
 	return fmt.Sprintf(`
 [wasm]
 # Smart query gas limit is the max gas to be used in a smart query contract call
 query_gas_limit = %d
 
+# Max number of smart query results of the latest committed height that are cached for the gRPC queries.
+# Set to 0 to disable.
+query_cache_size = %d
+
 # in-memory cache for Wasm contracts. Set to 0 to disable.
 # The value is in MiB not bytes
 memory_cache_size = %d
@@ -366,7 +491,32 @@ memory_cache_size = %d
 # Simulation gas limit is the max gas to be used in a tx simulation call.
 # When not set the consensus max block gas is used instead
 %s
-`, c.SmartQueryGasLimit, c.MemoryCacheSize, simGasLimit)
+
+# Code ids that get their own code id and checksum labels in the contract execution metrics.
+# All other codes are labelled "other" to bound the label cardinality.
+metrics_code_ids = [%s]
+
+# OpenTelemetry tracing of contract calls, wasmvm calls, dispatched messages and queries.
+# The exporter is one of "file" or "otlp". Tracing is disabled when empty.
+tracing_exporter = "%s"
+
+# File path for the "file" exporter or OTLP/HTTP endpoint url for the "otlp" exporter,
+# for example "http://localhost:4318"
+tracing_endpoint = "%s"
+
+# Skip the untyped events of the module actions, like "instantiate" or "pin_code".
+# The typed events, like "cosmwasm.wasm.v1.EventInstantiate", are always emitted.
+# Submessages that pass their events to the calling contract still emit them.
+disable_legacy_events = %t
+
+# Index the wasm events, instantiate and migrate operations and execute calls of the new blocks
+# into a local database that is served by the cosmwasm.wasm.v1.Indexer gRPC service.
+indexer_enabled = %t
+
+# Number of recent blocks that are kept in the indexer database. Older entries are pruned with each new block.
+# Set to 0 to keep all blocks, the database grows without bounds then.
+indexer_retain_blocks = %d
+`, c.SmartQueryGasLimit, c.SmartQueryCacheSize, c.MemoryCacheSize, simGasLimit, strings.Join(metricsCodeIDs, ", "), c.TracingExporter, c.TracingEndpoint, c.DisableLegacyEvents, c.IndexerEnabled, c.IndexerRetainBlocks)
 }
 
 // VerifyAddressLen ensures that the address matches the expected length
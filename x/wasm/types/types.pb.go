@@ -12,7 +12,9 @@ import (
 
 	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -190,6 +192,35 @@ var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
 type Params struct {
 	CodeUploadAccess             AccessConfig `protobuf:"bytes,1,opt,name=code_upload_access,json=codeUploadAccess,proto3" json:"code_upload_access" yaml:"code_upload_access"`
 	InstantiateDefaultPermission AccessType   `protobuf:"varint,2,opt,name=instantiate_default_permission,json=instantiateDefaultPermission,proto3,enum=cosmwasm.wasm.v1.AccessType" json:"instantiate_default_permission,omitempty" yaml:"instantiate_default_permission"`
+	// CallbackGasPrice is the minimum fee per unit of the gas limit that a
+	// scheduled callback must pay for each execution. When not set, callbacks
+	// can be registered without a fee.
+	CallbackGasPrice *types.DecCoin `protobuf:"bytes,3,opt,name=callback_gas_price,json=callbackGasPrice,proto3" json:"callback_gas_price,omitempty" yaml:"callback_gas_price"`
+	// MaxWasmSize is the largest a contract code can be in bytes when storing
+	// code on chain. Zero for the compiled in default.
+	MaxWasmSize uint64 `protobuf:"varint,4,opt,name=max_wasm_size,json=maxWasmSize,proto3" json:"max_wasm_size,omitempty" yaml:"max_wasm_size"`
+	// MaxProposalWasmSize is the largest a contract code can be in bytes when
+	// stored by a gov proposal. Zero for the compiled in default.
+	MaxProposalWasmSize uint64 `protobuf:"varint,5,opt,name=max_proposal_wasm_size,json=maxProposalWasmSize,proto3" json:"max_proposal_wasm_size,omitempty" yaml:"max_proposal_wasm_size"`
+	// MaxLabelSize is the longest label that can be used for a contract
+	// instance. Zero for the compiled in default.
+	MaxLabelSize uint64 `protobuf:"varint,6,opt,name=max_label_size,json=maxLabelSize,proto3" json:"max_label_size,omitempty" yaml:"max_label_size"`
+	// MaxAddressCount is the maximum number of addresses allowed within an
+	// access config. Zero for the compiled in default.
+	MaxAddressCount uint64 `protobuf:"varint,7,opt,name=max_address_count,json=maxAddressCount,proto3" json:"max_address_count,omitempty" yaml:"max_address_count"`
+	// GasRegister are the costs used to charge contract executions. When not
+	// set, the gas register configured on the node is used.
+	GasRegister *GasRegisterParams `protobuf:"bytes,8,opt,name=gas_register,json=gasRegister,proto3" json:"gas_register,omitempty" yaml:"gas_register"`
+	// StorageDepositPerByte is the refundable deposit that is held for each
+	// byte of contract state. When not set, no deposit is required.
+	StorageDepositPerByte *types.Coin `protobuf:"bytes,9,opt,name=storage_deposit_per_byte,json=storageDepositPerByte,proto3" json:"storage_deposit_per_byte,omitempty" yaml:"storage_deposit_per_byte"`
+	// RedactedErrorJSON returns the redacted errors of submessages and queries
+	// to contracts as json with the codespace, code and an allowlisted reason.
+	// When not set, the legacy "codespace: X, code: Y" text is returned.
+	RedactedErrorJSON bool `protobuf:"varint,10,opt,name=redacted_error_json,json=redactedErrorJson,proto3" json:"redacted_error_json,omitempty" yaml:"redacted_error_json"`
+	// UniqueContractLabels rejects new contracts and label updates with a label
+	// that is used by another contract already
+	UniqueContractLabels bool `protobuf:"varint,11,opt,name=unique_contract_labels,json=uniqueContractLabels,proto3" json:"unique_contract_labels,omitempty" yaml:"unique_contract_labels"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -229,6 +260,73 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// GasRegisterParams are the costs used by the gas register. All costs are in
+// Cosmos SDK gas units unless stated otherwise.
+type GasRegisterParams struct {
+	// InstanceCost is charged when a contract instance is loaded
+	InstanceCost uint64 `protobuf:"varint,1,opt,name=instance_cost,json=instanceCost,proto3" json:"instance_cost,omitempty" yaml:"instance_cost"`
+	// InstanceCostDiscount is charged instead of InstanceCost when the contract
+	// is assumed to be in an in-memory cache
+	InstanceCostDiscount uint64 `protobuf:"varint,2,opt,name=instance_cost_discount,json=instanceCostDiscount,proto3" json:"instance_cost_discount,omitempty" yaml:"instance_cost_discount"`
+	// CompileCost is charged per byte to compile a new contract code
+	CompileCost uint64 `protobuf:"varint,3,opt,name=compile_cost,json=compileCost,proto3" json:"compile_cost,omitempty" yaml:"compile_cost"`
+	// UncompressCostNumerator and UncompressCostDenominator define the fraction
+	// charged per byte to unpack a gzipped contract code
+	UncompressCostNumerator   uint64 `protobuf:"varint,4,opt,name=uncompress_cost_numerator,json=uncompressCostNumerator,proto3" json:"uncompress_cost_numerator,omitempty" yaml:"uncompress_cost_numerator"`
+	UncompressCostDenominator uint64 `protobuf:"varint,5,opt,name=uncompress_cost_denominator,json=uncompressCostDenominator,proto3" json:"uncompress_cost_denominator,omitempty" yaml:"uncompress_cost_denominator"`
+	// GasMultiplier is how many CosmWasm gas points are one SDK gas point
+	GasMultiplier uint64 `protobuf:"varint,6,opt,name=gas_multiplier,json=gasMultiplier,proto3" json:"gas_multiplier,omitempty" yaml:"gas_multiplier"`
+	// EventPerAttributeCost is charged per event attribute
+	EventPerAttributeCost uint64 `protobuf:"varint,7,opt,name=event_per_attribute_cost,json=eventPerAttributeCost,proto3" json:"event_per_attribute_cost,omitempty" yaml:"event_per_attribute_cost"`
+	// EventAttributeDataCost is charged per byte of event attribute data
+	EventAttributeDataCost uint64 `protobuf:"varint,8,opt,name=event_attribute_data_cost,json=eventAttributeDataCost,proto3" json:"event_attribute_data_cost,omitempty" yaml:"event_attribute_data_cost"`
+	// EventAttributeDataFreeTier is the number of bytes of event attribute data
+	// that are free of charge
+	EventAttributeDataFreeTier uint64 `protobuf:"varint,9,opt,name=event_attribute_data_free_tier,json=eventAttributeDataFreeTier,proto3" json:"event_attribute_data_free_tier,omitempty" yaml:"event_attribute_data_free_tier"`
+	// ContractMessageDataCost is charged per byte of a message to a contract
+	ContractMessageDataCost uint64 `protobuf:"varint,10,opt,name=contract_message_data_cost,json=contractMessageDataCost,proto3" json:"contract_message_data_cost,omitempty" yaml:"contract_message_data_cost"`
+	// CustomEventCost is charged per custom event
+	CustomEventCost uint64 `protobuf:"varint,11,opt,name=custom_event_cost,json=customEventCost,proto3" json:"custom_event_cost,omitempty" yaml:"custom_event_cost"`
+}
+
+func (m *GasRegisterParams) Reset()         { *m = GasRegisterParams{} }
+func (m *GasRegisterParams) String() string { return proto.CompactTextString(m) }
+func (*GasRegisterParams) ProtoMessage()    {}
+func (*GasRegisterParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{3}
+}
+
+func (m *GasRegisterParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *GasRegisterParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasRegisterParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *GasRegisterParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasRegisterParams.Merge(m, src)
+}
+
+func (m *GasRegisterParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *GasRegisterParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasRegisterParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasRegisterParams proto.InternalMessageInfo
+
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
 	// CodeHash is the unique identifier created by wasmvm
@@ -243,7 +341,7 @@ func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
 func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
 func (*CodeInfo) ProtoMessage()    {}
 func (*CodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{3}
+	return fileDescriptor_e6155d98fa173e02, []int{4}
 }
 
 func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
@@ -293,14 +391,23 @@ type ContractInfo struct {
 	IBC2PortID string              `protobuf:"bytes,7,opt,name=ibc2_port_id,json=ibc2PortId,proto3" json:"ibc2_port_id,omitempty"`
 	// Extension is an extension point to store custom metadata within the
 	// persistence model.
-	Extension *types.Any `protobuf:"bytes,8,opt,name=extension,proto3" json:"extension,omitempty"`
+	Extension *types1.Any `protobuf:"bytes,8,opt,name=extension,proto3" json:"extension,omitempty"`
+	// Frozen is set by the governance authority to reject any execution of the
+	// contract. Queries are still possible.
+	Frozen bool `protobuf:"varint,9,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// MigrationDelay is the number of blocks a migration by the admin is queued
+	// before it is executed. Zero for an immediate execution.
+	MigrationDelay uint64 `protobuf:"varint,10,opt,name=migration_delay,json=migrationDelay,proto3" json:"migration_delay,omitempty"`
+	// MaxStateSize is the maximum number of bytes the contract can store in its
+	// state. Zero for no limit.
+	MaxStateSize uint64 `protobuf:"varint,11,opt,name=max_state_size,json=maxStateSize,proto3" json:"max_state_size,omitempty"`
 }
 
 func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{4}
+	return fileDescriptor_e6155d98fa173e02, []int{5}
 }
 
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
@@ -348,7 +455,7 @@ func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEnt
 func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
 func (*ContractCodeHistoryEntry) ProtoMessage()    {}
 func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{5}
+	return fileDescriptor_e6155d98fa173e02, []int{6}
 }
 
 func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
@@ -396,7 +503,7 @@ func (m *AbsoluteTxPosition) Reset()         { *m = AbsoluteTxPosition{} }
 func (m *AbsoluteTxPosition) String() string { return proto.CompactTextString(m) }
 func (*AbsoluteTxPosition) ProtoMessage()    {}
 func (*AbsoluteTxPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{6}
+	return fileDescriptor_e6155d98fa173e02, []int{7}
 }
 
 func (m *AbsoluteTxPosition) XXX_Unmarshal(b []byte) error {
@@ -442,7 +549,7 @@ func (m *Model) Reset()         { *m = Model{} }
 func (m *Model) String() string { return proto.CompactTextString(m) }
 func (*Model) ProtoMessage()    {}
 func (*Model) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{7}
+	return fileDescriptor_e6155d98fa173e02, []int{8}
 }
 
 func (m *Model) XXX_Unmarshal(b []byte) error {
@@ -476,100 +583,449 @@ func (m *Model) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Model proto.InternalMessageInfo
 
+// ContractCallback is a sudo call into a contract that is scheduled for the
+// end of a block.
+type ContractCallback struct {
+	// ID is the unique identifier of the callback
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Sender is the address that registered the callback and receives the
+	// refund of the escrow
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Msg json encoded message to be passed to the contract's sudo entry point
+	Msg RawContractMessage `protobuf:"bytes,4,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// NextHeight is the block height of the next execution
+	NextHeight int64 `protobuf:"varint,5,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// Interval is the number of blocks between executions. Zero for a one time
+	// callback.
+	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// GasLimit is the max gas that can be consumed by a single execution
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Fee is charged from the escrow for each execution
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// Escrow is the remaining amount to pay the execution fees
+	Escrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=escrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrow"`
+}
+
+func (m *ContractCallback) Reset()         { *m = ContractCallback{} }
+func (m *ContractCallback) String() string { return proto.CompactTextString(m) }
+func (*ContractCallback) ProtoMessage()    {}
+func (*ContractCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{9}
+}
+
+func (m *ContractCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ContractCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallback.Merge(m, src)
+}
+
+func (m *ContractCallback) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCallback proto.InternalMessageInfo
+
+// PendingAdminTransfer is an admin change that was proposed by the current
+// admin of a contract and waits to be accepted by the new admin
+type PendingAdminTransfer struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// ProposedAdmin is the address that can accept the admin role
+	ProposedAdmin string `protobuf:"bytes,2,opt,name=proposed_admin,json=proposedAdmin,proto3" json:"proposed_admin,omitempty"`
+	// ExpiryHeight is the last block height the proposal can be accepted at.
+	// Zero for no expiry.
+	ExpiryHeight uint64 `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *PendingAdminTransfer) Reset()         { *m = PendingAdminTransfer{} }
+func (m *PendingAdminTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingAdminTransfer) ProtoMessage()    {}
+func (*PendingAdminTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{10}
+}
+
+func (m *PendingAdminTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *PendingAdminTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAdminTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *PendingAdminTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAdminTransfer.Merge(m, src)
+}
+
+func (m *PendingAdminTransfer) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *PendingAdminTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAdminTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAdminTransfer proto.InternalMessageInfo
+
+// PendingMigration is a migration by the contract admin that is executed
+// after the contract's migration delay
+type PendingMigration struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Sender is the address that requested the migration
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// CodeID references the new WASM code
+	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Msg json encoded message to be passed to the contract on migration
+	Msg RawContractMessage `protobuf:"bytes,4,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// ExecuteHeight is the block height at which the migration is executed
+	ExecuteHeight int64 `protobuf:"varint,5,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+}
+
+func (m *PendingMigration) Reset()         { *m = PendingMigration{} }
+func (m *PendingMigration) String() string { return proto.CompactTextString(m) }
+func (*PendingMigration) ProtoMessage()    {}
+func (*PendingMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{11}
+}
+
+func (m *PendingMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *PendingMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *PendingMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMigration.Merge(m, src)
+}
+
+func (m *PendingMigration) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *PendingMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMigration proto.InternalMessageInfo
+
+// CodeUploadSession stages the chunks of a wasm code upload that exceeds the
+// max tx size until it is finalized
+type CodeUploadSession struct {
+	// ID is the unique identifier of the upload session
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Sender is the address that started the upload and can add chunks
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ChunkCount is the number of chunks stored
+	ChunkCount uint64 `protobuf:"varint,3,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	// TotalSize is the number of bytes stored
+	TotalSize uint64 `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// ExpiryHeight is the last block height the session can be continued at.
+	// The session is pruned after this height.
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *CodeUploadSession) Reset()         { *m = CodeUploadSession{} }
+func (m *CodeUploadSession) String() string { return proto.CompactTextString(m) }
+func (*CodeUploadSession) ProtoMessage()    {}
+func (*CodeUploadSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{12}
+}
+
+func (m *CodeUploadSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CodeUploadSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeUploadSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *CodeUploadSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeUploadSession.Merge(m, src)
+}
+
+func (m *CodeUploadSession) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CodeUploadSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeUploadSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeUploadSession proto.InternalMessageInfo
+
+// ContractStorageUsage is the size of the contract state and the deposit
+// that is held for it
+type ContractStorageUsage struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// StoredBytes is the sum of the key and value lengths of the contract state
+	StoredBytes uint64 `protobuf:"varint,2,opt,name=stored_bytes,json=storedBytes,proto3" json:"stored_bytes,omitempty"`
+	// Deposit is the amount held for the stored bytes
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// Sponsor is the address that pays the deposit instead of the contract.
+	// Empty when the deposit is paid from the contract balance.
+	Sponsor string `protobuf:"bytes,4,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+}
+
+func (m *ContractStorageUsage) Reset()         { *m = ContractStorageUsage{} }
+func (m *ContractStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*ContractStorageUsage) ProtoMessage()    {}
+func (*ContractStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{13}
+}
+
+func (m *ContractStorageUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStorageUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ContractStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorageUsage.Merge(m, src)
+}
+
+func (m *ContractStorageUsage) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStorageUsage proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
 	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
 	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
 	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1.Params")
+	proto.RegisterType((*GasRegisterParams)(nil), "cosmwasm.wasm.v1.GasRegisterParams")
 	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1.CodeInfo")
 	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
 	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+	proto.RegisterType((*ContractCallback)(nil), "cosmwasm.wasm.v1.ContractCallback")
+	proto.RegisterType((*PendingAdminTransfer)(nil), "cosmwasm.wasm.v1.PendingAdminTransfer")
+	proto.RegisterType((*PendingMigration)(nil), "cosmwasm.wasm.v1.PendingMigration")
+	proto.RegisterType((*CodeUploadSession)(nil), "cosmwasm.wasm.v1.CodeUploadSession")
+	proto.RegisterType((*ContractStorageUsage)(nil), "cosmwasm.wasm.v1.ContractStorageUsage")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
 
 var fileDescriptor_e6155d98fa173e02 = []byte{
-	// 1221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xda, 0x4e, 0x62, 0x4f, 0x4c, 0xd9, 0x0e, 0xa9, 0xea, 0x98, 0xc8, 0x36, 0x4b, 0x09,
-	0x6d, 0xda, 0xda, 0xad, 0x41, 0x15, 0xea, 0xa1, 0x92, 0x3f, 0xb6, 0xcd, 0x56, 0x8a, 0x6d, 0xad,
-	0x5d, 0x4a, 0x90, 0xca, 0x6a, 0x3f, 0xc6, 0xce, 0x50, 0x7b, 0xc7, 0xda, 0x19, 0xa7, 0xf6, 0x7f,
-	0x80, 0x8c, 0x90, 0x38, 0x70, 0x40, 0x48, 0x96, 0x90, 0x40, 0xd0, 0x63, 0x0f, 0xfd, 0x07, 0xb8,
-	0x55, 0x9c, 0x2a, 0x4e, 0x9c, 0x2c, 0x70, 0x0f, 0xe5, 0x9c, 0x03, 0x87, 0x9e, 0xd0, 0xce, 0xd8,
-	0xf5, 0x8a, 0x7e, 0xc4, 0x70, 0x59, 0xcd, 0xbc, 0xf7, 0x7e, 0xbf, 0x79, 0xef, 0xf7, 0x66, 0xdf,
-	0x2e, 0xd8, 0xb2, 0x09, 0xed, 0xde, 0x33, 0x69, 0x37, 0xcf, 0x1f, 0x87, 0x97, 0xf3, 0x6c, 0xd8,
-	0x43, 0x34, 0xd7, 0xf3, 0x08, 0x23, 0x50, 0x9e, 0x7b, 0x73, 0xfc, 0x71, 0x78, 0x39, 0xb5, 0xe9,
-	0x5b, 0x08, 0x35, 0xb8, 0x3f, 0x2f, 0x36, 0x22, 0x38, 0xb5, 0xd1, 0x26, 0x6d, 0x22, 0xec, 0xfe,
-	0x6a, 0x66, 0xdd, 0x6c, 0x13, 0xd2, 0xee, 0xa0, 0x3c, 0xdf, 0x59, 0xfd, 0x56, 0xde, 0x74, 0x87,
-	0x33, 0xd7, 0x49, 0xb3, 0x8b, 0x5d, 0x92, 0xe7, 0x4f, 0x61, 0x52, 0xee, 0x80, 0x37, 0x8b, 0xb6,
-	0x8d, 0x28, 0x6d, 0x0e, 0x7b, 0xa8, 0x6e, 0x7a, 0x66, 0x17, 0x56, 0xc0, 0xca, 0xa1, 0xd9, 0xe9,
-	0xa3, 0xa4, 0x94, 0x95, 0xce, 0x9e, 0x28, 0x6c, 0xe5, 0xfe, 0x9d, 0x53, 0x6e, 0x81, 0x28, 0xc9,
-	0x47, 0x93, 0x4c, 0x62, 0x68, 0x76, 0x3b, 0x57, 0x15, 0x0e, 0x52, 0x74, 0x01, 0xbe, 0x1a, 0xfd,
-	0xf6, 0xfb, 0x8c, 0xa4, 0xfc, 0x2c, 0x81, 0x84, 0x88, 0x2e, 0x13, 0xb7, 0x85, 0xdb, 0xb0, 0x01,
-	0x40, 0x0f, 0x79, 0x5d, 0x4c, 0x29, 0x26, 0xee, 0x52, 0x27, 0x9c, 0x3a, 0x9a, 0x64, 0x4e, 0x8a,
-	0x13, 0x16, 0x48, 0x45, 0x0f, 0xd0, 0xc0, 0x2b, 0x20, 0x6e, 0x3a, 0x8e, 0x87, 0x28, 0x45, 0x34,
-	0x19, 0xc9, 0x46, 0xce, 0xc6, 0x4b, 0xc9, 0xdf, 0x1e, 0x5e, 0xdc, 0x98, 0xa9, 0x55, 0x14, 0xbe,
-	0x06, 0xf3, 0xb0, 0xdb, 0xd6, 0x17, 0xa1, 0x22, 0xc7, 0x9b, 0xd1, 0x58, 0x58, 0x8e, 0x28, 0xdf,
-	0x84, 0xc1, 0x2a, 0xaf, 0x9f, 0x42, 0x06, 0xa0, 0x4d, 0x1c, 0x64, 0xf4, 0x7b, 0x1d, 0x62, 0x3a,
-	0x86, 0xc9, 0x73, 0xe1, 0xb9, 0xae, 0x17, 0xd2, 0xaf, 0xca, 0x55, 0xd4, 0x57, 0xda, 0x7e, 0x34,
-	0xc9, 0x84, 0x8e, 0x26, 0x99, 0x4d, 0x91, 0xf1, 0x8b, 0x3c, 0xca, 0xfd, 0xa7, 0x0f, 0x76, 0x24,
-	0x5d, 0xf6, 0x3d, 0xb7, 0xb8, 0x43, 0xe0, 0xe1, 0x57, 0x12, 0x48, 0x63, 0x97, 0x32, 0xd3, 0x65,
-	0xd8, 0x64, 0xc8, 0x70, 0x50, 0xcb, 0xec, 0x77, 0x98, 0x11, 0x90, 0x2b, 0xbc, 0x84, 0x5c, 0xe7,
-	0x8e, 0x26, 0x99, 0xf7, 0xc4, 0xe1, 0xaf, 0x67, 0x53, 0xf4, 0xad, 0x40, 0x40, 0x45, 0xf8, 0xeb,
-	0xcf, 0xdd, 0x5c, 0x9c, 0x90, 0xf2, 0x8b, 0x04, 0x62, 0x65, 0xe2, 0x20, 0xcd, 0x6d, 0x11, 0xf8,
-	0x36, 0x88, 0xf3, 0x82, 0x0e, 0x4c, 0x7a, 0xc0, 0xf5, 0x48, 0xe8, 0x31, 0xdf, 0xb0, 0x6b, 0xd2,
-	0x03, 0x58, 0x00, 0x6b, 0xb6, 0x87, 0x4c, 0x46, 0x3c, 0x9e, 0xe7, 0xeb, 0x5a, 0x30, 0x0f, 0x84,
-	0x9f, 0x00, 0x18, 0x4c, 0xd2, 0xe6, 0x1a, 0x26, 0x57, 0x96, 0x52, 0x3a, 0xee, 0x2b, 0x2d, 0xc4,
-	0x3c, 0x19, 0x20, 0x11, 0xde, 0x9b, 0xd1, 0x58, 0x44, 0x8e, 0xde, 0x8c, 0xc6, 0xa2, 0xf2, 0x8a,
-	0xf2, 0x30, 0x02, 0x12, 0x65, 0xe2, 0x32, 0xcf, 0xb4, 0x19, 0xaf, 0xe3, 0x5d, 0xb0, 0xc6, 0xeb,
-	0xc0, 0x0e, 0xaf, 0x22, 0x5a, 0x02, 0xd3, 0x49, 0x66, 0x95, 0x97, 0x59, 0xd1, 0x57, 0x7d, 0x97,
-	0xe6, 0xfc, 0xaf, 0x7a, 0x72, 0x60, 0xc5, 0x74, 0xba, 0xd8, 0x4d, 0x46, 0x8e, 0x41, 0x88, 0x30,
-	0xb8, 0x01, 0x56, 0x3a, 0xa6, 0x85, 0x3a, 0xc9, 0xa8, 0x1f, 0xaf, 0x8b, 0x0d, 0xbc, 0x36, 0x3b,
-	0x19, 0x39, 0x33, 0x29, 0xce, 0xbc, 0x44, 0x0a, 0x8b, 0x92, 0x4e, 0x9f, 0xa1, 0xe6, 0xa0, 0x4e,
-	0x28, 0x66, 0x98, 0xb8, 0xfa, 0x1c, 0x04, 0x2f, 0x82, 0x75, 0x6c, 0xd9, 0x46, 0x8f, 0x78, 0xcc,
-	0x2f, 0x71, 0x95, 0xe7, 0xf2, 0xc6, 0x74, 0x92, 0x89, 0x6b, 0xa5, 0x72, 0x9d, 0x78, 0x4c, 0xab,
-	0xe8, 0x71, 0x6c, 0xd9, 0x7c, 0xe9, 0xc0, 0x4b, 0x20, 0x81, 0x2d, 0xbb, 0xf0, 0x3c, 0x7e, 0x8d,
-	0xc7, 0x9f, 0x98, 0x4e, 0x32, 0x40, 0x2b, 0x95, 0x0b, 0x33, 0x00, 0xf0, 0x63, 0x66, 0x88, 0xcf,
-	0x40, 0x1c, 0x0d, 0x18, 0x72, 0xf9, 0xa5, 0x8c, 0xf1, 0x14, 0x37, 0x72, 0x62, 0xec, 0xe4, 0xe6,
-	0x63, 0x27, 0x57, 0x74, 0x87, 0xa5, 0x9d, 0x5f, 0x1f, 0x5e, 0xdc, 0x7e, 0x21, 0xf7, 0x60, 0x2f,
-	0xd4, 0x39, 0x8f, 0xbe, 0xa0, 0xbc, 0x1a, 0xfd, 0xcb, 0x9f, 0x1d, 0x5f, 0x86, 0x41, 0x72, 0x1e,
-	0xea, 0xf7, 0x66, 0x17, 0x53, 0x46, 0xbc, 0xa1, 0xea, 0x32, 0x6f, 0x08, 0xeb, 0x20, 0x4e, 0x7a,
-	0xc8, 0x33, 0xd9, 0x62, 0x8c, 0x14, 0x72, 0xaf, 0x3c, 0x29, 0x00, 0xaf, 0xcd, 0x51, 0xfe, 0xdb,
-	0xa2, 0x2f, 0x48, 0x82, 0x97, 0x22, 0xfc, 0xca, 0x4b, 0x71, 0x0d, 0xac, 0xf5, 0x7b, 0x0e, 0x6f,
-	0x4d, 0xe4, 0xbf, 0xb4, 0x66, 0x06, 0x82, 0x1f, 0x81, 0x48, 0x97, 0xb6, 0x79, 0xbb, 0x13, 0xa5,
-	0xed, 0x67, 0x93, 0x0c, 0xd4, 0xcd, 0x7b, 0xf3, 0x2c, 0xf7, 0x10, 0xa5, 0x66, 0x1b, 0x7d, 0xf7,
-	0xf4, 0xc1, 0xce, 0x3a, 0x76, 0x3b, 0xd8, 0x45, 0xc6, 0xe7, 0x94, 0xb8, 0xba, 0x0f, 0x51, 0x74,
-	0x00, 0x5f, 0x24, 0x86, 0xef, 0x80, 0x84, 0xd5, 0x21, 0xf6, 0x5d, 0xe3, 0x00, 0xe1, 0xf6, 0x01,
-	0x13, 0xd7, 0x59, 0x5f, 0xe7, 0xb6, 0x5d, 0x6e, 0x82, 0x9b, 0x20, 0xc6, 0x06, 0x06, 0x76, 0x1d,
-	0x34, 0x10, 0x85, 0xe9, 0x6b, 0x6c, 0xa0, 0xf9, 0x5b, 0x05, 0x81, 0x95, 0x3d, 0xe2, 0xa0, 0x0e,
-	0xbc, 0x0e, 0x22, 0x77, 0xd1, 0x50, 0xbc, 0xd2, 0xa5, 0x0f, 0x9f, 0x4d, 0x32, 0x97, 0xda, 0x98,
-	0x1d, 0xf4, 0xad, 0x9c, 0x4d, 0xba, 0x79, 0x9b, 0x74, 0x11, 0xb3, 0x5a, 0x6c, 0xb1, 0xe8, 0x60,
-	0x8b, 0xe6, 0xad, 0x21, 0x43, 0x34, 0xb7, 0x8b, 0x06, 0x25, 0x7f, 0xa1, 0xfb, 0x04, 0xfe, 0x7d,
-	0x16, 0x9f, 0x8e, 0x30, 0x1f, 0x0e, 0x62, 0xb3, 0xf3, 0xb7, 0x04, 0xc0, 0x62, 0x42, 0xc1, 0x2b,
-	0xe0, 0x74, 0xb1, 0x5c, 0x56, 0x1b, 0x0d, 0xa3, 0xb9, 0x5f, 0x57, 0x8d, 0x5b, 0xd5, 0x46, 0x5d,
-	0x2d, 0x6b, 0xd7, 0x35, 0xb5, 0x22, 0x87, 0x52, 0x9b, 0xa3, 0x71, 0xf6, 0xd4, 0x22, 0xf8, 0x96,
-	0x4b, 0x7b, 0xc8, 0xc6, 0x2d, 0x8c, 0x1c, 0x78, 0x01, 0xc0, 0x20, 0xae, 0x5a, 0x2b, 0xd5, 0x2a,
-	0xfb, 0xb2, 0x94, 0xda, 0x18, 0x8d, 0xb3, 0xf2, 0x02, 0x52, 0x25, 0x16, 0x71, 0x86, 0xb0, 0x00,
-	0x4e, 0x05, 0xa3, 0xd5, 0x8f, 0x55, 0x7d, 0x9f, 0x03, 0x22, 0xa9, 0xd3, 0xa3, 0x71, 0xf6, 0xad,
-	0x05, 0x40, 0x3d, 0x44, 0xde, 0x90, 0x63, 0xae, 0x81, 0xad, 0x20, 0xa6, 0x58, 0xdd, 0x37, 0x6a,
-	0xd7, 0x8d, 0x62, 0xa5, 0xa2, 0xab, 0x8d, 0x86, 0xda, 0x90, 0xa3, 0xa9, 0xad, 0xd1, 0x38, 0x9b,
-	0x5c, 0x40, 0x8b, 0xee, 0xb0, 0xd6, 0x2a, 0xce, 0xbf, 0x27, 0xa9, 0xd8, 0x17, 0x3f, 0xa4, 0x43,
-	0xf7, 0x7f, 0x4c, 0x87, 0x14, 0xff, 0x9b, 0x12, 0xde, 0xf9, 0x29, 0x02, 0xb2, 0xc7, 0x5d, 0x41,
-	0x88, 0xc0, 0xa5, 0x72, 0xad, 0xda, 0xd4, 0x8b, 0xe5, 0xa6, 0x51, 0xae, 0x55, 0x54, 0x63, 0x57,
-	0x6b, 0x34, 0x6b, 0xfa, 0xbe, 0x51, 0xab, 0xab, 0x7a, 0xb1, 0xa9, 0xd5, 0xaa, 0x2f, 0xd3, 0x29,
-	0x3f, 0x1a, 0x67, 0xcf, 0x1f, 0xc7, 0x1d, 0x54, 0xef, 0x36, 0x38, 0xb7, 0xd4, 0x31, 0x5a, 0x55,
-	0x6b, 0xca, 0x52, 0xea, 0xec, 0x68, 0x9c, 0x3d, 0x73, 0x1c, 0xbf, 0xe6, 0x62, 0x06, 0xef, 0x80,
-	0x0b, 0x4b, 0x11, 0xef, 0x69, 0x37, 0xf4, 0x62, 0x53, 0x95, 0xc3, 0xa9, 0xf3, 0xa3, 0x71, 0xf6,
-	0xfd, 0xe3, 0xb8, 0xf7, 0x70, 0xdb, 0x33, 0x19, 0x5a, 0x9a, 0xfe, 0x86, 0x5a, 0x55, 0x1b, 0x5a,
-	0x43, 0x8e, 0x2c, 0x47, 0x7f, 0x03, 0xb9, 0x88, 0x62, 0x9a, 0x8a, 0xfa, 0x2d, 0x2b, 0xed, 0x3e,
-	0xfa, 0x33, 0x1d, 0xba, 0x3f, 0x4d, 0x4b, 0x8f, 0xa6, 0x69, 0xe9, 0xf1, 0x34, 0x2d, 0xfd, 0x31,
-	0x4d, 0x4b, 0x5f, 0x3f, 0x49, 0x87, 0x1e, 0x3f, 0x49, 0x87, 0x7e, 0x7f, 0x92, 0x0e, 0x7d, 0xba,
-	0x1d, 0x78, 0x21, 0xca, 0x84, 0x76, 0x6f, 0xcf, 0xff, 0xe0, 0x9c, 0xfc, 0x40, 0xfc, 0xc9, 0xf1,
-	0xdf, 0x38, 0x6b, 0x95, 0xcf, 0xbf, 0x0f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x13, 0xdb, 0xf4,
-	0x60, 0xe7, 0x09, 0x00, 0x00,
+	// 2358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x6c, 0x1b, 0xc7,
+	0x19, 0x16, 0x1f, 0x92, 0xc8, 0x21, 0x25, 0x53, 0x63, 0x49, 0xa6, 0x18, 0x85, 0x4b, 0xaf, 0x1d,
+	0x47, 0x71, 0x62, 0xd2, 0x56, 0x82, 0xa0, 0x30, 0xda, 0xa4, 0x7c, 0xd9, 0xa2, 0x11, 0x4b, 0xc4,
+	0x50, 0x8e, 0xeb, 0xa2, 0xe9, 0x76, 0xb8, 0x3b, 0xa4, 0xb6, 0xe6, 0xee, 0xb0, 0x3b, 0x4b, 0x99,
+	0x4c, 0xef, 0x45, 0xa1, 0xa2, 0x40, 0x8f, 0x45, 0x0b, 0x01, 0x01, 0x5a, 0xb4, 0x41, 0x4f, 0x41,
+	0x91, 0x43, 0xaf, 0xed, 0x29, 0xe8, 0xa5, 0x41, 0x4f, 0x3d, 0xb1, 0xad, 0x72, 0x48, 0x8f, 0x05,
+	0x0f, 0x3d, 0xe4, 0x54, 0xcc, 0xcc, 0x2e, 0xb9, 0xd4, 0xc3, 0x52, 0x72, 0xc8, 0x85, 0xe4, 0xfc,
+	0x8f, 0xef, 0x9f, 0xf9, 0x5f, 0xf3, 0x0f, 0xc1, 0xba, 0x4e, 0x99, 0xf5, 0x0c, 0x33, 0xab, 0x20,
+	0x3e, 0xf6, 0xef, 0x14, 0xdc, 0x41, 0x97, 0xb0, 0x7c, 0xd7, 0xa1, 0x2e, 0x85, 0x29, 0x9f, 0x9b,
+	0x17, 0x1f, 0xfb, 0x77, 0x32, 0x6b, 0x9c, 0x42, 0x99, 0x26, 0xf8, 0x05, 0xb9, 0x90, 0xc2, 0x99,
+	0xac, 0x5c, 0x15, 0x9a, 0x98, 0x91, 0xc2, 0xfe, 0x9d, 0x26, 0x71, 0xf1, 0x9d, 0x82, 0x4e, 0x4d,
+	0xdb, 0xe3, 0x2f, 0xb7, 0x69, 0x9b, 0x4a, 0x3d, 0xfe, 0xcb, 0xa3, 0xae, 0xb5, 0x29, 0x6d, 0x77,
+	0x48, 0x41, 0xac, 0x9a, 0xbd, 0x56, 0x01, 0xdb, 0x03, 0x8f, 0xb5, 0x84, 0x2d, 0xd3, 0xa6, 0x05,
+	0xf1, 0x29, 0x49, 0xea, 0x7b, 0xe0, 0x52, 0x51, 0xd7, 0x09, 0x63, 0xbb, 0x83, 0x2e, 0xa9, 0x63,
+	0x07, 0x5b, 0xb0, 0x02, 0x66, 0xf7, 0x71, 0xa7, 0x47, 0xd2, 0xa1, 0x5c, 0x68, 0x63, 0x71, 0x73,
+	0x3d, 0x7f, 0x7c, 0xcf, 0xf9, 0x89, 0x46, 0x29, 0x35, 0x1a, 0x2a, 0xc9, 0x01, 0xb6, 0x3a, 0x77,
+	0x55, 0xa1, 0xa4, 0x22, 0xa9, 0x7c, 0x37, 0xfa, 0xcb, 0x0f, 0x94, 0x90, 0xfa, 0xfb, 0x10, 0x48,
+	0x4a, 0xe9, 0x32, 0xb5, 0x5b, 0x66, 0x1b, 0x36, 0x00, 0xe8, 0x12, 0xc7, 0x32, 0x19, 0x33, 0xa9,
+	0x7d, 0x21, 0x0b, 0x2b, 0xa3, 0xa1, 0xb2, 0x24, 0x2d, 0x4c, 0x34, 0x55, 0x14, 0x80, 0x81, 0x6f,
+	0x82, 0x38, 0x36, 0x0c, 0x87, 0x30, 0x46, 0x58, 0x3a, 0x92, 0x8b, 0x6c, 0xc4, 0x4b, 0xe9, 0xbf,
+	0x7f, 0x7c, 0x6b, 0xd9, 0xf3, 0x66, 0x51, 0xf2, 0x1a, 0xae, 0x63, 0xda, 0x6d, 0x34, 0x11, 0x95,
+	0x7b, 0x7c, 0x10, 0x8d, 0x85, 0x53, 0x11, 0xf5, 0x4f, 0x31, 0x30, 0x27, 0xce, 0xcf, 0xa0, 0x0b,
+	0xa0, 0x4e, 0x0d, 0xa2, 0xf5, 0xba, 0x1d, 0x8a, 0x0d, 0x0d, 0x8b, 0xbd, 0x88, 0xbd, 0x26, 0x36,
+	0xb3, 0x67, 0xed, 0x55, 0x9e, 0xaf, 0x74, 0xe3, 0x93, 0xa1, 0x32, 0x33, 0x1a, 0x2a, 0x6b, 0x72,
+	0xc7, 0x27, 0x71, 0xd4, 0x0f, 0x3f, 0xff, 0xe8, 0x66, 0x08, 0xa5, 0x38, 0xe7, 0x91, 0x60, 0x48,
+	0x7d, 0xf8, 0xf3, 0x10, 0xc8, 0x9a, 0x36, 0x73, 0xb1, 0xed, 0x9a, 0xd8, 0x25, 0x9a, 0x41, 0x5a,
+	0xb8, 0xd7, 0x71, 0xb5, 0x80, 0xbb, 0xc2, 0x17, 0x70, 0xd7, 0x2b, 0xa3, 0xa1, 0xf2, 0x92, 0x34,
+	0xfe, 0x7c, 0x34, 0x15, 0xad, 0x07, 0x04, 0x2a, 0x92, 0x5f, 0x9f, 0x38, 0xd5, 0x04, 0x50, 0xc7,
+	0x9d, 0x4e, 0x13, 0xeb, 0x4f, 0xb5, 0x36, 0xe6, 0x09, 0x6a, 0xea, 0x24, 0x1d, 0x11, 0x5e, 0x90,
+	0x5b, 0xa0, 0x2c, 0xcf, 0x53, 0x33, 0xef, 0xa5, 0x66, 0xbe, 0x42, 0xf4, 0x32, 0x35, 0xed, 0xd2,
+	0x8b, 0x81, 0xf3, 0x9f, 0x40, 0x50, 0x51, 0xca, 0x27, 0xde, 0xc7, 0xac, 0xce, 0x49, 0xf0, 0x9b,
+	0x60, 0xc1, 0xc2, 0x7d, 0x8d, 0x9f, 0x46, 0x63, 0xe6, 0xfb, 0x24, 0x1d, 0xcd, 0x85, 0x36, 0xa2,
+	0xa5, 0xf4, 0x68, 0xa8, 0x2c, 0x4b, 0x9c, 0x29, 0xb6, 0x8a, 0x12, 0x16, 0xee, 0x3f, 0xc6, 0xcc,
+	0x6a, 0x98, 0xef, 0x13, 0xf8, 0x2e, 0x58, 0xe5, 0xec, 0xae, 0x43, 0xbb, 0x94, 0xe1, 0x4e, 0x00,
+	0x66, 0x56, 0xc0, 0x5c, 0x1d, 0x0d, 0x95, 0x17, 0x27, 0x30, 0x27, 0xe5, 0x54, 0x74, 0xd9, 0xc2,
+	0xfd, 0xba, 0x47, 0x1f, 0xe3, 0xbe, 0x0d, 0x16, 0xb9, 0x7c, 0x07, 0x37, 0x49, 0x47, 0xe2, 0xcd,
+	0x09, 0xbc, 0xb5, 0xd1, 0x50, 0x59, 0x99, 0xe0, 0x4d, 0xf8, 0x2a, 0x4a, 0x5a, 0xb8, 0xff, 0x0e,
+	0x5f, 0x0b, 0x80, 0x2d, 0xb0, 0xc4, 0x05, 0xbc, 0x7c, 0xd3, 0x74, 0xda, 0xb3, 0xdd, 0xf4, 0xbc,
+	0xc0, 0x58, 0x1f, 0x0d, 0x95, 0xf4, 0x04, 0x63, 0x4a, 0x44, 0x45, 0x97, 0x2c, 0xdc, 0xf7, 0x92,
+	0xb6, 0xcc, 0x29, 0x50, 0x03, 0x49, 0xee, 0x40, 0x87, 0xb4, 0x4d, 0xe6, 0x12, 0x27, 0x1d, 0x13,
+	0x51, 0xb8, 0x76, 0x32, 0x11, 0xee, 0x63, 0x86, 0x3c, 0x21, 0x99, 0xcc, 0xa5, 0x2b, 0xa3, 0xa1,
+	0x72, 0x59, 0x5a, 0x0a, 0x42, 0xa8, 0x28, 0xd1, 0x9e, 0xc8, 0xc2, 0x67, 0x20, 0xcd, 0x5c, 0xea,
+	0xe0, 0x36, 0xcf, 0x94, 0x2e, 0x65, 0xa6, 0xc8, 0x14, 0xad, 0x39, 0x70, 0x49, 0x3a, 0x2e, 0x8c,
+	0xad, 0x9d, 0x1a, 0x72, 0x11, 0xef, 0x6b, 0xa3, 0xa1, 0xa2, 0x48, 0x13, 0x67, 0x81, 0xa8, 0x68,
+	0xc5, 0x63, 0x55, 0x24, 0xa7, 0x4e, 0x9c, 0xd2, 0xc0, 0x25, 0x50, 0x07, 0x97, 0x1d, 0x62, 0x60,
+	0xdd, 0x25, 0x86, 0x46, 0x1c, 0x87, 0x3a, 0xda, 0x0f, 0x19, 0xb5, 0xd3, 0x20, 0x17, 0xda, 0x88,
+	0x95, 0x5e, 0x3f, 0x1a, 0x2a, 0x4b, 0xc8, 0x63, 0x57, 0x39, 0xf7, 0x41, 0x63, 0x67, 0x7b, 0x34,
+	0x54, 0x32, 0xd2, 0xda, 0x29, 0x9a, 0x2a, 0x5a, 0x72, 0xa6, 0x14, 0x18, 0xb5, 0xe1, 0x63, 0xb0,
+	0xda, 0xb3, 0xcd, 0x1f, 0xf5, 0x88, 0xa6, 0x53, 0xdb, 0x75, 0xb0, 0xee, 0xca, 0xa8, 0xb1, 0x74,
+	0x42, 0xd8, 0x09, 0x64, 0xc8, 0xe9, 0x72, 0x2a, 0x5a, 0x96, 0x8c, 0xb2, 0x47, 0x17, 0x41, 0x96,
+	0x0d, 0x64, 0x46, 0xfd, 0xdb, 0x3c, 0x58, 0x3a, 0xe1, 0x78, 0xf8, 0x2d, 0xb0, 0x20, 0xeb, 0x4b,
+	0xe7, 0x70, 0xcc, 0x4d, 0x87, 0x8e, 0x27, 0xf5, 0x14, 0x5b, 0x45, 0x49, 0x7f, 0x5d, 0xa6, 0xcc,
+	0xe5, 0x7b, 0x9e, 0xe2, 0x6b, 0x86, 0xc9, 0x64, 0x06, 0x85, 0x8f, 0x67, 0xf5, 0xe9, 0x72, 0x2a,
+	0x5a, 0x0e, 0x02, 0x56, 0x3c, 0x32, 0xbc, 0x0b, 0x92, 0x3a, 0xb5, 0xba, 0x66, 0xc7, 0xdb, 0x56,
+	0x44, 0xc0, 0x05, 0xd2, 0x24, 0xc8, 0x55, 0x51, 0xc2, 0x5b, 0x8a, 0x4d, 0xfd, 0x00, 0xac, 0xf5,
+	0x6c, 0x4e, 0xf0, 0xb2, 0x95, 0xb9, 0x9a, 0xdd, 0xb3, 0x88, 0x83, 0x5d, 0xea, 0x78, 0x45, 0x7b,
+	0x7d, 0x34, 0x54, 0x72, 0xbe, 0x2f, 0xcf, 0x10, 0x55, 0xd1, 0x95, 0x09, 0x8f, 0x03, 0x6f, 0xfb,
+	0x1c, 0xd8, 0x02, 0x2f, 0x1c, 0x57, 0x33, 0x88, 0x4d, 0x2d, 0xd3, 0x16, 0x36, 0x64, 0x45, 0xdf,
+	0x18, 0x0d, 0x15, 0xf5, 0x74, 0x1b, 0x01, 0x61, 0x15, 0xad, 0x4d, 0x5b, 0xa9, 0x4c, 0x78, 0xf0,
+	0xdb, 0x60, 0x91, 0x97, 0x83, 0xd5, 0xeb, 0xb8, 0x66, 0xb7, 0x63, 0x12, 0xe7, 0x64, 0x71, 0x4f,
+	0xf3, 0x55, 0xb4, 0xd0, 0xc6, 0xec, 0xe1, 0x78, 0x0d, 0xbf, 0x07, 0xd2, 0x64, 0x9f, 0xd8, 0x32,
+	0xc7, 0xb1, 0xeb, 0x3a, 0x66, 0xb3, 0xe7, 0x7a, 0x3e, 0x95, 0x45, 0x1e, 0xa8, 0x8b, 0xb3, 0x24,
+	0x55, 0xb4, 0x22, 0x58, 0x75, 0xe2, 0x14, 0x7d, 0x86, 0xf0, 0xb4, 0x06, 0xd6, 0xa4, 0xce, 0x44,
+	0xde, 0xc0, 0x2e, 0x96, 0xf0, 0xb1, 0xe3, 0x9e, 0x3e, 0x53, 0x54, 0x45, 0xab, 0x82, 0x37, 0x06,
+	0xaf, 0x60, 0x17, 0x0b, 0x03, 0x16, 0xc8, 0x9e, 0xaa, 0xd5, 0x72, 0x08, 0xd1, 0x5c, 0xee, 0x90,
+	0xb8, 0xb0, 0x12, 0xb8, 0x4f, 0x9e, 0x2f, 0xaf, 0xa2, 0xcc, 0x49, 0x53, 0xf7, 0x1c, 0x42, 0x76,
+	0xb9, 0xb7, 0x9a, 0x20, 0x33, 0xae, 0x29, 0x8b, 0x30, 0x86, 0xdb, 0x9e, 0xbe, 0x38, 0x10, 0x10,
+	0xa6, 0x5e, 0x1a, 0x0d, 0x95, 0xab, 0x7e, 0x0e, 0x9e, 0x25, 0xab, 0xa2, 0x2b, 0x3e, 0xf3, 0xa1,
+	0xe4, 0x8d, 0x8f, 0xb4, 0x05, 0x96, 0xf4, 0x1e, 0x73, 0xa9, 0xa5, 0xc9, 0x9d, 0x0a, 0xe8, 0xc4,
+	0xf1, 0x7e, 0x7b, 0x42, 0x44, 0x45, 0x97, 0x24, 0xad, 0xca, 0x49, 0x1c, 0x49, 0xfd, 0x73, 0x08,
+	0xc4, 0xca, 0xd4, 0x20, 0x35, 0xbb, 0x45, 0xe1, 0x0b, 0x20, 0x2e, 0xae, 0xf1, 0x3d, 0xcc, 0xf6,
+	0x44, 0x11, 0x27, 0x51, 0x8c, 0x13, 0xb6, 0x30, 0xdb, 0x83, 0x9b, 0x60, 0x5e, 0x77, 0x88, 0xc8,
+	0x4d, 0x5e, 0x97, 0xcf, 0x1b, 0x3c, 0x7c, 0x41, 0xf8, 0x1d, 0x00, 0x83, 0x57, 0xb3, 0x2e, 0x26,
+	0x87, 0xf4, 0xec, 0x85, 0xe6, 0x8b, 0x38, 0x9f, 0x2f, 0xe4, 0x08, 0xb1, 0x14, 0x00, 0x91, 0xdc,
+	0x07, 0xd1, 0x58, 0x24, 0x15, 0x7d, 0x10, 0x8d, 0x45, 0x53, 0xb3, 0xea, 0xaf, 0xa3, 0x20, 0xe9,
+	0xb7, 0x2b, 0x71, 0x8e, 0x6b, 0x60, 0x5e, 0x9c, 0xc3, 0x34, 0xbc, 0x56, 0x04, 0x8e, 0x86, 0xca,
+	0x9c, 0x38, 0x66, 0x05, 0xcd, 0x71, 0x56, 0xcd, 0xf8, 0x4a, 0xe7, 0xc9, 0x83, 0x59, 0x6c, 0x58,
+	0xa6, 0x9d, 0x8e, 0x9c, 0xa3, 0x21, 0xc5, 0xe0, 0x32, 0x98, 0x15, 0x6d, 0x55, 0x74, 0x8c, 0x38,
+	0x92, 0x0b, 0xf8, 0x96, 0x67, 0x99, 0x18, 0x9e, 0x2b, 0xae, 0x9f, 0xe2, 0x8a, 0x26, 0xa3, 0x9d,
+	0x9e, 0x4b, 0x76, 0xfb, 0x75, 0x7e, 0x89, 0x98, 0xd4, 0x46, 0xbe, 0x12, 0xbc, 0x05, 0x12, 0x66,
+	0x53, 0xd7, 0xba, 0xd4, 0x71, 0xf9, 0x11, 0xe7, 0xc4, 0x5e, 0x16, 0x8e, 0x86, 0x4a, 0xbc, 0x56,
+	0x2a, 0xd7, 0xa9, 0xe3, 0xd6, 0x2a, 0x28, 0x6e, 0x36, 0x75, 0xf1, 0xd3, 0x80, 0xb7, 0x41, 0xd2,
+	0x6c, 0xea, 0x9b, 0x63, 0xf9, 0x79, 0x21, 0xbf, 0x78, 0x34, 0x54, 0x40, 0xad, 0x54, 0xde, 0xf4,
+	0x14, 0x00, 0x97, 0xf1, 0x34, 0xbe, 0x0f, 0xe2, 0xa4, 0xef, 0x12, 0x5b, 0x8c, 0x62, 0xf2, 0x06,
+	0x5e, 0xce, 0xcb, 0x61, 0x3b, 0xef, 0x0f, 0xdb, 0xf9, 0xa2, 0x3d, 0x28, 0xdd, 0xfc, 0xeb, 0xc7,
+	0xb7, 0x6e, 0x9c, 0xd8, 0x7b, 0x30, 0x16, 0x55, 0x1f, 0x07, 0x4d, 0x20, 0xe1, 0x2a, 0x98, 0x6b,
+	0x39, 0xf4, 0x7d, 0x62, 0x8b, 0xca, 0x8b, 0x21, 0x6f, 0x05, 0x5f, 0x06, 0x97, 0x2c, 0xb3, 0xed,
+	0x60, 0x7e, 0x5c, 0xcd, 0x20, 0x1d, 0x3c, 0x90, 0xf5, 0x82, 0x16, 0xc7, 0xe4, 0x0a, 0xa7, 0xc2,
+	0xeb, 0x72, 0x60, 0x61, 0x2e, 0xcf, 0x2a, 0x31, 0xb0, 0x88, 0xe4, 0x17, 0x53, 0x49, 0x83, 0x13,
+	0xf9, 0x54, 0x72, 0x37, 0xfa, 0x1f, 0x3e, 0x98, 0xff, 0x2c, 0x0c, 0xd2, 0xfe, 0x8e, 0x78, 0x0a,
+	0x6c, 0x99, 0xfc, 0x7e, 0x1e, 0x54, 0x6d, 0xd7, 0x19, 0xc0, 0x3a, 0x88, 0xd3, 0x2e, 0x91, 0xd0,
+	0xde, 0x8c, 0xbe, 0x99, 0x3f, 0xf3, 0x40, 0x01, 0xf5, 0x1d, 0x5f, 0x8b, 0x8f, 0xa2, 0x68, 0x02,
+	0x12, 0xcc, 0xbd, 0xf0, 0x99, 0xb9, 0xf7, 0x16, 0x98, 0xef, 0x75, 0x0d, 0x91, 0x01, 0x91, 0x2f,
+	0x93, 0x01, 0x9e, 0x12, 0xfc, 0x06, 0x88, 0x58, 0xac, 0x2d, 0xb2, 0x2a, 0x59, 0xba, 0xf1, 0xc5,
+	0x50, 0x81, 0x08, 0x3f, 0x2b, 0x4f, 0x37, 0x8b, 0x5f, 0x7d, 0xfe, 0xd1, 0xcd, 0x84, 0x69, 0x77,
+	0x4c, 0x9b, 0x88, 0x79, 0x01, 0x71, 0x15, 0x15, 0x01, 0x78, 0x12, 0x18, 0x5e, 0x05, 0xc9, 0x66,
+	0x87, 0xea, 0x4f, 0xb5, 0x3d, 0x62, 0xb6, 0xf7, 0xbc, 0x0b, 0x1c, 0x25, 0x04, 0x6d, 0x4b, 0x90,
+	0xe0, 0x1a, 0x88, 0xb9, 0x7d, 0xcd, 0xb4, 0x0d, 0xd2, 0x97, 0x07, 0x43, 0xf3, 0x6e, 0xbf, 0xc6,
+	0x97, 0x2a, 0x01, 0xb3, 0x0f, 0xa9, 0x41, 0x3a, 0xf0, 0x1e, 0x88, 0x3c, 0x25, 0x03, 0xd9, 0x39,
+	0x4a, 0x6f, 0x7c, 0x31, 0x54, 0x6e, 0xb7, 0x4d, 0x77, 0xaf, 0xd7, 0xcc, 0xeb, 0xd4, 0x2a, 0xe8,
+	0xd4, 0x22, 0x6e, 0xb3, 0xe5, 0x4e, 0x7e, 0x74, 0xcc, 0x26, 0x2b, 0xf0, 0x99, 0x89, 0xe5, 0xb7,
+	0x48, 0x9f, 0x0f, 0x49, 0x0c, 0x71, 0x00, 0x5e, 0x36, 0xf2, 0x5d, 0x16, 0x16, 0x3d, 0x48, 0x2e,
+	0xd4, 0x9f, 0x44, 0x41, 0x6a, 0x1c, 0x09, 0x6f, 0xb0, 0x86, 0xab, 0x20, 0x3c, 0xae, 0xf2, 0xb9,
+	0xa3, 0xa1, 0x12, 0xae, 0x55, 0x50, 0xd8, 0x34, 0xe0, 0x1b, 0x20, 0xe6, 0x37, 0xcf, 0x73, 0xcb,
+	0x7b, 0x2c, 0x09, 0x6f, 0x83, 0x39, 0x46, 0x6c, 0x83, 0x38, 0xe7, 0x16, 0xb8, 0x27, 0x07, 0x37,
+	0x82, 0x91, 0x58, 0x3d, 0x3d, 0x12, 0xc2, 0xf3, 0x50, 0x01, 0x09, 0x9b, 0xf4, 0x5d, 0xdf, 0xc5,
+	0xbc, 0xf2, 0x23, 0x08, 0x70, 0x92, 0xe7, 0xe1, 0x0c, 0x88, 0x99, 0xb6, 0x4b, 0x9c, 0x7d, 0xdc,
+	0x91, 0x57, 0x34, 0x1a, 0xaf, 0x79, 0x67, 0xe6, 0x97, 0x74, 0xc7, 0xb4, 0x4c, 0xef, 0xce, 0x45,
+	0xb1, 0x36, 0x66, 0xef, 0xf0, 0x35, 0x64, 0x20, 0xd2, 0x22, 0x24, 0x1d, 0xcb, 0x45, 0x9e, 0x3f,
+	0xbd, 0xde, 0xe3, 0x1d, 0xf5, 0x0f, 0xff, 0x54, 0x36, 0xa6, 0xa2, 0x22, 0x1e, 0xde, 0xf2, 0xeb,
+	0x16, 0x33, 0x9e, 0x7a, 0x8f, 0x78, 0xae, 0xc0, 0x78, 0x0a, 0x25, 0x3b, 0xa4, 0x8d, 0xf5, 0x81,
+	0xc6, 0x5f, 0xe3, 0x4c, 0xb6, 0x63, 0x6e, 0x0d, 0x0e, 0xc0, 0x1c, 0x61, 0xba, 0x43, 0x9f, 0xa5,
+	0xe3, 0x5f, 0x97, 0x5d, 0xcf, 0xa0, 0xfa, 0xc7, 0x10, 0x58, 0xae, 0x13, 0xdb, 0x30, 0xed, 0x76,
+	0x91, 0xb7, 0xd9, 0x5d, 0x07, 0xdb, 0xac, 0x45, 0x9c, 0xa9, 0xa0, 0x87, 0x2e, 0x1c, 0xf4, 0xb7,
+	0xc1, 0xa2, 0x7c, 0x29, 0x11, 0x43, 0x93, 0xdd, 0xfd, 0xbc, 0x84, 0x59, 0xf0, 0xe5, 0x85, 0x79,
+	0x78, 0x0d, 0x2c, 0x90, 0x7e, 0xd7, 0x74, 0x06, 0x7e, 0x6c, 0x23, 0xb2, 0x19, 0x49, 0xa2, 0x8c,
+	0xae, 0xfa, 0xdf, 0x10, 0x48, 0x79, 0x9b, 0x7e, 0xe8, 0x37, 0xb3, 0xaf, 0xb8, 0xe1, 0x49, 0x96,
+	0x86, 0x2f, 0x98, 0xa5, 0x81, 0xa6, 0x14, 0x39, 0xb3, 0x29, 0x5d, 0x3c, 0x95, 0x5f, 0x02, 0x8b,
+	0xa4, 0x4f, 0x74, 0x3e, 0x19, 0x4d, 0x65, 0xf3, 0x82, 0x47, 0xf5, 0x8e, 0xfc, 0x97, 0x10, 0x58,
+	0x2a, 0x8f, 0x1f, 0xff, 0x0d, 0xc2, 0xbc, 0xe6, 0x7f, 0x7a, 0xc5, 0x7e, 0xf9, 0x53, 0x29, 0x20,
+	0xa1, 0xef, 0xf5, 0xec, 0xa7, 0xde, 0x7b, 0x53, 0x7a, 0x1d, 0x08, 0x92, 0x7c, 0x4c, 0xbe, 0x08,
+	0x80, 0x4b, 0x5d, 0xdc, 0x09, 0x3c, 0xb5, 0x51, 0x5c, 0x50, 0xc4, 0xab, 0xf5, 0x44, 0xdc, 0xe4,
+	0x29, 0xa6, 0xe3, 0xf6, 0x41, 0x18, 0x2c, 0xfb, 0x4e, 0x68, 0xc8, 0x87, 0xdd, 0x23, 0xee, 0x89,
+	0xaf, 0x18, 0xbb, 0xab, 0x20, 0xc9, 0xef, 0x0f, 0x62, 0x88, 0xc7, 0x22, 0xf3, 0x5a, 0x69, 0x42,
+	0xd2, 0x44, 0x0b, 0x84, 0x3f, 0x06, 0xf3, 0xde, 0xa3, 0x32, 0x1d, 0xf9, 0xba, 0x4a, 0xcb, 0xb7,
+	0xc8, 0xa7, 0x22, 0xd6, 0xa5, 0x36, 0xf3, 0x5e, 0x39, 0xcf, 0x9d, 0x8a, 0x3c, 0xc1, 0x9b, 0xff,
+	0x0b, 0x01, 0x30, 0xf9, 0x5f, 0x06, 0xbe, 0x09, 0xae, 0x14, 0xcb, 0xe5, 0x6a, 0xa3, 0xa1, 0xed,
+	0x3e, 0xa9, 0x57, 0xb5, 0x47, 0xdb, 0x8d, 0x7a, 0xb5, 0x5c, 0xbb, 0x57, 0xab, 0x56, 0x52, 0x33,
+	0x99, 0xb5, 0x83, 0xc3, 0xdc, 0xca, 0x44, 0xf8, 0x91, 0xcd, 0xba, 0x44, 0x37, 0x5b, 0x26, 0x31,
+	0xe0, 0x6b, 0x00, 0x06, 0xf5, 0xb6, 0x77, 0x4a, 0x3b, 0x95, 0x27, 0xa9, 0x50, 0x66, 0xf9, 0xe0,
+	0x30, 0x97, 0x9a, 0xa8, 0x6c, 0xd3, 0x26, 0x35, 0x06, 0x70, 0x13, 0xac, 0x04, 0xa5, 0xab, 0xef,
+	0x56, 0xd1, 0x13, 0xa1, 0x10, 0xc9, 0x5c, 0x39, 0x38, 0xcc, 0x5d, 0x9e, 0x28, 0x54, 0xf7, 0x89,
+	0x33, 0x10, 0x3a, 0x6f, 0x81, 0xf5, 0xa0, 0x4e, 0x71, 0xfb, 0x89, 0xb6, 0x73, 0x4f, 0x2b, 0x56,
+	0x2a, 0xa8, 0xda, 0x68, 0x54, 0x1b, 0xa9, 0x68, 0x66, 0xfd, 0xe0, 0x30, 0x97, 0x9e, 0xa8, 0x16,
+	0xed, 0xc1, 0x4e, 0xab, 0xe8, 0xff, 0x8b, 0x96, 0x89, 0xfd, 0xf4, 0x37, 0xd9, 0x99, 0x0f, 0x7f,
+	0x9b, 0x9d, 0x51, 0xf9, 0x3f, 0x69, 0xe1, 0x9b, 0xbf, 0x8b, 0x80, 0xdc, 0x79, 0xb3, 0x01, 0x24,
+	0xe0, 0x76, 0x79, 0x67, 0x7b, 0x17, 0x15, 0xcb, 0xbb, 0x5a, 0x79, 0xa7, 0x52, 0xd5, 0xb6, 0x6a,
+	0x8d, 0xdd, 0x1d, 0xf4, 0x44, 0xdb, 0xa9, 0x57, 0x51, 0x71, 0xb7, 0xb6, 0xb3, 0x7d, 0x9a, 0x9f,
+	0x0a, 0x07, 0x87, 0xb9, 0x57, 0xcf, 0xc3, 0x0e, 0x7a, 0xef, 0x31, 0x78, 0xe5, 0x42, 0x66, 0x6a,
+	0xdb, 0xb5, 0xdd, 0x54, 0x28, 0xb3, 0x71, 0x70, 0x98, 0xbb, 0x7e, 0x1e, 0x7e, 0xcd, 0x36, 0x5d,
+	0xf8, 0x1e, 0x78, 0xed, 0x42, 0xc0, 0x0f, 0x6b, 0xf7, 0x51, 0x71, 0xb7, 0x9a, 0x0a, 0x67, 0x5e,
+	0x3d, 0x38, 0xcc, 0xbd, 0x7c, 0x1e, 0xb6, 0x6c, 0x82, 0xe4, 0xc2, 0xf0, 0xf7, 0xab, 0xdb, 0xd5,
+	0x46, 0xad, 0x91, 0x8a, 0x5c, 0x0c, 0xfe, 0x3e, 0xb1, 0x09, 0x33, 0x59, 0x26, 0xca, 0x43, 0x56,
+	0xda, 0xfa, 0xe4, 0xdf, 0xd9, 0x99, 0x0f, 0x8f, 0xb2, 0xa1, 0x4f, 0x8e, 0xb2, 0xa1, 0x4f, 0x8f,
+	0xb2, 0xa1, 0x7f, 0x1d, 0x65, 0x43, 0xbf, 0xf8, 0x2c, 0x3b, 0xf3, 0xe9, 0x67, 0xd9, 0x99, 0x7f,
+	0x7c, 0x96, 0x9d, 0xf9, 0xee, 0x8d, 0x40, 0x01, 0x95, 0x29, 0xb3, 0x1e, 0xfb, 0xff, 0x6b, 0x1b,
+	0x85, 0xbe, 0xf8, 0x96, 0x45, 0xd4, 0x9c, 0x13, 0xf3, 0xef, 0xeb, 0xff, 0x1f, 0x00, 0xa5, 0xae,
+	0xe9, 0x11, 0xfd, 0x16, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -655,6 +1111,88 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
 		return false
 	}
+	if !this.CallbackGasPrice.Equal(that1.CallbackGasPrice) {
+		return false
+	}
+	if this.MaxWasmSize != that1.MaxWasmSize {
+		return false
+	}
+	if this.MaxProposalWasmSize != that1.MaxProposalWasmSize {
+		return false
+	}
+	if this.MaxLabelSize != that1.MaxLabelSize {
+		return false
+	}
+	if this.MaxAddressCount != that1.MaxAddressCount {
+		return false
+	}
+	if !this.GasRegister.Equal(that1.GasRegister) {
+		return false
+	}
+	if !this.StorageDepositPerByte.Equal(that1.StorageDepositPerByte) {
+		return false
+	}
+	if this.RedactedErrorJSON != that1.RedactedErrorJSON {
+		return false
+	}
+	if this.UniqueContractLabels != that1.UniqueContractLabels {
+		return false
+	}
+	return true
+}
+
+func (this *GasRegisterParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasRegisterParams)
+	if !ok {
+		that2, ok := that.(GasRegisterParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InstanceCost != that1.InstanceCost {
+		return false
+	}
+	if this.InstanceCostDiscount != that1.InstanceCostDiscount {
+		return false
+	}
+	if this.CompileCost != that1.CompileCost {
+		return false
+	}
+	if this.UncompressCostNumerator != that1.UncompressCostNumerator {
+		return false
+	}
+	if this.UncompressCostDenominator != that1.UncompressCostDenominator {
+		return false
+	}
+	if this.GasMultiplier != that1.GasMultiplier {
+		return false
+	}
+	if this.EventPerAttributeCost != that1.EventPerAttributeCost {
+		return false
+	}
+	if this.EventAttributeDataCost != that1.EventAttributeDataCost {
+		return false
+	}
+	if this.EventAttributeDataFreeTier != that1.EventAttributeDataFreeTier {
+		return false
+	}
+	if this.ContractMessageDataCost != that1.ContractMessageDataCost {
+		return false
+	}
+	if this.CustomEventCost != that1.CustomEventCost {
+		return false
+	}
 	return true
 }
 
@@ -732,6 +1270,15 @@ func (this *ContractInfo) Equal(that interface{}) bool {
 	if !this.Extension.Equal(that1.Extension) {
 		return false
 	}
+	if this.Frozen != that1.Frozen {
+		return false
+	}
+	if this.MigrationDelay != that1.MigrationDelay {
+		return false
+	}
+	if this.MaxStateSize != that1.MaxStateSize {
+		return false
+	}
 	return true
 }
 
@@ -825,35 +1372,238 @@ func (this *Model) Equal(that interface{}) bool {
 	return true
 }
 
-func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+func (this *ContractCallback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*ContractCallback)
+	if !ok {
+		that2, ok := that.(ContractCallback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.NextHeight != that1.NextHeight {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	if len(this.Escrow) != len(that1.Escrow) {
+		return false
+	}
+	for i := range this.Escrow {
+		if !this.Escrow[i].Equal(&that1.Escrow[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *PendingAdminTransfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingAdminTransfer)
+	if !ok {
+		that2, ok := that.(PendingAdminTransfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.ProposedAdmin != that1.ProposedAdmin {
+		return false
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	return true
+}
+
+func (this *PendingMigration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingMigration)
+	if !ok {
+		that2, ok := that.(PendingMigration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.ExecuteHeight != that1.ExecuteHeight {
+		return false
+	}
+	return true
+}
+
+func (this *CodeUploadSession) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeUploadSession)
+	if !ok {
+		that2, ok := that.(CodeUploadSession)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.ChunkCount != that1.ChunkCount {
+		return false
+	}
+	if this.TotalSize != that1.TotalSize {
+		return false
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	return true
+}
+
+func (this *ContractStorageUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractStorageUsage)
+	if !ok {
+		that2, ok := that.(ContractStorageUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.StoredBytes != that1.StoredBytes {
+		return false
+	}
+	if len(this.Deposit) != len(that1.Deposit) {
+		return false
+	}
+	for i := range this.Deposit {
+		if !this.Deposit[i].Equal(&that1.Deposit[i]) {
+			return false
+		}
+	}
+	if this.Sponsor != that1.Sponsor {
+		return false
+	}
+	return true
+}
+
+func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -910,6 +1660,82 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UniqueContractLabels {
+		i--
+		if m.UniqueContractLabels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RedactedErrorJSON {
+		i--
+		if m.RedactedErrorJSON {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StorageDepositPerByte != nil {
+		{
+			size, err := m.StorageDepositPerByte.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GasRegister != nil {
+		{
+			size, err := m.GasRegister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxAddressCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxAddressCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxLabelSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxLabelSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxProposalWasmSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxProposalWasmSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxWasmSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxWasmSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CallbackGasPrice != nil {
+		{
+			size, err := m.CallbackGasPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.InstantiateDefaultPermission != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
 		i--
@@ -928,7 +1754,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+func (m *GasRegisterParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -938,42 +1764,120 @@ func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *GasRegisterParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GasRegisterParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if m.CustomEventCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CustomEventCost))
+		i--
+		dAtA[i] = 0x58
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+	if m.ContractMessageDataCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ContractMessageDataCost))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x50
 	}
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
+	if m.EventAttributeDataFreeTier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventAttributeDataFreeTier))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x48
 	}
-	return len(dAtA) - i, nil
-}
+	if m.EventAttributeDataCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventAttributeDataCost))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EventPerAttributeCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventPerAttributeCost))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasMultiplier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasMultiplier))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UncompressCostDenominator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UncompressCostDenominator))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UncompressCostNumerator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UncompressCostNumerator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CompileCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CompileCost))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InstanceCostDiscount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstanceCostDiscount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InstanceCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstanceCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -995,6 +1899,26 @@ func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxStateSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxStateSize))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MigrationDelay != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MigrationDelay))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Extension != nil {
 		{
 			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
@@ -1184,136 +2108,511 @@ func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *AccessTypeParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
-	}
-	return n
+func (m *ContractCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccessConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContractCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Permission != 0 {
-		n += 1 + sovTypes(uint64(m.Permission))
+	if len(m.Escrow) > 0 {
+		for iNdEx := len(m.Escrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
 	}
-	return n
-}
-
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	l = m.CodeUploadAccess.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.InstantiateDefaultPermission != 0 {
-		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *CodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.NextHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.InstantiateConfig.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingAdminTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAdminTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAdminTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.IBCPortID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.IBC2PortID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.ProposedAdmin) > 0 {
+		i -= len(m.ProposedAdmin)
+		copy(dAtA[i:], m.ProposedAdmin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposedAdmin)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Extension != nil {
-		l = m.Extension.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractCodeHistoryEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Operation != 0 {
-		n += 1 + sovTypes(uint64(m.Operation))
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeUploadSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeUploadSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeUploadSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChunkCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractStorageUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStorageUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStorageUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StoredBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StoredBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *AccessTypeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovTypes(uint64(m.Permission))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeUploadAccess.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.InstantiateDefaultPermission != 0 {
+		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	}
+	if m.CallbackGasPrice != nil {
+		l = m.CallbackGasPrice.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MaxWasmSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxWasmSize))
+	}
+	if m.MaxProposalWasmSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxProposalWasmSize))
+	}
+	if m.MaxLabelSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxLabelSize))
+	}
+	if m.MaxAddressCount != 0 {
+		n += 1 + sovTypes(uint64(m.MaxAddressCount))
+	}
+	if m.GasRegister != nil {
+		l = m.GasRegister.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StorageDepositPerByte != nil {
+		l = m.StorageDepositPerByte.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RedactedErrorJSON {
+		n += 2
+	}
+	if m.UniqueContractLabels {
+		n += 2
+	}
+	return n
+}
+
+func (m *GasRegisterParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InstanceCost != 0 {
+		n += 1 + sovTypes(uint64(m.InstanceCost))
+	}
+	if m.InstanceCostDiscount != 0 {
+		n += 1 + sovTypes(uint64(m.InstanceCostDiscount))
+	}
+	if m.CompileCost != 0 {
+		n += 1 + sovTypes(uint64(m.CompileCost))
+	}
+	if m.UncompressCostNumerator != 0 {
+		n += 1 + sovTypes(uint64(m.UncompressCostNumerator))
+	}
+	if m.UncompressCostDenominator != 0 {
+		n += 1 + sovTypes(uint64(m.UncompressCostDenominator))
+	}
+	if m.GasMultiplier != 0 {
+		n += 1 + sovTypes(uint64(m.GasMultiplier))
+	}
+	if m.EventPerAttributeCost != 0 {
+		n += 1 + sovTypes(uint64(m.EventPerAttributeCost))
+	}
+	if m.EventAttributeDataCost != 0 {
+		n += 1 + sovTypes(uint64(m.EventAttributeDataCost))
+	}
+	if m.EventAttributeDataFreeTier != 0 {
+		n += 1 + sovTypes(uint64(m.EventAttributeDataFreeTier))
+	}
+	if m.ContractMessageDataCost != 0 {
+		n += 1 + sovTypes(uint64(m.ContractMessageDataCost))
+	}
+	if m.CustomEventCost != 0 {
+		n += 1 + sovTypes(uint64(m.CustomEventCost))
+	}
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.InstantiateConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IBCPortID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IBC2PortID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Extension != nil {
+		l = m.Extension.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	if m.MigrationDelay != 0 {
+		n += 1 + sovTypes(uint64(m.MigrationDelay))
+	}
+	if m.MaxStateSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxStateSize))
+	}
+	return n
+}
+
+func (m *ContractCodeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovTypes(uint64(m.Operation))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovTypes(uint64(l))
 	}
 	l = len(m.Msg)
 	if l > 0 {
@@ -1354,48 +2653,1351 @@ func (m *Model) Size() (n int) {
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-
-func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+func (m *ContractCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextHeight))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Escrow) > 0 {
+		for _, e := range m.Escrow {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingAdminTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ProposedAdmin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *PendingMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExecuteHeight))
+	}
+	return n
+}
+
+func (m *CodeUploadSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovTypes(uint64(m.ChunkCount))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovTypes(uint64(m.TotalSize))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *ContractStorageUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StoredBytes != 0 {
+		n += 1 + sovTypes(uint64(m.StoredBytes))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+			}
+			m.InstantiateDefaultPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallbackGasPrice == nil {
+				m.CallbackGasPrice = &types.DecCoin{}
+			}
+			if err := m.CallbackGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWasmSize", wireType)
+			}
+			m.MaxWasmSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWasmSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProposalWasmSize", wireType)
+			}
+			m.MaxProposalWasmSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProposalWasmSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLabelSize", wireType)
+			}
+			m.MaxLabelSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLabelSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAddressCount", wireType)
+			}
+			m.MaxAddressCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAddressCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRegister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasRegister == nil {
+				m.GasRegister = &GasRegisterParams{}
+			}
+			if err := m.GasRegister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDepositPerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageDepositPerByte == nil {
+				m.StorageDepositPerByte = &types.Coin{}
+			}
+			if err := m.StorageDepositPerByte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedactedErrorJSON", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedactedErrorJSON = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueContractLabels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UniqueContractLabels = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GasRegisterParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasRegisterParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasRegisterParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCost", wireType)
+			}
+			m.InstanceCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstanceCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCostDiscount", wireType)
+			}
+			m.InstanceCostDiscount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstanceCostDiscount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompileCost", wireType)
+			}
+			m.CompileCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompileCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostNumerator", wireType)
+			}
+			m.UncompressCostNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostNumerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostDenominator", wireType)
+			}
+			m.UncompressCostDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+			}
+			m.GasMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventPerAttributeCost", wireType)
+			}
+			m.EventPerAttributeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventPerAttributeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAttributeDataCost", wireType)
+			}
+			m.EventAttributeDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventAttributeDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAttributeDataFreeTier", wireType)
+			}
+			m.EventAttributeDataFreeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventAttributeDataFreeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMessageDataCost", wireType)
+			}
+			m.ContractMessageDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractMessageDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomEventCost", wireType)
+			}
+			m.CustomEventCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CustomEventCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Created == nil {
+				m.Created = &AbsoluteTxPosition{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBC2PortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBC2PortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Extension == nil {
+				m.Extension = &types1.Any{}
+			}
+			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
 			}
-			m.Value = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1405,7 +4007,46 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= AccessType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationDelay", wireType)
+			}
+			m.MigrationDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigrationDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStateSize", wireType)
+			}
+			m.MaxStateSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStateSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1432,7 +4073,7 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1455,17 +4096,17 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			m.Permission = 0
+			m.Operation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1475,16 +4116,35 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= AccessType(b&0x7F) << shift
+				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1494,23 +4154,61 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updated == nil {
+				m.Updated = &AbsoluteTxPosition{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1534,7 +4232,7 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1557,17 +4255,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1577,30 +4275,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			m.InstantiateDefaultPermission = 0
+			m.TxIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1610,7 +4294,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				m.TxIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1637,7 +4321,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+func (m *Model) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1660,15 +4344,15 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Model: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1695,48 +4379,16 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1746,23 +4398,24 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1787,7 +4440,7 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+func (m *ContractCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1810,17 +4463,17 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.CodeID = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1830,14 +4483,14 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1865,11 +4518,11 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1897,13 +4550,13 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1913,27 +4566,86 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1960,16 +4672,99 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &AbsoluteTxPosition{}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrow = append(m.Escrow, types.Coin{})
+			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PendingAdminTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAdminTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAdminTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1997,11 +4792,11 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBC2PortID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2029,13 +4824,13 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IBC2PortID = string(dAtA[iNdEx:postIndex])
+			m.ProposedAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			var msglen int
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2045,28 +4840,11 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Extension == nil {
-				m.Extension = &types.Any{}
-			}
-			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2089,7 +4867,7 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
+func (m *PendingMigration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2112,17 +4890,17 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingMigration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingMigration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.Operation = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2132,16 +4910,29 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.CodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2151,16 +4942,29 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			var msglen int
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2170,28 +4974,11 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Updated == nil {
-				m.Updated = &AbsoluteTxPosition{}
-			}
-			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
@@ -2226,6 +5013,25 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				m.Msg = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
+			}
+			m.ExecuteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2248,7 +5054,7 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
+func (m *CodeUploadSession) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2271,17 +5077,17 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeUploadSession: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeUploadSession: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.BlockHeight = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2291,16 +5097,48 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
 			}
-			m.TxIndex = 0
+			m.ChunkCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2310,7 +5148,45 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
+				m.ChunkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2337,7 +5213,7 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Model) Unmarshal(dAtA []byte) error {
+func (m *ContractStorageUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2360,17 +5236,17 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Model: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractStorageUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractStorageUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2380,31 +5256,48 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredBytes", wireType)
+			}
+			m.StoredBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2414,25 +5307,57 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
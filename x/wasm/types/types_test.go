@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
-	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +12,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -49,8 +50,11 @@ func TestContractInfoValidateBasic(t *testing.T) {
 			srcMutator: func(c *ContractInfo) { c.Label = "" },
 			expError:   true,
 		},
-		"label exceeds limit": {
-			srcMutator: func(c *ContractInfo) { c.Label = strings.Repeat("a", MaxLabelSize+1) },
+		"migration delay": {
+			srcMutator: func(c *ContractInfo) { c.MigrationDelay = MaxMigrationDelay },
+		},
+		"migration delay exceeds limit": {
+			srcMutator: func(c *ContractInfo) { c.MigrationDelay = MaxMigrationDelay + 1 },
 			expError:   true,
 		},
 		"invalid extension": {
@@ -636,6 +640,80 @@ func TestContractCodeHistoryEntryValidation(t *testing.T) {
 	}
 }
 
+func TestContractCallbackValidation(t *testing.T) {
+	specs := map[string]struct {
+		src    ContractCallback
+		expErr bool
+	}{
+		"all good": {
+			src: ContractCallbackFixture(),
+		},
+		"empty escrow": {
+			src: ContractCallbackFixture(func(c *ContractCallback) {
+				c.Escrow = nil
+			}),
+		},
+		"empty id": {
+			src: ContractCallbackFixture(func(c *ContractCallback) {
+				c.ID = 0
+			}),
+			expErr: true,
+		},
+		"invalid contract": {
+			src: ContractCallbackFixture(func(c *ContractCallback) {
+				c.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+		"invalid sender": {
+			src: ContractCallbackFixture(func(c *ContractCallback) {
+				c.Sender = invalidAddress
+			}),
+			expErr: true,
+		},
+		"invalid msg": {
+			src: ContractCallbackFixture(func(c *ContractCallback) {
+				c.Msg = []byte("not json")
+			}),
+			expErr: true,
+		},
+		"non positive next height": {
+			src: ContractCallbackFixture(func(c *ContractCallback) {
+				c.NextHeight = 0
+			}),
+			expErr: true,
+		},
+		"empty gas limit": {
+			src: ContractCallbackFixture(func(c *ContractCallback) {
+				c.GasLimit = 0
+			}),
+			expErr: true,
+		},
+		"gas limit exceeds max": {
+			src: ContractCallbackFixture(func(c *ContractCallback) {
+				c.GasLimit = MaxCallbackGasLimit + 1
+			}),
+			expErr: true,
+		},
+		"invalid fee": {
+			src: ContractCallbackFixture(func(c *ContractCallback) {
+				c.Fee = sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(-1)}}
+			}),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
 func TestTxContractsAddContract(t *testing.T) {
 	specs := map[string]struct {
 		checksums [][]byte
@@ -706,3 +784,54 @@ func TestTxContractsExists(t *testing.T) {
 		})
 	}
 }
+
+func TestPendingMigrationValidation(t *testing.T) {
+	specs := map[string]struct {
+		src    PendingMigration
+		expErr bool
+	}{
+		"all good": {
+			src: PendingMigrationFixture(),
+		},
+		"invalid contract": {
+			src: PendingMigrationFixture(func(p *PendingMigration) {
+				p.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+		"invalid sender": {
+			src: PendingMigrationFixture(func(p *PendingMigration) {
+				p.Sender = invalidAddress
+			}),
+			expErr: true,
+		},
+		"empty code id": {
+			src: PendingMigrationFixture(func(p *PendingMigration) {
+				p.CodeID = 0
+			}),
+			expErr: true,
+		},
+		"invalid msg": {
+			src: PendingMigrationFixture(func(p *PendingMigration) {
+				p.Msg = []byte("not json")
+			}),
+			expErr: true,
+		},
+		"non positive execute height": {
+			src: PendingMigrationFixture(func(p *PendingMigration) {
+				p.ExecuteHeight = 0
+			}),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"net/url"
@@ -12,44 +13,80 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // MaxSaltSize is the longest salt that can be used when instantiating a contract
 const MaxSaltSize = 64
 
 var (
-	// MaxLabelSize is the longest label that can be used when instantiating a contract
+	// MaxLabelSize is the default for the longest label that can be used when instantiating a contract.
+	// The on-chain limit is set by Params.MaxLabelSize.
 	MaxLabelSize = 128 // extension point for chains to customize via compile flag.
 
-	// MaxWasmSize is the largest a compiled contract code can be when storing code on chain
+	// MaxWasmSize is the default for the largest a compiled contract code can be when storing code on chain.
+	// The on-chain limit is set by Params.MaxWasmSize.
 	MaxWasmSize = 800 * 1024 // extension point for chains to customize via compile flag.
 
-	// MaxProposalWasmSize is the largest a gov proposal compiled contract code can be when storing code on chain
+	// MaxProposalWasmSize is the default for the largest a gov proposal compiled contract code can be when storing
+	// code on chain. The on-chain limit is set by Params.MaxProposalWasmSize.
 	MaxProposalWasmSize = 3 * 1024 * 1024 // extension point for chains to customize via compile flag.
 
-	// MaxAddressCount is the maximum number of addresses allowed within a message
+	// MaxAddressCount is the default for the maximum number of addresses allowed within an access config.
+	// The on-chain limit is set by Params.MaxAddressCount.
 	MaxAddressCount = 50
+
+	// MaxCallbackGasLimit is the max gas a single execution of a scheduled contract callback can consume
+	MaxCallbackGasLimit uint64 = 10_000_000 // extension point for chains to customize via compile flag.
+
+	// MaxCallbacksPerBlock is the max number of scheduled contract callbacks executed at the end of a block.
+	// Callbacks that are due are executed in the following blocks then.
+	MaxCallbacksPerBlock = 50 // extension point for chains to customize via compile flag.
+
+	// MaxMigrationDelay is the max number of blocks a contract migration can be delayed
+	MaxMigrationDelay uint64 = 10_000_000 // extension point for chains to customize via compile flag.
+
+	// MaxScheduledMigrationsPerBlock is the max number of pending migrations executed at the end of a block.
+	// Migrations that are due are executed in the following blocks then.
+	MaxScheduledMigrationsPerBlock = 10 // extension point for chains to customize via compile flag.
+
+	// MaxScheduledMigrationGasLimit is the max gas a pending migration can consume when it is executed
+	MaxScheduledMigrationGasLimit uint64 = 100_000_000 // extension point for chains to customize via compile flag.
+
+	// MaxContractsMigrateBatch is the max number of contracts migrated by a single MsgMigrateContractsByCode
+	MaxContractsMigrateBatch uint64 = 100 // extension point for chains to customize via compile flag.
+
+	// MaxCodeUploadChunks is the max number of chunks a chunked code upload can consist of.
+	// The total size of the staged chunks is limited by Params.MaxWasmSize.
+	MaxCodeUploadChunks uint64 = 100 // extension point for chains to customize via compile flag.
+
+	// CodeUploadTimeout is the number of blocks an upload session is kept after its last chunk was stored.
+	// Abandoned sessions are pruned at the end of a block.
+	CodeUploadTimeout int64 = 1_000 // extension point for chains to customize via compile flag.
+
+	// MaxCodeUploadsPrunedPerBlock is the max number of expired upload sessions removed at the end of a block.
+	MaxCodeUploadsPrunedPerBlock = 10 // extension point for chains to customize via compile flag.
 )
 
-func validateWasmCode(s []byte, maxSize int) error {
+// validateWasmCode ensures the code is not empty. The size limit depends on the params and is enforced on execution.
+func validateWasmCode(s []byte) error {
 	if len(s) == 0 {
 		return errorsmod.Wrap(ErrEmpty, "is required")
 	}
+	return nil
+}
+
+func validateWasmCodeSize(s []byte, maxSize int) error {
 	if len(s) > maxSize {
 		return errorsmod.Wrapf(ErrLimit, "cannot be longer than %d bytes", maxSize)
 	}
 	return nil
 }
 
-// ValidateLabel ensure label constraints
+// ValidateLabel ensure label constraints. The max label size depends on the params, see Params.ValidateLabelSize.
 func ValidateLabel(label string) error {
 	if label == "" {
 		return errorsmod.Wrap(ErrEmpty, "is required")
 	}
-	if len(label) > MaxLabelSize {
-		return ErrLimit.Wrapf("cannot be longer than %d characters", MaxLabelSize)
-	}
 	if label != strings.TrimSpace(label) {
 		return ErrInvalid.Wrap("label must not start/end with whitespaces")
 	}
@@ -76,6 +113,17 @@ func ValidateSalt(salt []byte) error {
 	return nil
 }
 
+// ValidateChecksum ensure checksum constraints
+func ValidateChecksum(checksum []byte) error {
+	switch n := len(checksum); {
+	case n == 0:
+		return errorsmod.Wrap(ErrEmpty, "is required")
+	case n != sha256.Size:
+		return errorsmod.Wrapf(ErrInvalid, "must be %d bytes", sha256.Size)
+	}
+	return nil
+}
+
 // ValidateVerificationInfo ensure source, builder and checksum constraints
 func ValidateVerificationInfo(source, builder string, codeHash []byte) error {
 	// if any set require others to be set
@@ -100,14 +148,11 @@ func ValidateVerificationInfo(source, builder string, codeHash []byte) error {
 	return nil
 }
 
-// validateBech32Addresses ensures the list is not empty, has no duplicates
-// and does not exceed the max number of addresses
+// validateBech32Addresses ensures the list is not empty and has no duplicates.
+// The max number of addresses depends on the params, see Params.ValidateAddressCount.
 func validateBech32Addresses(addresses []string) error {
-	switch n := len(addresses); {
-	case n == 0:
+	if len(addresses) == 0 {
 		return errorsmod.Wrap(ErrEmpty, "addresses")
-	case n > MaxAddressCount:
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "total number of addresses is greater than %d", MaxAddressCount)
 	}
 
 	index := map[string]struct{}{}
@@ -125,3 +170,14 @@ func validateBech32Addresses(addresses []string) error {
 	}
 	return nil
 }
+
+// validateCallbackGasLimit ensures the gas limit is set and does not exceed the max gas for a scheduled callback
+func validateCallbackGasLimit(gasLimit uint64) error {
+	if gasLimit == 0 {
+		return errorsmod.Wrap(ErrEmpty, "is required")
+	}
+	if gasLimit > MaxCallbackGasLimit {
+		return errorsmod.Wrapf(ErrLimit, "cannot be greater than %d", MaxCallbackGasLimit)
+	}
+	return nil
+}
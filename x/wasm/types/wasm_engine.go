@@ -357,6 +357,11 @@ type WasmEngine interface {
 	// Unpin is idempotent.
 	Unpin(checksum wasmvm.Checksum) error
 
+	// RemoveCode removes the wasm blob and the compiled module of the given checksum
+	// from the file system cache.
+	// The code must not be pinned. Removing unknown code returns an error.
+	RemoveCode(checksum wasmvm.Checksum) error
+
 	// GetMetrics some internal metrics for monitoring purposes.
 	GetMetrics() (*wasmvmtypes.Metrics, error)
 